@@ -4,9 +4,12 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"io"
 	"net/url"
+	"slices"
 	"strconv"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -18,6 +21,14 @@ const blobDataPlaneProvisioningAttempts = 6
 const blobDataPlaneProvisioningRetryBase = 200 * time.Millisecond
 const blobDataPlaneProvisioningRetryMax = 10 * time.Second
 
+// Multipart uploads are sent in fixed size parts, several at a time, so that
+// memory use is bounded regardless of the size of the object.
+const BlobMultipartPartSize = 8 * 1024 * 1024
+const blobMultipartConcurrency = 6
+
+// blobListPageLimit is the number of objects requested per page when listing.
+const blobListPageLimit = 1000
+
 // Package vars keep the Blob data-plane retry path testable without live waits.
 var blobDataPlaneURL = "https://vercel.com/api/blob"
 var blobDataPlaneSleep = time.Sleep
@@ -60,33 +71,86 @@ func (c *Client) GetBlobObject(ctx context.Context, request GetBlobObjectRequest
 		"url":      endpoint,
 	})
 
-	requestID := blobDataPlaneRequestID(request.StoreID)
-	headers := c.blobDataPlaneHeaders(request.StoreID, request.TeamID)
-	headers["x-api-version"] = blobDataPlaneAPIVersion
-	headers["x-api-blob-request-id"] = requestID
+	err = c.doBlobDataPlaneRequest(clientRequest{
+		ctx:     ctx,
+		method:  "GET",
+		url:     endpoint,
+		headers: c.blobDataPlaneHeaders(request.StoreID, request.TeamID),
+	}, &object)
+	if err != nil {
+		return object, err
+	}
+	object.ETag = normalizeBlobObjectETag(object.ETag)
+	return object, nil
+}
 
-	for attempt := 1; attempt <= blobDataPlaneTransientAttempts; attempt++ {
-		headers["x-api-blob-request-attempt"] = strconv.Itoa(attempt - 1)
-		err = c.doRequest(clientRequest{
+type ListBlobObjectsRequest struct {
+	// Mode is either "expanded", to list every object under the prefix, or
+	// "folded", to list the objects directly under the prefix along with the
+	// folders below it.
+	Mode    string
+	Prefix  string
+	StoreID string
+	TeamID  string
+}
+
+type BlobObjectList struct {
+	Blobs   []BlobObject
+	Folders []string
+}
+
+type blobObjectListResponse struct {
+	Blobs   []BlobObject `json:"blobs"`
+	Folders []string     `json:"folders"`
+	Cursor  string       `json:"cursor"`
+	HasMore bool         `json:"hasMore"`
+}
+
+// ListBlobObjects lists the objects in a store under a prefix, following the
+// cursor until every page has been read.
+func (c *Client) ListBlobObjects(ctx context.Context, request ListBlobObjectsRequest) (list BlobObjectList, err error) {
+	query := url.Values{}
+	query.Set("limit", strconv.Itoa(blobListPageLimit))
+	if request.Prefix != "" {
+		query.Set("prefix", request.Prefix)
+	}
+	if request.Mode != "" {
+		query.Set("mode", request.Mode)
+	}
+
+	for {
+		endpoint := fmt.Sprintf("%s?%s", blobDataPlaneURL, query.Encode())
+		tflog.Info(ctx, "listing blob objects", map[string]any{
+			"prefix":   request.Prefix,
+			"store_id": request.StoreID,
+			"url":      endpoint,
+		})
+
+		var page blobObjectListResponse
+		err = c.doBlobDataPlaneRequest(clientRequest{
 			ctx:     ctx,
 			method:  "GET",
 			url:     endpoint,
-			headers: headers,
-		}, &object)
-		if err == nil {
-			object.ETag = normalizeBlobObjectETag(object.ETag)
-			return object, nil
+			headers: c.blobDataPlaneHeaders(request.StoreID, request.TeamID),
+		}, &page)
+		if err != nil {
+			return list, err
 		}
 
-		maxAttempts := blobDataPlaneRetryMaxAttempts(err)
-		if maxAttempts == 0 || attempt == maxAttempts {
-			return object, err
+		for _, blob := range page.Blobs {
+			blob.ETag = normalizeBlobObjectETag(blob.ETag)
+			list.Blobs = append(list.Blobs, blob)
 		}
+		list.Folders = append(list.Folders, page.Folders...)
 
-		blobDataPlaneSleep(blobDataPlaneRetryDelay(attempt))
+		if !page.HasMore || page.Cursor == "" {
+			return list, nil
+		}
+		if page.Cursor == query.Get("cursor") {
+			return list, fmt.Errorf("error listing blob objects: cursor %q did not advance", page.Cursor)
+		}
+		query.Set("cursor", page.Cursor)
 	}
-
-	return object, err
 }
 
 func (c *Client) PutBlobObject(ctx context.Context, request PutBlobObjectRequest) (object BlobObject, err error) {
@@ -102,8 +166,6 @@ func (c *Client) PutBlobObject(ctx context.Context, request PutBlobObjectRequest
 	headers := c.blobDataPlaneHeaders(request.StoreID, request.TeamID)
 	headers["x-add-random-suffix"] = "0"
 	headers["x-allow-overwrite"] = "1"
-	headers["x-api-version"] = blobDataPlaneAPIVersion
-	headers["x-api-blob-request-id"] = blobDataPlaneRequestID(request.StoreID)
 	headers["x-vercel-blob-access"] = store.Access
 	if request.ContentType != "" {
 		headers["x-content-type"] = request.ContentType
@@ -118,62 +180,388 @@ func (c *Client) PutBlobObject(ctx context.Context, request PutBlobObjectRequest
 		"url":      endpoint,
 	})
 
-	for attempt := 1; attempt <= blobDataPlaneTransientAttempts; attempt++ {
-		headers["x-api-blob-request-attempt"] = strconv.Itoa(attempt - 1)
-		err = c.doRequest(clientRequest{
-			ctx:       ctx,
-			method:    "PUT",
-			url:       endpoint,
-			bodyBytes: request.Body,
-			headers:   headers,
-		}, &object)
-		if err == nil {
-			object.ETag = normalizeBlobObjectETag(object.ETag)
-			if request.CacheControlMaxAge > 0 && object.CacheControl == "" {
-				object.CacheControl = fmt.Sprintf("public, max-age=%d", request.CacheControlMaxAge)
+	err = c.doBlobDataPlaneRequest(clientRequest{
+		ctx:       ctx,
+		method:    "PUT",
+		url:       endpoint,
+		bodyBytes: request.Body,
+		headers:   headers,
+	}, &object)
+	if err != nil {
+		return object, err
+	}
+
+	object.ETag = normalizeBlobObjectETag(object.ETag)
+	if request.CacheControlMaxAge > 0 && object.CacheControl == "" {
+		object.CacheControl = fmt.Sprintf("public, max-age=%d", request.CacheControlMaxAge)
+	}
+	if object.Size == 0 && len(request.Body) > 0 {
+		object.Size = int64(len(request.Body))
+	}
+	if object.UploadedAt == "" {
+		object.UploadedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	return object, nil
+}
+
+type CopyBlobObjectRequest struct {
+	// FromURL is the URL of the object to copy. It may belong to another store.
+	FromURL            string
+	CacheControlMaxAge int64
+	ContentType        string
+	// Pathname and StoreID identify the copy. An existing object at the
+	// pathname, including the object being copied, is overwritten.
+	Pathname string
+	StoreID  string
+	TeamID   string
+}
+
+// CopyBlobObject copies an object on the server, without downloading or
+// uploading its content. Copying an object onto its own pathname replaces its
+// content type and cache max-age.
+func (c *Client) CopyBlobObject(ctx context.Context, request CopyBlobObjectRequest) (object BlobObject, err error) {
+	store, err := c.GetBlobStore(ctx, request.StoreID, request.TeamID)
+	if err != nil {
+		return object, err
+	}
+
+	query := url.Values{}
+	query.Set("pathname", request.Pathname)
+	query.Set("fromUrl", request.FromURL)
+	endpoint := fmt.Sprintf("%s?%s", blobDataPlaneURL, query.Encode())
+
+	headers := c.blobDataPlaneHeaders(request.StoreID, request.TeamID)
+	headers["x-add-random-suffix"] = "0"
+	headers["x-allow-overwrite"] = "1"
+	headers["x-vercel-blob-access"] = store.Access
+	if request.ContentType != "" {
+		headers["x-content-type"] = request.ContentType
+	}
+	if request.CacheControlMaxAge > 0 {
+		headers["x-cache-control-max-age"] = strconv.FormatInt(request.CacheControlMaxAge, 10)
+	}
+
+	tflog.Info(ctx, "copying blob object", map[string]any{
+		"from_url": request.FromURL,
+		"pathname": request.Pathname,
+		"store_id": request.StoreID,
+		"url":      endpoint,
+	})
+
+	err = c.doBlobDataPlaneRequest(clientRequest{
+		ctx:     ctx,
+		method:  "PUT",
+		url:     endpoint,
+		headers: headers,
+	}, &object)
+	if err != nil {
+		return object, err
+	}
+
+	object.ETag = normalizeBlobObjectETag(object.ETag)
+	if request.CacheControlMaxAge > 0 && object.CacheControl == "" {
+		object.CacheControl = fmt.Sprintf("public, max-age=%d", request.CacheControlMaxAge)
+	}
+	return object, nil
+}
+
+// BlobMultipartUpload identifies a multipart upload that has been created but
+// not yet completed.
+type BlobMultipartUpload struct {
+	Key      string `json:"key"`
+	UploadID string `json:"uploadId"`
+}
+
+// BlobMultipartPart is a part of a multipart upload that has been uploaded.
+type BlobMultipartPart struct {
+	PartNumber int    `json:"partNumber"`
+	ETag       string `json:"etag"`
+}
+
+type PutBlobObjectMultipartRequest struct {
+	// Body is read sequentially, one part at a time, and is never held in
+	// memory in full.
+	Body               io.Reader
+	CacheControlMaxAge int64
+	ContentType        string
+	Pathname           string
+	StoreID            string
+	TeamID             string
+
+	// Upload and CompletedParts resume an earlier upload of the same body.
+	// Parts that have already been uploaded are skipped.
+	Upload         *BlobMultipartUpload
+	CompletedParts []BlobMultipartPart
+	// OnPartUploaded, if set, is called after each part is uploaded, so that
+	// callers can record progress and resume after a failure. It is never
+	// called concurrently.
+	OnPartUploaded func(upload BlobMultipartUpload, part BlobMultipartPart)
+}
+
+// PutBlobObjectMultipart uploads an object using the Blob multipart upload
+// protocol: the upload is created, the parts are uploaded in parallel, and
+// the upload is then completed. It is intended for objects too large to be
+// sent in a single request.
+func (c *Client) PutBlobObjectMultipart(ctx context.Context, request PutBlobObjectMultipartRequest) (object BlobObject, err error) {
+	store, err := c.GetBlobStore(ctx, request.StoreID, request.TeamID)
+	if err != nil {
+		return object, err
+	}
+
+	query := url.Values{}
+	query.Set("pathname", request.Pathname)
+	endpoint := fmt.Sprintf("%s/mpu?%s", blobDataPlaneURL, query.Encode())
+
+	headers := c.blobDataPlaneHeaders(request.StoreID, request.TeamID)
+	headers["x-add-random-suffix"] = "0"
+	headers["x-allow-overwrite"] = "1"
+	headers["x-vercel-blob-access"] = store.Access
+	if request.ContentType != "" {
+		headers["x-content-type"] = request.ContentType
+	}
+	if request.CacheControlMaxAge > 0 {
+		headers["x-cache-control-max-age"] = strconv.FormatInt(request.CacheControlMaxAge, 10)
+	}
+
+	upload := request.Upload
+	if upload == nil {
+		upload = &BlobMultipartUpload{}
+		err = c.doBlobDataPlaneRequest(clientRequest{
+			ctx:     ctx,
+			method:  "POST",
+			url:     endpoint,
+			headers: blobMultipartHeaders(headers, "create", nil),
+		}, upload)
+		if err != nil {
+			return object, fmt.Errorf("error creating multipart upload: %w", err)
+		}
+	}
+
+	tflog.Info(ctx, "writing blob object in parts", map[string]any{
+		"pathname":  request.Pathname,
+		"store_id":  request.StoreID,
+		"upload_id": upload.UploadID,
+		"resumed":   request.Upload != nil,
+		"url":       endpoint,
+	})
+
+	parts, err := c.uploadBlobMultipartParts(ctx, endpoint, headers, *upload, request)
+	if err != nil {
+		return object, err
+	}
+
+	err = c.doBlobDataPlaneRequest(clientRequest{
+		ctx:         ctx,
+		method:      "POST",
+		url:         endpoint,
+		body:        string(mustMarshal(parts)),
+		contentType: "application/json",
+		headers:     blobMultipartHeaders(headers, "complete", upload),
+	}, &object)
+	if err != nil {
+		return object, fmt.Errorf("error completing multipart upload: %w", err)
+	}
+
+	object.ETag = normalizeBlobObjectETag(object.ETag)
+	if request.CacheControlMaxAge > 0 && object.CacheControl == "" {
+		object.CacheControl = fmt.Sprintf("public, max-age=%d", request.CacheControlMaxAge)
+	}
+	if object.UploadedAt == "" {
+		object.UploadedAt = time.Now().UTC().Format(time.RFC3339)
+	}
+	return object, nil
+}
+
+// uploadBlobMultipartParts reads the body one part at a time and uploads up
+// to blobMultipartConcurrency parts at once. It returns every part of the
+// object, including those uploaded before a resume, in order.
+func (c *Client) uploadBlobMultipartParts(ctx context.Context, endpoint string, headers map[string]string, upload BlobMultipartUpload, request PutBlobObjectMultipartRequest) ([]BlobMultipartPart, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	completed := map[int]BlobMultipartPart{}
+	for _, part := range request.CompletedParts {
+		completed[part.PartNumber] = part
+	}
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		parts    []BlobMultipartPart
+		firstErr error
+	)
+	fail := func(err error) {
+		mu.Lock()
+		defer mu.Unlock()
+		if firstErr == nil {
+			firstErr = err
+			cancel()
+		}
+	}
+	sem := make(chan struct{}, blobMultipartConcurrency)
+
+	for partNumber := 1; ; partNumber++ {
+		if part, ok := completed[partNumber]; ok {
+			n, err := io.CopyN(io.Discard, request.Body, BlobMultipartPartSize)
+			if err != nil && !errors.Is(err, io.EOF) {
+				fail(fmt.Errorf("error reading part %d: %w", partNumber, err))
+				break
+			}
+			if n == 0 {
+				break
 			}
-			if object.Size == 0 && len(request.Body) > 0 {
-				object.Size = int64(len(request.Body))
+			mu.Lock()
+			parts = append(parts, part)
+			mu.Unlock()
+			if n < BlobMultipartPartSize {
+				break
+			}
+			continue
+		}
+
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+		}
+		if ctx.Err() != nil {
+			break
+		}
+
+		body := make([]byte, BlobMultipartPartSize)
+		n, err := io.ReadFull(request.Body, body)
+		if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+			<-sem
+			fail(fmt.Errorf("error reading part %d: %w", partNumber, err))
+			break
+		}
+		// An empty object is still uploaded as a single, empty part.
+		if n == 0 && partNumber > 1 {
+			<-sem
+			break
+		}
+
+		wg.Add(1)
+		go func(partNumber int, body []byte) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			part := BlobMultipartPart{PartNumber: partNumber}
+			err := c.doBlobDataPlaneRequest(clientRequest{
+				ctx:       ctx,
+				method:    "POST",
+				url:       endpoint,
+				bodyBytes: body,
+				headers:   blobMultipartPartHeaders(headers, upload, partNumber),
+			}, &part)
+			if err != nil {
+				fail(fmt.Errorf("error uploading part %d: %w", partNumber, err))
+				return
 			}
-			if object.UploadedAt == "" {
-				object.UploadedAt = time.Now().UTC().Format(time.RFC3339)
+			part.PartNumber = partNumber
+
+			mu.Lock()
+			defer mu.Unlock()
+			parts = append(parts, part)
+			if request.OnPartUploaded != nil {
+				request.OnPartUploaded(upload, part)
 			}
-			return object, nil
+		}(partNumber, body[:n])
+
+		if n < BlobMultipartPartSize {
+			break
+		}
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	slices.SortFunc(parts, func(a, b BlobMultipartPart) int { return a.PartNumber - b.PartNumber })
+	return parts, nil
+}
+
+func blobMultipartHeaders(base map[string]string, action string, upload *BlobMultipartUpload) map[string]string {
+	headers := make(map[string]string, len(base)+3)
+	for key, value := range base {
+		headers[key] = value
+	}
+	headers["x-mpu-action"] = action
+	if upload != nil {
+		headers["x-mpu-key"] = strings.ReplaceAll(url.QueryEscape(upload.Key), "+", "%20")
+		headers["x-mpu-upload-id"] = upload.UploadID
+	}
+	return headers
+}
+
+func blobMultipartPartHeaders(base map[string]string, upload BlobMultipartUpload, partNumber int) map[string]string {
+	headers := blobMultipartHeaders(base, "upload", &upload)
+	headers["x-mpu-part-number"] = strconv.Itoa(partNumber)
+	return headers
+}
+
+// doBlobDataPlaneRequest performs a data-plane request, retrying transient
+// and provisioning errors. Every attempt shares a request ID, as the Blob API
+// expects.
+func (c *Client) doBlobDataPlaneRequest(req clientRequest, v any) (err error) {
+	req.headers["x-api-version"] = blobDataPlaneAPIVersion
+	req.headers["x-api-blob-request-id"] = blobDataPlaneRequestID(req.headers["x-vercel-blob-store-id"])
+	for attempt := 1; attempt <= blobDataPlaneTransientAttempts; attempt++ {
+		req.headers["x-api-blob-request-attempt"] = strconv.Itoa(attempt - 1)
+		err = c.doRequest(req, v)
+		if err == nil {
+			return nil
 		}
 
 		maxAttempts := blobDataPlaneRetryMaxAttempts(err)
-		if maxAttempts == 0 || attempt == maxAttempts {
-			return object, err
+		if maxAttempts == 0 || attempt == maxAttempts || req.ctx.Err() != nil {
+			return err
 		}
 
 		blobDataPlaneSleep(blobDataPlaneRetryDelay(attempt))
 	}
 
-	return object, err
+	return err
 }
 
 func (c *Client) DeleteBlobObject(ctx context.Context, storeID, pathname, teamID string) error {
-	endpoint := fmt.Sprintf("%s/delete", blobDataPlaneURL)
-	body := string(mustMarshal(struct {
-		URLs []string `json:"urls"`
-	}{
-		URLs: []string{pathname},
-	}))
-
-	tflog.Info(ctx, "deleting blob object", map[string]any{
-		"pathname": pathname,
-		"store_id": storeID,
-		"url":      endpoint,
-	})
+	return c.DeleteBlobObjects(ctx, storeID, []string{pathname}, teamID)
+}
 
-	return c.doRequest(clientRequest{
-		ctx:         ctx,
-		method:      "POST",
-		url:         endpoint,
-		body:        body,
-		contentType: "application/json",
-		headers:     c.blobDataPlaneHeaders(storeID, teamID),
-	}, nil)
+// blobDeleteBatchSize is the number of objects deleted in a single request.
+const blobDeleteBatchSize = 100
+
+// DeleteBlobObjects deletes several objects from a store, in batches.
+func (c *Client) DeleteBlobObjects(ctx context.Context, storeID string, pathnames []string, teamID string) error {
+	endpoint := fmt.Sprintf("%s/delete", blobDataPlaneURL)
+	for batch := range slices.Chunk(pathnames, blobDeleteBatchSize) {
+		body := string(mustMarshal(struct {
+			URLs []string `json:"urls"`
+		}{
+			URLs: batch,
+		}))
+
+		tflog.Info(ctx, "deleting blob objects", map[string]any{
+			"pathnames": batch,
+			"store_id":  storeID,
+			"url":       endpoint,
+		})
+
+		err := c.doRequest(clientRequest{
+			ctx:         ctx,
+			method:      "POST",
+			url:         endpoint,
+			body:        body,
+			contentType: "application/json",
+			headers:     c.blobDataPlaneHeaders(storeID, teamID),
+		}, nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
 }
 
 func (c *Client) blobDataPlaneHeaders(storeID, teamID string) map[string]string {
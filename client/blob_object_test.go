@@ -1,11 +1,16 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
+	"slices"
 	"strconv"
+	"sync"
 	"testing"
 	"time"
 )
@@ -112,3 +117,281 @@ func TestBlobDataPlaneRetryDelayCapsExponentialBackoff(t *testing.T) {
 		}
 	}
 }
+
+func TestPutBlobObjectMultipart(t *testing.T) {
+	originalBlobDataPlaneURL := blobDataPlaneURL
+	originalBlobDataPlaneSleep := blobDataPlaneSleep
+	defer func() {
+		blobDataPlaneURL = originalBlobDataPlaneURL
+		blobDataPlaneSleep = originalBlobDataPlaneSleep
+	}()
+
+	// Two full parts and a short final part.
+	body := bytes.Repeat([]byte("a"), 2*BlobMultipartPartSize+10)
+	copy(body[BlobMultipartPartSize:], "b")
+	copy(body[2*BlobMultipartPartSize:], "c")
+
+	testCases := []struct {
+		name           string
+		upload         *BlobMultipartUpload
+		completedParts []BlobMultipartPart
+		wantCreates    int
+		wantUploaded   []int
+	}{
+		{
+			name:         "new upload",
+			wantCreates:  1,
+			wantUploaded: []int{1, 2, 3},
+		},
+		{
+			name:           "resumed upload",
+			upload:         &BlobMultipartUpload{Key: "key/1", UploadID: "upload_1"},
+			completedParts: []BlobMultipartPart{{PartNumber: 2, ETag: "etag-2"}},
+			wantUploaded:   []int{1, 3},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var mu sync.Mutex
+			creates := 0
+			uploaded := []int{}
+			failedOnce := false
+			var completed []BlobMultipartPart
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				switch r.URL.Path {
+				case "/v1/storage/stores/store_123":
+					fmt.Fprint(w, `{"store":{"id":"store_123","access":"public"}}`)
+				case "/v1/storage/stores/store_123/secrets":
+					fmt.Fprint(w, `{"rwToken":"vercel_blob_rw_store_123_test"}`)
+				case "/blob/mpu":
+					if r.Method != http.MethodPost {
+						t.Errorf("unexpected method for multipart upload: %s", r.Method)
+					}
+					if pathname := r.URL.Query().Get("pathname"); pathname != "media/large.bin" {
+						t.Errorf("expected pathname media/large.bin, got %q", pathname)
+					}
+					switch action := r.Header.Get("x-mpu-action"); action {
+					case "create":
+						mu.Lock()
+						creates++
+						mu.Unlock()
+						fmt.Fprint(w, `{"key":"key/1","uploadId":"upload_1"}`)
+					case "upload":
+						if key, id := r.Header.Get("x-mpu-key"), r.Header.Get("x-mpu-upload-id"); key != "key%2F1" || id != "upload_1" {
+							t.Errorf("unexpected upload key %q and id %q", key, id)
+						}
+						partNumber, _ := strconv.Atoi(r.Header.Get("x-mpu-part-number"))
+						content, _ := io.ReadAll(r.Body)
+						if want := body[(partNumber-1)*BlobMultipartPartSize : min(partNumber*BlobMultipartPartSize, len(body))]; !bytes.Equal(content, want) {
+							t.Errorf("part %d has unexpected content of %d bytes", partNumber, len(content))
+						}
+						mu.Lock()
+						defer mu.Unlock()
+						// The last part fails once, and is retried.
+						if partNumber == 3 && !failedOnce {
+							failedOnce = true
+							w.WriteHeader(http.StatusInternalServerError)
+							fmt.Fprint(w, `{"error":{"code":"unknown_error","message":"Unknown error"}}`)
+							return
+						}
+						uploaded = append(uploaded, partNumber)
+						fmt.Fprintf(w, `{"etag":"etag-%d"}`, partNumber)
+					case "complete":
+						if err := json.NewDecoder(r.Body).Decode(&completed); err != nil {
+							t.Errorf("could not decode completed parts: %s", err)
+						}
+						fmt.Fprint(w, `{"etag":"\"etag-mpu\"","pathname":"media/large.bin","url":"https://example.com/media/large.bin"}`)
+					default:
+						t.Errorf("unexpected multipart action %q", action)
+					}
+				default:
+					t.Errorf("unexpected request path: %s", r.URL.Path)
+				}
+			}))
+			defer server.Close()
+
+			blobDataPlaneURL = server.URL + "/blob"
+			blobDataPlaneSleep = func(time.Duration) {}
+
+			client := New("test-token")
+			client.baseURL = server.URL
+
+			var recorded []int
+			object, err := client.PutBlobObjectMultipart(context.Background(), PutBlobObjectMultipartRequest{
+				Body:           bytes.NewReader(body),
+				ContentType:    "application/octet-stream",
+				Pathname:       "media/large.bin",
+				StoreID:        "store_123",
+				Upload:         tc.upload,
+				CompletedParts: tc.completedParts,
+				OnPartUploaded: func(upload BlobMultipartUpload, part BlobMultipartPart) {
+					if upload.UploadID != "upload_1" {
+						t.Errorf("expected upload_1, got %q", upload.UploadID)
+					}
+					recorded = append(recorded, part.PartNumber)
+				},
+			})
+			if err != nil {
+				t.Fatalf("PutBlobObjectMultipart returned error: %v", err)
+			}
+
+			if creates != tc.wantCreates {
+				t.Errorf("expected %d create requests, got %d", tc.wantCreates, creates)
+			}
+			slices.Sort(uploaded)
+			slices.Sort(recorded)
+			if !slices.Equal(uploaded, tc.wantUploaded) || !slices.Equal(recorded, tc.wantUploaded) {
+				t.Errorf("expected parts %v to be uploaded and recorded, got %v and %v", tc.wantUploaded, uploaded, recorded)
+			}
+			want := []BlobMultipartPart{{1, "etag-1"}, {2, "etag-2"}, {3, "etag-3"}}
+			if !slices.Equal(completed, want) {
+				t.Errorf("expected completed parts %v, got %v", want, completed)
+			}
+			if object.ETag != "etag-mpu" || object.UploadedAt == "" {
+				t.Errorf("unexpected object %+v", object)
+			}
+		})
+	}
+}
+
+func TestListBlobObjectsFollowsCursorAndRetries(t *testing.T) {
+	originalBlobDataPlaneURL := blobDataPlaneURL
+	originalBlobDataPlaneSleep := blobDataPlaneSleep
+	defer func() {
+		blobDataPlaneURL = originalBlobDataPlaneURL
+		blobDataPlaneSleep = originalBlobDataPlaneSleep
+	}()
+
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/blob" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		query := r.URL.Query()
+		if query.Get("prefix") != "assets/" || query.Get("mode") != "folded" || query.Get("limit") != "1000" {
+			t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+		}
+		if storeID := r.Header.Get("x-vercel-blob-store-id"); storeID != "123" {
+			t.Fatalf("expected store id header 123, got %q", storeID)
+		}
+
+		requests++
+		switch requests {
+		case 1:
+			if query.Get("cursor") != "" {
+				t.Fatalf("expected no cursor on the first page, got %q", query.Get("cursor"))
+			}
+			fmt.Fprint(w, `{"blobs":[{"pathname":"assets/a.txt","size":1,"url":"https://example.com/assets/a.txt"}],"folders":["assets/img/"],"cursor":"page-2","hasMore":true}`)
+		case 2:
+			w.WriteHeader(http.StatusServiceUnavailable)
+			fmt.Fprint(w, `{"error":{"code":"service_unavailable","message":"Service unavailable"}}`)
+		case 3:
+			if query.Get("cursor") != "page-2" {
+				t.Fatalf("expected cursor page-2, got %q", query.Get("cursor"))
+			}
+			if attempt := r.Header.Get("x-api-blob-request-attempt"); attempt != "1" {
+				t.Fatalf("expected retry attempt 1, got %q", attempt)
+			}
+			fmt.Fprint(w, `{"blobs":[{"pathname":"assets/b.txt","size":2,"url":"https://example.com/assets/b.txt"}],"folders":["assets/js/"],"hasMore":false}`)
+		default:
+			t.Fatalf("unexpected request %d", requests)
+		}
+	}))
+	defer server.Close()
+
+	blobDataPlaneURL = server.URL + "/blob"
+	blobDataPlaneSleep = func(time.Duration) {}
+
+	list, err := New("test-token").ListBlobObjects(context.Background(), ListBlobObjectsRequest{
+		Mode:    "folded",
+		Prefix:  "assets/",
+		StoreID: "store_123",
+	})
+	if err != nil {
+		t.Fatalf("ListBlobObjects returned error: %v", err)
+	}
+
+	var pathnames []string
+	for _, blob := range list.Blobs {
+		pathnames = append(pathnames, blob.Pathname)
+	}
+	if want := []string{"assets/a.txt", "assets/b.txt"}; !slices.Equal(pathnames, want) {
+		t.Fatalf("expected blobs %v, got %v", want, pathnames)
+	}
+	if want := []string{"assets/img/", "assets/js/"}; !slices.Equal(list.Folders, want) {
+		t.Fatalf("expected folders %v, got %v", want, list.Folders)
+	}
+}
+
+func TestCopyBlobObject(t *testing.T) {
+	originalBlobDataPlaneURL := blobDataPlaneURL
+	defer func() {
+		blobDataPlaneURL = originalBlobDataPlaneURL
+	}()
+
+	copies := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/v1/storage/stores/store_456":
+			fmt.Fprint(w, `{"store":{"id":"store_456","access":"public"}}`)
+		case "/blob":
+			if r.Method != http.MethodPut {
+				t.Fatalf("unexpected method for blob copy: %s", r.Method)
+			}
+			query := r.URL.Query()
+			if query.Get("pathname") != "copies/object.txt" || query.Get("fromUrl") != "https://store123.public.blob.vercel-storage.com/terraform/object.txt" {
+				t.Fatalf("unexpected query: %s", r.URL.RawQuery)
+			}
+			if body, _ := io.ReadAll(r.Body); len(body) != 0 {
+				t.Fatalf("expected an empty body, got %q", body)
+			}
+			for header, want := range map[string]string{
+				"x-vercel-blob-store-id":  "456",
+				"x-vercel-blob-access":    "public",
+				"x-allow-overwrite":       "1",
+				"x-add-random-suffix":     "0",
+				"x-content-type":          "text/markdown",
+				"x-cache-control-max-age": "600",
+			} {
+				if got := r.Header.Get(header); got != want {
+					t.Fatalf("expected %s header %q, got %q", header, want, got)
+				}
+			}
+			copies++
+			fmt.Fprint(w, `{
+				"contentDisposition":"inline; filename=\"object.txt\"",
+				"contentType":"text/markdown",
+				"downloadUrl":"https://store456.public.blob.vercel-storage.com/copies/object.txt?download=1",
+				"pathname":"copies/object.txt",
+				"url":"https://store456.public.blob.vercel-storage.com/copies/object.txt"
+			}`)
+		default:
+			t.Fatalf("unexpected request path: %s", r.URL.Path)
+		}
+	}))
+	defer server.Close()
+
+	blobDataPlaneURL = server.URL + "/blob"
+
+	client := New("test-token")
+	client.baseURL = server.URL
+
+	object, err := client.CopyBlobObject(context.Background(), CopyBlobObjectRequest{
+		FromURL:            "https://store123.public.blob.vercel-storage.com/terraform/object.txt",
+		CacheControlMaxAge: 600,
+		ContentType:        "text/markdown",
+		Pathname:           "copies/object.txt",
+		StoreID:            "store_456",
+	})
+	if err != nil {
+		t.Fatalf("CopyBlobObject returned error: %v", err)
+	}
+	if copies != 1 {
+		t.Fatalf("expected 1 copy request, got %d", copies)
+	}
+	if object.Pathname != "copies/object.txt" || object.CacheControl != "public, max-age=600" {
+		t.Fatalf("unexpected copied object: %+v", object)
+	}
+}
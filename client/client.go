@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"net/http"
+	"sync"
 	"time"
 )
 
@@ -12,6 +13,10 @@ type Client struct {
 	client  *http.Client
 	team    Team
 	baseURL string
+
+	// edgeConfigSchemas caches Edge Config schemas read during plan, so that
+	// every item in an Edge Config does not fetch the schema again.
+	edgeConfigSchemas sync.Map // teamID/id -> cachedEdgeConfigSchema
 }
 
 func (c *Client) http() *http.Client {
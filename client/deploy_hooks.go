@@ -55,6 +55,35 @@ func (c *Client) CreateDeployHook(ctx context.Context, request CreateDeployHookR
 	return h, fmt.Errorf("deploy hook was created successfully, but could not be found")
 }
 
+type GetDeployHookRequest struct {
+	ProjectID string
+	TeamID    string
+	ID        string
+}
+
+// GetDeployHook retrieves a single deploy hook. Deploy hooks have no endpoint of their own,
+// so the hook is looked up from the git link of the project it belongs to.
+func (c *Client) GetDeployHook(ctx context.Context, request GetDeployHookRequest) (h DeployHook, err error) {
+	project, err := c.GetProject(ctx, request.ProjectID, request.TeamID)
+	if err != nil {
+		return h, err
+	}
+
+	if project.Link != nil {
+		for _, hook := range project.Link.DeployHooks {
+			if hook.ID == request.ID {
+				return hook, nil
+			}
+		}
+	}
+
+	return h, APIError{
+		StatusCode: 404,
+		Message:    "Deploy hook not found",
+		Code:       "not_found",
+	}
+}
+
 type DeleteDeployHookRequest struct {
 	ProjectID string
 	TeamID    string
@@ -66,10 +95,8 @@ func (c *Client) DeleteDeployHook(ctx context.Context, request DeleteDeployHookR
 	if c.TeamID(request.TeamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
 	}
-	payload := string(mustMarshal(request))
-	tflog.Info(ctx, "creating deploy hook", map[string]any{
-		"url":     url,
-		"payload": payload,
+	tflog.Info(ctx, "deleting deploy hook", map[string]any{
+		"url": url,
 	})
 
 	err := c.doRequest(clientRequest{
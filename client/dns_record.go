@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -15,12 +16,20 @@ type SRV struct {
 	Weight   int64  `json:"weight"`
 }
 
+// HTTPS defines the metadata for an HTTPS (service binding) record.
+type HTTPS struct {
+	Priority int64  `json:"priority"`
+	Target   string `json:"target"`
+	Params   string `json:"params,omitempty"`
+}
+
 // CreateDNSRecordRequest defines the information necessary to create a DNS record within Vercel.
 type CreateDNSRecordRequest struct {
 	Domain     string `json:"-"`
 	MXPriority int64  `json:"mxPriority,omitempty"`
 	Name       string `json:"name"`
 	SRV        *SRV   `json:"srv,omitempty"`
+	HTTPS      *HTTPS `json:"https,omitempty"`
 	TTL        int64  `json:"ttl,omitempty"`
 	Type       string `json:"type"`
 	Value      string `json:"value,omitempty"`
@@ -76,6 +85,7 @@ type DNSRecord struct {
 	Value      string `json:"value"`
 	RecordType string `json:"recordType"`
 	Priority   int64  `json:"priority"`
+	MXPriority int64  `json:"mxPriority"`
 	Comment    string `json:"comment"`
 }
 
@@ -96,29 +106,75 @@ func (c *Client) GetDNSRecord(ctx context.Context, recordID, teamID string) (r D
 	return r, err
 }
 
-// ListDNSRecords is a test helper for listing DNS records that exist for a given domain.
-// We limit this to 100, as this is the largest limit allowed by the API.
-// This is only used by the sweeper script, so this is safe to do so, but converting
-// into a production ready function would require some refactoring.
-func (c *Client) ListDNSRecords(ctx context.Context, domain, teamID string) (r []DNSRecord, err error) {
-	url := fmt.Sprintf("%s/v4/domains/%s/records?limit=100", c.baseURL, domain)
-	if c.TeamID(teamID) != "" {
-		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
+type ListDNSRecordsRequest struct {
+	Domain string
+	TeamID string
+	Limit  int
+	Until  *int64
+	Since  *int64
+}
+
+type ListDNSRecordsResponse struct {
+	Records    []DNSRecord
+	Pagination PageInfo
+}
+
+// ListDNSRecordsPage lists a single page of DNS records that exist for a given domain.
+func (c *Client) ListDNSRecordsPage(ctx context.Context, request ListDNSRecordsRequest) (ListDNSRecordsResponse, error) {
+	baseURL := fmt.Sprintf("%s/v4/domains/%s/records", c.baseURL, request.Domain)
+	query := url.Values{}
+	if c.TeamID(request.TeamID) != "" {
+		query.Set("teamId", c.TeamID(request.TeamID))
 	}
+	url := urlWithQuery(baseURL, paginationQuery(query, request.Limit, request.Until, request.Since))
 
+	// Unlike the single record endpoint, the list endpoint returns the record type as `type`,
+	// and the MX and SRV priorities in their own fields rather than as part of the value.
 	dr := struct {
-		Records []DNSRecord `json:"records"`
+		Records []struct {
+			DNSRecord
+			Type string `json:"type"`
+		} `json:"records"`
+		Pagination PageInfo `json:"pagination"`
 	}{}
-	err = c.doRequest(clientRequest{
+	tflog.Info(ctx, "listing DNS records", map[string]any{
+		"url": url,
+	})
+	err := c.doRequest(clientRequest{
 		ctx:    ctx,
 		method: "GET",
 		url:    url,
 		body:   "",
 	}, &dr)
-	for i := 0; i < len(dr.Records); i++ {
-		dr.Records[i].TeamID = c.TeamID(teamID)
+	records := make([]DNSRecord, 0, len(dr.Records))
+	for _, r := range dr.Records {
+		record := r.DNSRecord
+		if record.RecordType == "" {
+			record.RecordType = r.Type
+		}
+		record.TeamID = c.TeamID(request.TeamID)
+		if record.Domain == "" {
+			record.Domain = request.Domain
+		}
+		records = append(records, record)
 	}
-	return dr.Records, err
+	return ListDNSRecordsResponse{
+		Records:    records,
+		Pagination: dr.Pagination,
+	}, err
+}
+
+// ListDNSRecords lists all the DNS records that exist for a given domain, following pagination.
+func (c *Client) ListDNSRecords(ctx context.Context, domain, teamID string) ([]DNSRecord, error) {
+	return collectPages(func(until *int64) ([]DNSRecord, PageInfo, error) {
+		response, err := c.ListDNSRecordsPage(ctx, ListDNSRecordsRequest{
+			Domain: domain,
+			TeamID: teamID,
+			Limit:  defaultPaginationLimit,
+			Until:  until,
+		})
+		return response.Records, response.Pagination, err
+	})
 }
 
 // SRVUpdate defines the updatable fields within an SRV block of a DNS record.
@@ -134,6 +190,7 @@ type UpdateDNSRecordRequest struct {
 	MXPriority *int64     `json:"mxPriority,omitempty"`
 	Name       *string    `json:"name,omitempty"`
 	SRV        *SRVUpdate `json:"srv,omitempty"`
+	HTTPS      *HTTPS     `json:"https,omitempty"`
 	TTL        *int64     `json:"ttl,omitempty"`
 	Value      *string    `json:"value,omitempty"`
 	Comment    string     `json:"comment"`
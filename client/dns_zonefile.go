@@ -0,0 +1,453 @@
+package client
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// zoneFileToken is a single whitespace separated field within a zone file entry.
+type zoneFileToken struct {
+	value  string
+	quoted bool
+}
+
+// ParseZoneFile parses an RFC 1035 (BIND) zone file into DNS records for the given domain.
+// Names are made relative to the domain, so that the records can be passed directly to
+// CreateDNSRecord. SOA records are skipped, as they are managed by Vercel.
+func ParseZoneFile(domain string, r io.Reader) ([]CreateDNSRecordRequest, error) {
+	domain = strings.ToLower(strings.TrimSuffix(domain, "."))
+	entries, err := splitZoneFileEntries(r)
+	if err != nil {
+		return nil, err
+	}
+
+	origin := domain
+	var defaultTTL int64
+	var previousOwner string
+	var previousTTL int64
+	var records []CreateDNSRecordRequest
+	for _, entry := range entries {
+		tokens := entry.tokens
+		if len(tokens) == 0 {
+			continue
+		}
+
+		switch strings.ToUpper(tokens[0].value) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN expects a single domain name", entry.line)
+			}
+			origin = strings.ToLower(qualifyZoneFileName(tokens[1].value, origin))
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL expects a single value", entry.line)
+			}
+			ttl, err := parseZoneFileTTL(tokens[1].value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", entry.line, err)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: the %s directive is not supported", entry.line, tokens[0].value)
+		}
+
+		owner := previousOwner
+		if !entry.continuesOwner {
+			owner = qualifyZoneFileName(tokens[0].value, origin)
+			tokens = tokens[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", entry.line)
+		}
+		previousOwner = owner
+
+		ttl := int64(-1)
+		for len(tokens) > 0 {
+			if strings.EqualFold(tokens[0].value, "IN") {
+				tokens = tokens[1:]
+				continue
+			}
+			if t, err := parseZoneFileTTL(tokens[0].value); err == nil && !tokens[0].quoted {
+				ttl = t
+				tokens = tokens[1:]
+				continue
+			}
+			break
+		}
+		if len(tokens) == 0 {
+			return nil, fmt.Errorf("line %d: record has no type", entry.line)
+		}
+		switch {
+		case ttl >= 0:
+			previousTTL = ttl
+		case defaultTTL > 0:
+			ttl = defaultTTL
+		default:
+			ttl = previousTTL
+		}
+
+		recordType := strings.ToUpper(tokens[0].value)
+		rdata := tokens[1:]
+		if recordType == "SOA" {
+			continue
+		}
+
+		name, err := relativeZoneFileName(owner, domain)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", entry.line, err)
+		}
+		record := CreateDNSRecordRequest{
+			Domain:  domain,
+			Name:    name,
+			Type:    recordType,
+			TTL:     ttl,
+			Comment: entry.comment,
+		}
+		if err := parseZoneFileRData(&record, rdata, origin); err != nil {
+			return nil, fmt.Errorf("line %d: %s record: %w", entry.line, recordType, err)
+		}
+		records = append(records, record)
+	}
+	return records, nil
+}
+
+func parseZoneFileRData(record *CreateDNSRecordRequest, rdata []zoneFileToken, origin string) error {
+	expect := func(n int) error {
+		if len(rdata) != n {
+			return fmt.Errorf("expected %d fields, got %d", n, len(rdata))
+		}
+		return nil
+	}
+	switch record.Type {
+	case "A", "AAAA":
+		if err := expect(1); err != nil {
+			return err
+		}
+		ip := net.ParseIP(rdata[0].value)
+		if ip == nil || (record.Type == "A") != (ip.To4() != nil) {
+			return fmt.Errorf("%q is not a valid address", rdata[0].value)
+		}
+		record.Value = rdata[0].value
+	case "ALIAS", "CNAME", "NS":
+		if err := expect(1); err != nil {
+			return err
+		}
+		record.Value = strings.TrimSuffix(qualifyZoneFileName(rdata[0].value, origin), ".")
+	case "MX":
+		if err := expect(2); err != nil {
+			return err
+		}
+		priority, err := strconv.ParseInt(rdata[0].value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid priority %q", rdata[0].value)
+		}
+		record.MXPriority = priority
+		record.Value = strings.TrimSuffix(qualifyZoneFileName(rdata[1].value, origin), ".")
+	case "SRV":
+		if err := expect(4); err != nil {
+			return err
+		}
+		var fields [3]int64
+		for i := range fields {
+			v, err := strconv.ParseInt(rdata[i].value, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid number %q", rdata[i].value)
+			}
+			fields[i] = v
+		}
+		record.SRV = &SRV{
+			Priority: fields[0],
+			Weight:   fields[1],
+			Port:     fields[2],
+			Target:   strings.TrimSuffix(qualifyZoneFileName(rdata[3].value, origin), "."),
+		}
+	case "HTTPS":
+		if len(rdata) < 2 {
+			return fmt.Errorf("expected at least 2 fields, got %d", len(rdata))
+		}
+		priority, err := strconv.ParseInt(rdata[0].value, 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid priority %q", rdata[0].value)
+		}
+		target := rdata[1].value
+		if target != "." {
+			target = strings.TrimSuffix(qualifyZoneFileName(target, origin), ".")
+		}
+		// Quoted parameter values, such as alpn="h2,h3", are split off by the tokeniser.
+		var params []string
+		for _, t := range rdata[2:] {
+			if t.quoted && len(params) > 0 && strings.HasSuffix(params[len(params)-1], "=") {
+				params[len(params)-1] += strconv.Quote(t.value)
+				continue
+			}
+			params = append(params, t.value)
+		}
+		record.HTTPS = &HTTPS{
+			Priority: priority,
+			Target:   target,
+			Params:   strings.Join(params, " "),
+		}
+	case "TXT":
+		if len(rdata) == 0 {
+			return fmt.Errorf("expected at least one string")
+		}
+		var b strings.Builder
+		for _, t := range rdata {
+			b.WriteString(t.value)
+		}
+		record.Value = b.String()
+	case "CAA":
+		if err := expect(3); err != nil {
+			return err
+		}
+		record.Value = fmt.Sprintf("%s %s %s", rdata[0].value, rdata[1].value, strconv.Quote(rdata[2].value))
+	default:
+		return fmt.Errorf("unsupported record type")
+	}
+	return nil
+}
+
+// RenderZoneFile renders DNS records, as returned by ListDNSRecords, as an RFC 1035 zone file.
+// Records are sorted so that the output is stable.
+func RenderZoneFile(domain string, records []DNSRecord) string {
+	domain = strings.TrimSuffix(domain, ".")
+	sorted := append([]DNSRecord(nil), records...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Name != sorted[j].Name {
+			return sorted[i].Name < sorted[j].Name
+		}
+		if sorted[i].RecordType != sorted[j].RecordType {
+			return sorted[i].RecordType < sorted[j].RecordType
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s.\n", domain)
+	for _, record := range sorted {
+		owner := record.Name
+		if owner == "" {
+			owner = "@"
+		}
+		fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s", owner, record.TTL, record.RecordType, renderZoneFileRData(record))
+		if record.Comment != "" {
+			fmt.Fprintf(&b, " ; %s", strings.ReplaceAll(record.Comment, "\n", " "))
+		}
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+func renderZoneFileRData(record DNSRecord) string {
+	fields := strings.Fields(record.Value)
+	switch record.RecordType {
+	case "ALIAS", "CNAME", "NS":
+		return absoluteZoneFileName(record.Value)
+	case "MX":
+		if len(fields) == 2 {
+			return fmt.Sprintf("%s %s", fields[0], absoluteZoneFileName(fields[1]))
+		}
+		return fmt.Sprintf("%d %s", record.MXPriority, absoluteZoneFileName(record.Value))
+	case "SRV":
+		if len(fields) == 4 {
+			return fmt.Sprintf("%s %s %s %s", fields[0], fields[1], fields[2], absoluteZoneFileName(fields[3]))
+		}
+		if len(fields) == 3 {
+			return fmt.Sprintf("%d %s %s %s", record.Priority, fields[0], fields[1], absoluteZoneFileName(fields[2]))
+		}
+		return record.Value
+	case "HTTPS":
+		if len(fields) >= 2 {
+			fields[1] = absoluteZoneFileName(fields[1])
+			return strings.Join(fields, " ")
+		}
+		return record.Value
+	case "TXT":
+		return quoteZoneFileText(record.Value)
+	default:
+		return record.Value
+	}
+}
+
+// quoteZoneFileText quotes a TXT value, splitting it into the 255 byte character-strings
+// a single TXT record is made up of.
+func quoteZoneFileText(value string) string {
+	if value == "" {
+		return `""`
+	}
+	var parts []string
+	for len(value) > 0 {
+		n := min(len(value), 255)
+		chunk := value[:n]
+		value = value[n:]
+		chunk = strings.ReplaceAll(chunk, `\`, `\\`)
+		chunk = strings.ReplaceAll(chunk, `"`, `\"`)
+		parts = append(parts, `"`+chunk+`"`)
+	}
+	return strings.Join(parts, " ")
+}
+
+func absoluteZoneFileName(name string) string {
+	if name == "" || strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// qualifyZoneFileName makes a name absolute (without a trailing dot) using the current origin.
+func qualifyZoneFileName(name, origin string) string {
+	switch {
+	case name == "@":
+		return origin
+	case strings.HasSuffix(name, "."):
+		return strings.TrimSuffix(name, ".")
+	case origin == "":
+		return name
+	default:
+		return name + "." + origin
+	}
+}
+
+func relativeZoneFileName(name, domain string) (string, error) {
+	lower := strings.ToLower(name)
+	switch {
+	case lower == domain:
+		return "", nil
+	case strings.HasSuffix(lower, "."+domain):
+		return name[:len(name)-len(domain)-1], nil
+	default:
+		return "", fmt.Errorf("%q is not within the domain %s", name, domain)
+	}
+}
+
+// parseZoneFileTTL parses a TTL, either in seconds or using BIND unit suffixes such as 1h30m.
+func parseZoneFileTTL(value string) (int64, error) {
+	if value == "" {
+		return 0, fmt.Errorf("empty TTL")
+	}
+	if ttl, err := strconv.ParseInt(value, 10, 64); err == nil && ttl >= 0 {
+		return ttl, nil
+	}
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	var total, current int64
+	seenDigit := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= '0' && c <= '9':
+			current = current*10 + int64(c-'0')
+			seenDigit = true
+		case units[c|0x20] > 0 && seenDigit:
+			total += current * units[c|0x20]
+			current = 0
+			seenDigit = false
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+	}
+	if seenDigit {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	return total, nil
+}
+
+type zoneFileEntry struct {
+	line           int
+	continuesOwner bool
+	tokens         []zoneFileToken
+	comment        string
+}
+
+// splitZoneFileEntries tokenises a zone file, joining entries that span multiple lines with
+// parentheses and stripping comments.
+func splitZoneFileEntries(r io.Reader) ([]zoneFileEntry, error) {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	var entries []zoneFileEntry
+	var current *zoneFileEntry
+	depth := 0
+	lineNumber := 0
+	for scanner.Scan() {
+		lineNumber++
+		line := scanner.Text()
+		if current == nil {
+			current = &zoneFileEntry{
+				line:           lineNumber,
+				continuesOwner: len(line) > 0 && (line[0] == ' ' || line[0] == '\t'),
+			}
+		}
+
+		for i := 0; i < len(line); {
+			c := line[i]
+			switch {
+			case c == ' ' || c == '\t' || c == '\r':
+				i++
+			case c == ';':
+				comment := strings.TrimSpace(line[i+1:])
+				if comment != "" && current.comment == "" {
+					current.comment = comment
+				}
+				i = len(line)
+			case c == '(':
+				depth++
+				i++
+			case c == ')':
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parentheses", lineNumber)
+				}
+				depth--
+				i++
+			case c == '"':
+				var b strings.Builder
+				i++
+				closed := false
+				for i < len(line) {
+					if line[i] == '\\' && i+1 < len(line) {
+						b.WriteByte(line[i+1])
+						i += 2
+						continue
+					}
+					if line[i] == '"' {
+						closed = true
+						i++
+						break
+					}
+					b.WriteByte(line[i])
+					i++
+				}
+				if !closed {
+					return nil, fmt.Errorf("line %d: unterminated quoted string", lineNumber)
+				}
+				current.tokens = append(current.tokens, zoneFileToken{value: b.String(), quoted: true})
+			default:
+				start := i
+				for i < len(line) && !strings.ContainsRune(" \t\r;()\"", rune(line[i])) {
+					i++
+				}
+				current.tokens = append(current.tokens, zoneFileToken{value: line[start:i]})
+			}
+		}
+
+		if depth == 0 {
+			if len(current.tokens) > 0 {
+				entries = append(entries, *current)
+			}
+			current = nil
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced parentheses at end of zone file")
+	}
+	return entries, nil
+}
@@ -0,0 +1,106 @@
+package client
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseZoneFile(t *testing.T) {
+	zone := `
+$ORIGIN example.com.
+$TTL 300
+@       IN  SOA  ns1.vercel-dns.com. hostmaster.example.com. (
+                 2024010101 ; serial
+                 7200 3600 1209600 3600 )
+@           IN  A      76.76.21.21 ; apex
+            IN  AAAA   2001:db8::1
+www    3600 IN  CNAME  cname.vercel-dns.com.
+blog        IN  ALIAS  blog.example.net.
+@           IN  MX     10 mail
+_sip._tcp   IN  SRV    10 5 5060 sip.example.com.
+@           IN  TXT    "v=spf1 include:_spf.example.com ~all"
+long        IN  TXT    "first " "second"
+@           IN  CAA    0 issue "letsencrypt.org"
+sub.example.com. 1h IN NS ns1.example.org.
+svc         IN  HTTPS  1 . alpn="h2,h3" port=443
+`
+	records, err := ParseZoneFile("example.com", strings.NewReader(zone))
+	if err != nil {
+		t.Fatalf("ParseZoneFile() error = %v", err)
+	}
+
+	want := []CreateDNSRecordRequest{
+		{Domain: "example.com", Name: "", Type: "A", TTL: 300, Value: "76.76.21.21", Comment: "apex"},
+		{Domain: "example.com", Name: "", Type: "AAAA", TTL: 300, Value: "2001:db8::1"},
+		{Domain: "example.com", Name: "www", Type: "CNAME", TTL: 3600, Value: "cname.vercel-dns.com"},
+		{Domain: "example.com", Name: "blog", Type: "ALIAS", TTL: 300, Value: "blog.example.net"},
+		{Domain: "example.com", Name: "", Type: "MX", TTL: 300, MXPriority: 10, Value: "mail.example.com"},
+		{Domain: "example.com", Name: "_sip._tcp", Type: "SRV", TTL: 300, SRV: &SRV{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"}},
+		{Domain: "example.com", Name: "", Type: "TXT", TTL: 300, Value: "v=spf1 include:_spf.example.com ~all"},
+		{Domain: "example.com", Name: "long", Type: "TXT", TTL: 300, Value: "first second"},
+		{Domain: "example.com", Name: "", Type: "CAA", TTL: 300, Value: `0 issue "letsencrypt.org"`},
+		{Domain: "example.com", Name: "sub", Type: "NS", TTL: 3600, Value: "ns1.example.org"},
+		{Domain: "example.com", Name: "svc", Type: "HTTPS", TTL: 300, HTTPS: &HTTPS{Priority: 1, Target: ".", Params: `alpn="h2,h3" port=443`}},
+	}
+	if !reflect.DeepEqual(records, want) {
+		t.Fatalf("ParseZoneFile() =\n%+v\nwant\n%+v", records, want)
+	}
+}
+
+func TestParseZoneFileErrors(t *testing.T) {
+	tests := map[string]string{
+		"outside domain":   "www.example.org. 60 IN A 1.2.3.4",
+		"invalid address":  "www 60 IN A 2001:db8::1",
+		"unsupported type": "www 60 IN SSHFP 1 1 abcdef",
+		"unbalanced":       "www 60 IN TXT ( \"a\"",
+		"unterminated":     "www 60 IN TXT \"a",
+		"include":          "$INCLUDE other.zone",
+		"mx fields":        "@ 60 IN MX mail.example.com.",
+	}
+	for name, zone := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseZoneFile("example.com", strings.NewReader(zone)); err == nil {
+				t.Fatalf("ParseZoneFile(%q) succeeded, want error", zone)
+			}
+		})
+	}
+}
+
+func TestRenderZoneFileRoundTrips(t *testing.T) {
+	records := []DNSRecord{
+		{Name: "www", RecordType: "CNAME", TTL: 60, Value: "cname.vercel-dns.com"},
+		{Name: "", RecordType: "A", TTL: 60, Value: "76.76.21.21", Comment: "apex"},
+		{Name: "", RecordType: "MX", TTL: 60, Value: "mail.example.com.", MXPriority: 10},
+		{Name: "_sip._tcp", RecordType: "SRV", TTL: 60, Value: "5 5060 sip.example.com", Priority: 10},
+		{Name: "", RecordType: "TXT", TTL: 60, Value: `say "hi" \o/`},
+		{Name: "", RecordType: "CAA", TTL: 60, Value: `0 issue "letsencrypt.org"`},
+		{Name: "svc", RecordType: "HTTPS", TTL: 60, Value: "1 svc.example.net alpn=h2"},
+	}
+
+	rendered := RenderZoneFile("example.com", records)
+	wantLines := []string{
+		"$ORIGIN example.com.",
+		"@\t60\tIN\tA\t76.76.21.21 ; apex",
+		"@\t60\tIN\tCAA\t0 issue \"letsencrypt.org\"",
+		"@\t60\tIN\tMX\t10 mail.example.com.",
+		"@\t60\tIN\tTXT\t\"say \\\"hi\\\" \\\\o/\"",
+		"_sip._tcp\t60\tIN\tSRV\t10 5 5060 sip.example.com.",
+		"svc\t60\tIN\tHTTPS\t1 svc.example.net. alpn=h2",
+		"www\t60\tIN\tCNAME\tcname.vercel-dns.com.",
+	}
+	if got := strings.Split(strings.TrimSuffix(rendered, "\n"), "\n"); !reflect.DeepEqual(got, wantLines) {
+		t.Fatalf("RenderZoneFile() =\n%s\nwant\n%s", strings.Join(got, "\n"), strings.Join(wantLines, "\n"))
+	}
+
+	parsed, err := ParseZoneFile("example.com", strings.NewReader(rendered))
+	if err != nil {
+		t.Fatalf("ParseZoneFile() error = %v", err)
+	}
+	if len(parsed) != len(records) {
+		t.Fatalf("round trip produced %d records, want %d", len(parsed), len(records))
+	}
+	if parsed[3].Value != `say "hi" \o/` {
+		t.Fatalf("TXT value = %q, want it unescaped", parsed[3].Value)
+	}
+}
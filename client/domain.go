@@ -0,0 +1,279 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Domain is the information Vercel surfaces about a domain that has been added to a team or personal account.
+type Domain struct {
+	ID                  string   `json:"id"`
+	Name                string   `json:"name"`
+	TeamID              string   `json:"-"`
+	ServiceType         string   `json:"serviceType"`
+	Verified            bool     `json:"verified"`
+	Nameservers         []string `json:"nameservers"`
+	IntendedNameservers []string `json:"intendedNameservers"`
+	CustomNameservers   []string `json:"customNameservers"`
+	Zone                bool     `json:"zone"`
+	Renew               *bool    `json:"renew"`
+	CreatedAt           int64    `json:"createdAt"`
+	ExpiresAt           *int64   `json:"expiresAt"`
+	BoughtAt            *int64   `json:"boughtAt"`
+	TransferStartedAt   *int64   `json:"transferStartedAt"`
+	TransferredAt       *int64   `json:"transferredAt"`
+}
+
+// UsesVercelNameservers reports whether Vercel is the intended DNS provider for the domain.
+func (d Domain) UsesVercelNameservers() bool {
+	return d.ServiceType == "zeit.world"
+}
+
+// RegisteredWithVercel reports whether the domain was purchased from, or transferred in to, Vercel.
+func (d Domain) RegisteredWithVercel() bool {
+	return d.BoughtAt != nil || d.TransferStartedAt != nil
+}
+
+// TransferStatus summarises the state of an inbound registrar transfer for the domain.
+func (d Domain) TransferStatus() string {
+	switch {
+	case d.TransferredAt != nil:
+		return "completed"
+	case d.TransferStartedAt != nil:
+		return "pending"
+	default:
+		return "none"
+	}
+}
+
+// CreateDomainRequest defines the information necessary to add a domain to a team or personal account.
+// Method is one of `add`, `move-in` or `transfer-in`.
+type CreateDomainRequest struct {
+	TeamID        string   `json:"-"`
+	Name          string   `json:"name"`
+	Method        string   `json:"method"`
+	Zone          *bool    `json:"zone,omitempty"`
+	Token         string   `json:"token,omitempty"`
+	AuthCode      string   `json:"authCode,omitempty"`
+	ExpectedPrice *float64 `json:"expectedPrice,omitempty"`
+}
+
+// CreateDomain adds a domain to a team or personal account.
+func (c *Client) CreateDomain(ctx context.Context, request CreateDomainRequest) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v7/domains", c.baseURL)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
+	}
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "creating domain", map[string]any{
+		"url":    url,
+		"domain": request.Name,
+		"method": request.Method,
+	})
+
+	var response struct {
+		Domain Domain `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   payload,
+	}, &response)
+	if err != nil {
+		return d, err
+	}
+
+	// The create response omits several fields, so read the domain back in full.
+	return c.GetDomain(ctx, request.Name, request.TeamID)
+}
+
+// GetDomain retrieves information about a domain from Vercel.
+func (c *Client) GetDomain(ctx context.Context, domain, teamID string) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v5/domains/%s", c.baseURL, domain)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "getting domain", map[string]any{
+		"url": url,
+	})
+
+	var response struct {
+		Domain Domain `json:"domain"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &response)
+	if err != nil {
+		return d, err
+	}
+	response.Domain.TeamID = c.TeamID(teamID)
+	return response.Domain, nil
+}
+
+type ListDomainsRequest struct {
+	TeamID string
+	Limit  int
+	Until  *int64
+	Since  *int64
+}
+
+type ListDomainsResponse struct {
+	Domains    []Domain
+	Pagination PageInfo
+}
+
+// ListDomainsPage lists a single page of the domains within a team or personal account.
+func (c *Client) ListDomainsPage(ctx context.Context, request ListDomainsRequest) (ListDomainsResponse, error) {
+	baseURL := fmt.Sprintf("%s/v5/domains", c.baseURL)
+	query := url.Values{}
+	if c.TeamID(request.TeamID) != "" {
+		query.Set("teamId", c.TeamID(request.TeamID))
+	}
+	url := urlWithQuery(baseURL, paginationQuery(query, request.Limit, request.Until, request.Since))
+	tflog.Info(ctx, "listing domains", map[string]any{
+		"url": url,
+	})
+
+	response := struct {
+		Domains    []Domain `json:"domains"`
+		Pagination PageInfo `json:"pagination"`
+	}{}
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &response)
+	for i := range response.Domains {
+		response.Domains[i].TeamID = c.TeamID(request.TeamID)
+	}
+	return ListDomainsResponse{
+		Domains:    response.Domains,
+		Pagination: response.Pagination,
+	}, err
+}
+
+// ListDomains lists all the domains within a team or personal account, following pagination.
+func (c *Client) ListDomains(ctx context.Context, teamID string) ([]Domain, error) {
+	return collectPages(func(until *int64) ([]Domain, PageInfo, error) {
+		response, err := c.ListDomainsPage(ctx, ListDomainsRequest{
+			TeamID: teamID,
+			Limit:  defaultPaginationLimit,
+			Until:  until,
+		})
+		return response.Domains, response.Pagination, err
+	})
+}
+
+// UpdateDomainRequest defines the settings that can be changed on an existing domain.
+// Renew and CustomNameservers only apply to domains registered through Vercel.
+type UpdateDomainRequest struct {
+	Op                string    `json:"op"`
+	Zone              *bool     `json:"zone,omitempty"`
+	Renew             *bool     `json:"renew,omitempty"`
+	CustomNameservers *[]string `json:"customNameservers,omitempty"`
+}
+
+// UpdateDomain changes the nameserver and renewal settings of a domain.
+func (c *Client) UpdateDomain(ctx context.Context, domain, teamID string, request UpdateDomainRequest) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v3/domains/%s", c.baseURL, domain)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	request.Op = "update"
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "updating domain", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, nil)
+	if err != nil {
+		return d, err
+	}
+	return c.GetDomain(ctx, domain, teamID)
+}
+
+// MoveDomain moves a domain from one team or personal account to another. The caller must be a
+// member of both the source and destination.
+func (c *Client) MoveDomain(ctx context.Context, domain, fromTeamID, toTeamID string) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v3/domains/%s", c.baseURL, domain)
+	if c.TeamID(fromTeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(fromTeamID))
+	}
+	payload := string(mustMarshal(struct {
+		Op          string `json:"op"`
+		Destination string `json:"destination"`
+	}{
+		Op:          "move-out",
+		Destination: toTeamID,
+	}))
+	tflog.Info(ctx, "moving domain", map[string]any{
+		"url":     url,
+		"payload": payload,
+	})
+
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PATCH",
+		url:    url,
+		body:   payload,
+	}, nil)
+	if err != nil {
+		return d, err
+	}
+	return c.GetDomain(ctx, domain, toTeamID)
+}
+
+// VerifyDomain asks Vercel to re-check the verification status of a domain. A domain that
+// still fails verification is not treated as an error; inspect Verified on the result instead.
+func (c *Client) VerifyDomain(ctx context.Context, domain, teamID string) (d Domain, err error) {
+	url := fmt.Sprintf("%s/v4/domains/%s/verify", c.baseURL, domain)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "verifying domain", map[string]any{
+		"url": url,
+	})
+
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "POST",
+		url:    url,
+		body:   "",
+	}, nil)
+	if err != nil && !domainVerificationFailed(err) {
+		return d, err
+	}
+	return c.GetDomain(ctx, domain, teamID)
+}
+
+// DeleteDomain removes a domain, and all of its DNS records, from Vercel.
+func (c *Client) DeleteDomain(ctx context.Context, domain, teamID string) error {
+	url := fmt.Sprintf("%s/v6/domains/%s", c.baseURL, domain)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "deleting domain", map[string]any{
+		"url": url,
+	})
+
+	return c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "DELETE",
+		url:    url,
+		body:   "",
+	}, nil)
+}
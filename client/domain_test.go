@@ -0,0 +1,112 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"testing"
+)
+
+func TestListDomainsPaginates(t *testing.T) {
+	client := newPaginationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v5/domains" {
+			t.Fatalf("path = %q, want /v5/domains", r.URL.Path)
+		}
+		requireQuery(t, r, "teamId", "team_123")
+		requireQuery(t, r, "limit", "100")
+
+		switch r.URL.Query().Get("until") {
+		case "":
+			fmt.Fprintln(w, `{
+				"domains": [{"id":"dom_1","name":"example.com","serviceType":"zeit.world","verified":true}],
+				"pagination": {"count":1,"next":123}
+			}`)
+		case "123":
+			fmt.Fprintln(w, `{
+				"domains": [{"id":"dom_2","name":"example.org","serviceType":"external","transferStartedAt":1700000000000}],
+				"pagination": {"count":1}
+			}`)
+		default:
+			t.Fatalf("unexpected until %q", r.URL.Query().Get("until"))
+		}
+	})
+
+	domains, err := client.ListDomains(context.Background(), "team_123")
+	if err != nil {
+		t.Fatalf("ListDomains() error = %v", err)
+	}
+
+	if got, want := []string{domains[0].Name, domains[1].Name}, []string{"example.com", "example.org"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("domain names = %#v, want %#v", got, want)
+	}
+	if domains[0].TeamID != "team_123" || domains[1].TeamID != "team_123" {
+		t.Fatalf("TeamID values = %q, %q; want team_123", domains[0].TeamID, domains[1].TeamID)
+	}
+	if !domains[0].UsesVercelNameservers() || domains[1].UsesVercelNameservers() {
+		t.Fatal("UsesVercelNameservers() did not reflect serviceType")
+	}
+	if got := domains[1].TransferStatus(); got != "pending" {
+		t.Fatalf("TransferStatus() = %q, want pending", got)
+	}
+}
+
+func TestMoveDomainMovesOutAndReadsFromDestination(t *testing.T) {
+	var moved bool
+	client := newPaginationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPatch && r.URL.Path == "/v3/domains/example.com":
+			requireQuery(t, r, "teamId", "team_from")
+			var body map[string]string
+			b, _ := io.ReadAll(r.Body)
+			if err := json.Unmarshal(b, &body); err != nil {
+				t.Fatalf("invalid body %s: %v", b, err)
+			}
+			if body["op"] != "move-out" || body["destination"] != "team_to" {
+				t.Fatalf("body = %#v, want move-out to team_to", body)
+			}
+			moved = true
+			fmt.Fprintln(w, `{"moved":true}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v5/domains/example.com":
+			requireQuery(t, r, "teamId", "team_to")
+			fmt.Fprintln(w, `{"domain":{"id":"dom_1","name":"example.com"}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	domain, err := client.MoveDomain(context.Background(), "example.com", "team_from", "team_to")
+	if err != nil {
+		t.Fatalf("MoveDomain() error = %v", err)
+	}
+	if !moved {
+		t.Fatal("MoveDomain() did not move the domain out")
+	}
+	if domain.TeamID != "team_to" {
+		t.Fatalf("TeamID = %q, want team_to", domain.TeamID)
+	}
+}
+
+func TestVerifyDomainToleratesFailedVerification(t *testing.T) {
+	client := newPaginationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/v4/domains/example.com/verify":
+			w.WriteHeader(http.StatusBadRequest)
+			fmt.Fprintln(w, `{"error":{"code":"verification_failed","message":"The domain could not be verified"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v5/domains/example.com":
+			fmt.Fprintln(w, `{"domain":{"id":"dom_1","name":"example.com","verified":false}}`)
+		default:
+			t.Fatalf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+	})
+
+	domain, err := client.VerifyDomain(context.Background(), "example.com", "")
+	if err != nil {
+		t.Fatalf("VerifyDomain() error = %v", err)
+	}
+	if domain.Verified {
+		t.Fatal("Verified = true, want false")
+	}
+}
@@ -11,6 +11,8 @@ type EdgeConfig struct {
 	Slug   string `json:"slug"`
 	ID     string `json:"id"`
 	TeamID string `json:"ownerId"`
+	// Digest changes whenever the items of the Edge Config change.
+	Digest string `json:"digest"`
 }
 
 type CreateEdgeConfigRequest struct {
@@ -0,0 +1,119 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const edgeConfigBackupsPageLimit = 50
+
+// EdgeConfigBackupVersion is an entry in the list of backups of an Edge
+// Config. A backup is kept each time the items of the Edge Config change.
+type EdgeConfigBackupVersion struct {
+	ID string `json:"id"`
+	// LastModified is the Unix timestamp, in milliseconds, when the backup
+	// was taken.
+	LastModified int64 `json:"lastModified"`
+}
+
+type EdgeConfigBackupItem struct {
+	Value       json.RawMessage `json:"value"`
+	Description string          `json:"description"`
+}
+
+type EdgeConfigBackupContent struct {
+	Digest    string                          `json:"digest"`
+	Items     map[string]EdgeConfigBackupItem `json:"items"`
+	UpdatedAt int64                           `json:"updatedAt"`
+}
+
+// EdgeConfigBackup is a previous version of the items of an Edge Config.
+type EdgeConfigBackup struct {
+	ID           string                  `json:"id"`
+	LastModified int64                   `json:"lastModified"`
+	Backup       EdgeConfigBackupContent `json:"backup"`
+	EdgeConfigID string                  `json:"-"`
+	TeamID       string                  `json:"-"`
+}
+
+// ListEdgeConfigBackups returns the backups of an Edge Config, most recent
+// first. If limit is greater than zero, at most limit backups are returned.
+func (c *Client) ListEdgeConfigBackups(ctx context.Context, edgeConfigID, teamID string, limit int) ([]EdgeConfigBackupVersion, error) {
+	query := url.Values{}
+	if tid := c.TeamID(teamID); tid != "" {
+		query.Set("teamId", tid)
+	}
+	pageLimit := edgeConfigBackupsPageLimit
+	if limit > 0 && limit < pageLimit {
+		pageLimit = limit
+	}
+	query.Set("limit", strconv.Itoa(pageLimit))
+
+	var all []EdgeConfigBackupVersion
+	for {
+		var res struct {
+			Backups    []EdgeConfigBackupVersion `json:"backups"`
+			Pagination struct {
+				HasNext bool   `json:"hasNext"`
+				Next    string `json:"next"`
+			} `json:"pagination"`
+		}
+		requestURL := urlWithQuery(fmt.Sprintf("%s/v1/edge-config/%s/backups", c.baseURL, edgeConfigID), query)
+		tflog.Info(ctx, "listing edge config backups", map[string]any{
+			"url": requestURL,
+		})
+		err := c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    requestURL,
+		}, &res)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, res.Backups...)
+
+		if limit > 0 && len(all) >= limit {
+			return all[:limit], nil
+		}
+		if !res.Pagination.HasNext || res.Pagination.Next == "" {
+			return all, nil
+		}
+		if res.Pagination.Next == query.Get("next") {
+			return nil, fmt.Errorf("pagination cursor did not advance")
+		}
+		query.Set("next", res.Pagination.Next)
+	}
+}
+
+// GetEdgeConfigBackup returns a backup of an Edge Config, including the items
+// it holds.
+func (c *Client) GetEdgeConfigBackup(ctx context.Context, edgeConfigID, backupID, teamID string) (b EdgeConfigBackup, err error) {
+	url := fmt.Sprintf("%s/v1/edge-config/%s/backups/%s", c.baseURL, edgeConfigID, backupID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+	tflog.Info(ctx, "getting edge config backup", map[string]any{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:              ctx,
+		method:           "GET",
+		url:              url,
+		errorOnNoContent: true,
+	}, &b)
+	if noContent(err) {
+		return b, APIError{
+			StatusCode: 404,
+			Message:    "Edge Config Backup not found",
+			Code:       "not_found",
+		}
+	}
+	b.EdgeConfigID = edgeConfigID
+	b.TeamID = c.TeamID(teamID)
+	return b, err
+}
@@ -0,0 +1,89 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestListEdgeConfigBackups(t *testing.T) {
+	pages := map[string]string{
+		"":       `{"backups":[{"id":"b3","lastModified":3},{"id":"b2","lastModified":2}],"pagination":{"hasNext":true,"next":"page_2"}}`,
+		"page_2": `{"backups":[{"id":"b1","lastModified":1}],"pagination":{"hasNext":false}}`,
+	}
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/edge-config/ecfg_123/backups" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("teamId"); got != "team_123" {
+			t.Errorf("teamId = %q, want team_123", got)
+		}
+		page, ok := pages[r.URL.Query().Get("next")]
+		if !ok {
+			t.Fatalf("unexpected cursor %q", r.URL.Query().Get("next"))
+		}
+		fmt.Fprint(w, page)
+	}))
+	t.Cleanup(h.Close)
+	cl := client.New("INVALID").WithBaseURL(fmt.Sprintf("http://%s", h.Listener.Addr().String()))
+
+	for _, tt := range []struct {
+		limit int
+		want  []string
+	}{
+		{limit: 0, want: []string{"b3", "b2", "b1"}},
+		{limit: 1, want: []string{"b3"}},
+		{limit: 3, want: []string{"b3", "b2", "b1"}},
+	} {
+		t.Run(fmt.Sprintf("limit=%d", tt.limit), func(t *testing.T) {
+			backups, err := cl.ListEdgeConfigBackups(context.Background(), "ecfg_123", "team_123", tt.limit)
+			if err != nil {
+				t.Fatalf("ListEdgeConfigBackups() error = %s", err)
+			}
+			if len(backups) != len(tt.want) {
+				t.Fatalf("got %d backups, want %d", len(backups), len(tt.want))
+			}
+			for i, id := range tt.want {
+				if backups[i].ID != id {
+					t.Errorf("backup %d = %q, want %q", i, backups[i].ID, id)
+				}
+			}
+		})
+	}
+}
+
+func TestGetEdgeConfigBackup(t *testing.T) {
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet || r.URL.Path != "/v1/edge-config/ecfg_123/backups/b1" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		fmt.Fprint(w, `{
+			"id": "b1",
+			"lastModified": 1700000000000,
+			"backup": {
+				"digest": "digest_1",
+				"items": {"greeting": {"value": "hello"}, "flags": {"value": {"enabled": true}}}
+			}
+		}`)
+	}))
+	t.Cleanup(h.Close)
+	cl := client.New("INVALID").WithBaseURL(fmt.Sprintf("http://%s", h.Listener.Addr().String()))
+
+	backup, err := cl.GetEdgeConfigBackup(context.Background(), "ecfg_123", "b1", "team_123")
+	if err != nil {
+		t.Fatalf("GetEdgeConfigBackup() error = %s", err)
+	}
+	if backup.Backup.Digest != "digest_1" || backup.LastModified != 1700000000000 {
+		t.Errorf("unexpected backup %+v", backup)
+	}
+	if got := string(backup.Backup.Items["flags"].Value); got != `{"enabled": true}` {
+		t.Errorf("flags = %s", got)
+	}
+	if backup.EdgeConfigID != "ecfg_123" || backup.TeamID != "team_123" {
+		t.Errorf("backup is for %s %s, want ecfg_123 team_123", backup.TeamID, backup.EdgeConfigID)
+	}
+}
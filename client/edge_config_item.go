@@ -12,12 +12,16 @@ type EdgeConfigOperation struct {
 	Operation string          `json:"operation"`
 	Key       string          `json:"key"`
 	Value     json.RawMessage `json:"value,omitempty"`
+	// Description, if set, replaces the description of the item. An empty
+	// string clears it, while nil leaves it unchanged.
+	Description *string `json:"description,omitempty"`
 }
 
 type EdgeConfigItem struct {
 	TeamID       string          `json:"-"`
 	Key          string          `json:"key"`
 	Value        json.RawMessage `json:"value"`
+	Description  string          `json:"description"`
 	EdgeConfigID string          `json:"edgeConfigId"`
 }
 
@@ -135,3 +139,68 @@ func (c *Client) GetEdgeConfigItem(ctx context.Context, request EdgeConfigItemRe
 	e.TeamID = c.TeamID(request.TeamID)
 	return e, err
 }
+
+// ListEdgeConfigItems returns every item in an Edge Config.
+func (c *Client) ListEdgeConfigItems(ctx context.Context, edgeConfigID, teamID string) (items []EdgeConfigItem, err error) {
+	url := fmt.Sprintf("%s/v1/edge-config/%s/items", c.baseURL, edgeConfigID)
+	if c.TeamID(teamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
+	}
+
+	tflog.Info(ctx, "listing edge config items", map[string]any{
+		"url": url,
+	})
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &items)
+	for i := range items {
+		items[i].TeamID = c.TeamID(teamID)
+	}
+	return items, err
+}
+
+type UpdateEdgeConfigItemsRequest struct {
+	EdgeConfigID string
+	TeamID       string
+	Items        []EdgeConfigOperation
+	// Digest, if set, makes the update conditional: it is rejected with a
+	// 412 if the items of the Edge Config have changed since the digest was
+	// read.
+	Digest string
+}
+
+// UpdateEdgeConfigItems applies a batch of operations to the items of an Edge
+// Config in a single request.
+func (c *Client) UpdateEdgeConfigItems(ctx context.Context, request UpdateEdgeConfigItemsRequest) error {
+	url := fmt.Sprintf("%s/v1/edge-config/%s/items", c.baseURL, request.EdgeConfigID)
+	if c.TeamID(request.TeamID) != "" {
+		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
+	}
+
+	payload := string(mustMarshal(
+		struct {
+			Items []EdgeConfigOperation `json:"items"`
+		}{
+			Items: request.Items,
+		},
+	))
+	var headers map[string]string
+	if request.Digest != "" {
+		headers = map[string]string{"If-Match": request.Digest}
+	}
+
+	tflog.Info(ctx, "updating edge config items", map[string]any{
+		"url":        url,
+		"operations": len(request.Items),
+		"digest":     request.Digest,
+	})
+	return c.doRequest(clientRequest{
+		ctx:     ctx,
+		method:  "PATCH",
+		url:     url,
+		body:    payload,
+		headers: headers,
+	}, nil)
+}
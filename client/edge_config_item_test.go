@@ -0,0 +1,69 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestUpdateEdgeConfigItems(t *testing.T) {
+	for _, digest := range []string{"", "digest_123"} {
+		t.Run(fmt.Sprintf("digest=%q", digest), func(t *testing.T) {
+			requests := 0
+			h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				requests++
+				if r.Method != http.MethodPatch || r.URL.Path != "/v1/edge-config/ecfg_123/items" {
+					t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+				}
+				if got := r.URL.Query().Get("teamId"); got != "team_123" {
+					t.Errorf("teamId = %q, want team_123", got)
+				}
+				if got := r.Header.Get("If-Match"); got != digest {
+					t.Errorf("If-Match = %q, want %q", got, digest)
+				}
+
+				body, _ := io.ReadAll(r.Body)
+				var payload struct {
+					Items []client.EdgeConfigOperation `json:"items"`
+				}
+				if err := json.Unmarshal(body, &payload); err != nil {
+					t.Fatalf("could not decode payload %s: %v", body, err)
+				}
+				if len(payload.Items) != 3 {
+					t.Fatalf("got %d operations, want 3: %s", len(payload.Items), body)
+				}
+				for i, want := range []string{"create", "update", "delete"} {
+					if payload.Items[i].Operation != want {
+						t.Errorf("operation %d = %q, want %q", i, payload.Items[i].Operation, want)
+					}
+				}
+				fmt.Fprint(w, `{"status":"ok"}`)
+			}))
+			t.Cleanup(h.Close)
+
+			cl := client.New("INVALID").WithBaseURL(fmt.Sprintf("http://%s", h.Listener.Addr().String()))
+			err := cl.UpdateEdgeConfigItems(context.Background(), client.UpdateEdgeConfigItemsRequest{
+				EdgeConfigID: "ecfg_123",
+				TeamID:       "team_123",
+				Digest:       digest,
+				Items: []client.EdgeConfigOperation{
+					{Operation: "create", Key: "a", Value: json.RawMessage(`1`)},
+					{Operation: "update", Key: "b", Value: json.RawMessage(`{"enabled":true}`)},
+					{Operation: "delete", Key: "c"},
+				},
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if requests != 1 {
+				t.Errorf("got %d requests, want 1", requests)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ func (c *Client) UpsertEdgeConfigSchema(ctx context.Context, request EdgeConfigS
 	if c.TeamID(request.TeamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(request.TeamID))
 	}
+	c.edgeConfigSchemas.Delete(c.edgeConfigSchemaCacheKey(request.ID, request.TeamID))
 	payload := string(mustMarshal(request))
 	tflog.Info(ctx, "creating edge config schema", map[string]any{
 		"url":     url,
@@ -62,11 +63,37 @@ func (c *Client) GetEdgeConfigSchema(ctx context.Context, id, teamID string) (e
 	return e, err
 }
 
+type cachedEdgeConfigSchema struct {
+	schema EdgeConfigSchema
+	err    error
+}
+
+func (c *Client) edgeConfigSchemaCacheKey(id, teamID string) string {
+	return c.TeamID(teamID) + "/" + id
+}
+
+// GetEdgeConfigSchemaCached is GetEdgeConfigSchema, but reads each schema from
+// the API only once for the lifetime of the client. A missing schema is cached
+// too, and writing or deleting a schema through the client drops its entry.
+func (c *Client) GetEdgeConfigSchemaCached(ctx context.Context, id, teamID string) (EdgeConfigSchema, error) {
+	key := c.edgeConfigSchemaCacheKey(id, teamID)
+	if cached, ok := c.edgeConfigSchemas.Load(key); ok {
+		return cached.(cachedEdgeConfigSchema).schema, cached.(cachedEdgeConfigSchema).err
+	}
+
+	e, err := c.GetEdgeConfigSchema(ctx, id, teamID)
+	if err == nil || NotFound(err) {
+		c.edgeConfigSchemas.Store(key, cachedEdgeConfigSchema{schema: e, err: err})
+	}
+	return e, err
+}
+
 func (c *Client) DeleteEdgeConfigSchema(ctx context.Context, id, teamID string) error {
 	url := fmt.Sprintf("%s/v1/edge-config/%s/schema", c.baseURL, id)
 	if c.TeamID(teamID) != "" {
 		url = fmt.Sprintf("%s?teamId=%s", url, c.TeamID(teamID))
 	}
+	c.edgeConfigSchemas.Delete(c.edgeConfigSchemaCacheKey(id, teamID))
 	tflog.Info(ctx, "deleting edge config schema", map[string]any{
 		"url": url,
 	})
@@ -0,0 +1,52 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestGetEdgeConfigSchemaCached(t *testing.T) {
+	gets := 0
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/edge-config/ecfg_123/schema" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		switch r.Method {
+		case http.MethodGet:
+			gets++
+			fmt.Fprint(w, `{"definition":{"type":"object"}}`)
+		case http.MethodPost:
+			fmt.Fprint(w, `{"definition":{"type":"object"}}`)
+		default:
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+	}))
+	t.Cleanup(h.Close)
+	cl := client.New("INVALID").WithBaseURL(fmt.Sprintf("http://%s", h.Listener.Addr().String()))
+	ctx := context.Background()
+
+	for range 3 {
+		if _, err := cl.GetEdgeConfigSchemaCached(ctx, "ecfg_123", "team_123"); err != nil {
+			t.Fatalf("GetEdgeConfigSchemaCached() error = %s", err)
+		}
+	}
+	if gets != 1 {
+		t.Fatalf("got %d schema reads, want 1", gets)
+	}
+
+	// Writing the schema drops the cached copy.
+	if _, err := cl.UpsertEdgeConfigSchema(ctx, client.EdgeConfigSchema{ID: "ecfg_123", TeamID: "team_123", Definition: map[string]any{"type": "object"}}); err != nil {
+		t.Fatalf("UpsertEdgeConfigSchema() error = %s", err)
+	}
+	if _, err := cl.GetEdgeConfigSchemaCached(ctx, "ecfg_123", "team_123"); err != nil {
+		t.Fatalf("GetEdgeConfigSchemaCached() error = %s", err)
+	}
+	if gets != 2 {
+		t.Fatalf("got %d schema reads, want 2", gets)
+	}
+}
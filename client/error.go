@@ -24,6 +24,11 @@ func noContent(err error) bool {
 	return err != nil && errors.As(err, &apiErr) && apiErr.StatusCode == 204
 }
 
+func domainVerificationFailed(err error) bool {
+	var apiErr APIError
+	return err != nil && errors.As(err, &apiErr) && apiErr.StatusCode == 400 && apiErr.Code == "verification_failed"
+}
+
 func conflictingSharedEnv(err error) bool {
 	var apiErr APIError
 	return err != nil && errors.As(err, &apiErr) && apiErr.StatusCode == 409 && apiErr.Code == "existing_key_and_target"
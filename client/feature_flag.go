@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -54,6 +55,29 @@ type FeatureFlagReuse struct {
 	Environment string `json:"environment"`
 }
 
+// FeatureFlagRule is the decoded form of an entry in FeatureFlagEnvironment.Rules.
+// Rules are evaluated in order, and the first rule whose conditions all match
+// decides the outcome.
+type FeatureFlagRule struct {
+	ID         string                        `json:"id"`
+	Conditions []FeatureFlagSegmentCondition `json:"conditions"`
+	Outcome    FeatureFlagOutcome            `json:"outcome"`
+}
+
+// FeatureFlagListRHS is the right hand side of a condition whose comparator
+// takes several values, such as `oneOf` or `containsAnyOf`.
+type FeatureFlagListRHS struct {
+	Type  string                    `json:"type"`
+	Items []FeatureFlagSegmentValue `json:"items"`
+}
+
+// FeatureFlagRegexRHS is the right hand side of a `regex` or `!regex` condition.
+type FeatureFlagRegexRHS struct {
+	Type    string `json:"type"`
+	Pattern string `json:"pattern"`
+	Flags   string `json:"flags"`
+}
+
 type CreateFeatureFlagRequest struct {
 	ProjectID    string                            `json:"-"`
 	TeamID       string                            `json:"-"`
@@ -155,6 +179,63 @@ func (c *Client) DeleteFeatureFlag(ctx context.Context, request DeleteFeatureFla
 	}, nil)
 }
 
+// ListFeatureFlagsRequest lists the flags of a project. State optionally
+// restricts the result to `active` or `archived` flags, and Kind to flags of
+// one value type.
+type ListFeatureFlagsRequest struct {
+	ProjectID string
+	TeamID    string
+	State     string
+	Kind      string
+}
+
+// ListFeatureFlags lists the flags of a project, following pagination.
+func (c *Client) ListFeatureFlags(ctx context.Context, request ListFeatureFlagsRequest) ([]FeatureFlag, error) {
+	query := url.Values{}
+	if request.State != "" {
+		query.Set("state", request.State)
+	}
+	if request.Kind != "" {
+		query.Set("kind", request.Kind)
+	}
+	return listFeatureFlagPages[FeatureFlag](ctx, c, request.ProjectID, "flags", request.TeamID, query)
+}
+
+// FeatureFlagVersion is a snapshot of a flag taken each time it changes.
+// Environments holds the revision each environment had at that point, so
+// callers can tell which environments a change touched.
+type FeatureFlagVersion struct {
+	ID           string                            `json:"id"`
+	Revision     int                               `json:"revision"`
+	Message      string                            `json:"message,omitempty"`
+	CreatedBy    string                            `json:"createdBy,omitempty"`
+	CreatedAt    int64                             `json:"createdAt"`
+	Environments map[string]FeatureFlagEnvironment `json:"environments,omitempty"`
+}
+
+type ListFeatureFlagVersionsRequest struct {
+	ProjectID    string
+	FlagID       string
+	FlagIDOrSlug string
+	TeamID       string
+}
+
+func (c *Client) ListFeatureFlagVersions(ctx context.Context, request ListFeatureFlagVersionsRequest) (r []FeatureFlagVersion, err error) {
+	url := featureFlagScopedURL(c, request.ProjectID, fmt.Sprintf("flags/%s/versions", featureFlagIdentifier(request.FlagIDOrSlug, request.FlagID)), request.TeamID)
+	tflog.Info(ctx, "listing feature flag versions", map[string]any{
+		"url": url,
+	})
+	var response struct {
+		Data []FeatureFlagVersion `json:"data"`
+	}
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+	}, &response)
+	return response.Data, err
+}
+
 type FeatureFlagSegment struct {
 	ID             string                 `json:"id"`
 	Slug           string                 `json:"slug"`
@@ -299,6 +380,40 @@ func (c *Client) DeleteFeatureFlagSegment(ctx context.Context, request DeleteFea
 	}, nil)
 }
 
+type ListFeatureFlagSegmentsRequest struct {
+	ProjectID string
+	TeamID    string
+}
+
+// ListFeatureFlagSegments lists the segments of a project, following pagination.
+func (c *Client) ListFeatureFlagSegments(ctx context.Context, request ListFeatureFlagSegmentsRequest) ([]FeatureFlagSegment, error) {
+	return listFeatureFlagPages[FeatureFlagSegment](ctx, c, request.ProjectID, "segments", request.TeamID, url.Values{})
+}
+
+// listFeatureFlagPages fetches every page of a feature flags list endpoint.
+func listFeatureFlagPages[T any](ctx context.Context, c *Client, projectID, path, teamID string, query url.Values) ([]T, error) {
+	baseURL := fmt.Sprintf("%s/v1/projects/%s/feature-flags/%s", c.baseURL, projectID, path)
+	if c.TeamID(teamID) != "" {
+		query.Set("teamId", c.TeamID(teamID))
+	}
+	return collectPages(func(until *int64) ([]T, PageInfo, error) {
+		url := urlWithQuery(baseURL, paginationQuery(query, defaultPaginationLimit, until, nil))
+		tflog.Info(ctx, "listing feature flag "+path, map[string]any{
+			"url": url,
+		})
+		var response struct {
+			Data       []T      `json:"data"`
+			Pagination PageInfo `json:"pagination"`
+		}
+		err := c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    url,
+		}, &response)
+		return response.Data, response.Pagination, err
+	})
+}
+
 type FeatureFlagSDKKey struct {
 	HashKey          string `json:"hashKey"`
 	ProjectID        string `json:"projectId"`
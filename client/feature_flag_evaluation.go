@@ -0,0 +1,474 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The reasons a feature flag evaluation resolved to a variant.
+const (
+	FeatureFlagReasonPaused      = "paused"
+	FeatureFlagReasonTargetMatch = "target_match"
+	FeatureFlagReasonRuleMatch   = "rule_match"
+	FeatureFlagReasonFallthrough = "fallthrough"
+)
+
+// ErrFeatureFlagBucketed is returned when the outcome of an evaluation depends
+// on which bucket of a weighted split or segment percentage an entity falls in.
+// Buckets are assigned by the hosted evaluator, so they cannot be reproduced
+// offline.
+var ErrFeatureFlagBucketed = errors.New("the outcome depends on percentage bucketing, which can only be evaluated by Vercel")
+
+// FeatureFlagEvaluationRequest describes a single offline evaluation of a flag.
+type FeatureFlagEvaluationRequest struct {
+	// Environments holds the configuration of every environment of the flag,
+	// so that environments reusing another environment can be resolved.
+	Environments map[string]FeatureFlagEnvironment
+	Environment  string
+	// Entities maps an entity kind, such as "user", to its attributes.
+	Entities map[string]map[string]any
+	// Segments holds every segment referenced by the rules being evaluated.
+	Segments []FeatureFlagSegment
+}
+
+// FeatureFlagEvaluation is the result of evaluating a flag.
+type FeatureFlagEvaluation struct {
+	VariantID string
+	Reason    string
+	// RuleIndex is the position of the rule that matched, or -1 when no rule
+	// decided the outcome.
+	RuleIndex int
+	RuleID    string
+}
+
+type featureFlagEvaluator struct {
+	entities map[string]map[string]any
+	segments map[string]FeatureFlagSegment
+}
+
+// EvaluateFeatureFlag resolves the variant served to a set of entities without
+// calling the API. It follows the order used by Vercel: a paused environment
+// serves its paused outcome, then individually targeted entities, then the
+// rules in order, and finally the fallthrough outcome.
+//
+// Weighted splits and segment percentages are only resolved when the result
+// does not depend on the bucket an entity falls in, such as a split with a
+// single weighted variant or a percentage of 0 or 100. Otherwise an error
+// wrapping ErrFeatureFlagBucketed is returned.
+func EvaluateFeatureFlag(request FeatureFlagEvaluationRequest) (FeatureFlagEvaluation, error) {
+	result := FeatureFlagEvaluation{RuleIndex: -1}
+
+	env, ok := request.Environments[request.Environment]
+	if !ok {
+		return result, fmt.Errorf("the flag has no configuration for environment %q", request.Environment)
+	}
+
+	e := featureFlagEvaluator{
+		entities: request.Entities,
+		segments: make(map[string]FeatureFlagSegment, len(request.Segments)),
+	}
+	for _, segment := range request.Segments {
+		e.segments[segment.ID] = segment
+	}
+
+	if !env.Active {
+		variantID, err := e.outcome(env.PausedOutcome)
+		result.VariantID, result.Reason = variantID, FeatureFlagReasonPaused
+		return result, err
+	}
+
+	// A linked environment serves the targets, rules and fallthrough of the
+	// environment it reuses, but is still paused and resumed on its own.
+	config := env
+	if env.Reuse != nil && env.Reuse.Active {
+		reused, ok := request.Environments[env.Reuse.Environment]
+		if !ok {
+			return result, fmt.Errorf("environment %q reuses %q, which has no configuration", request.Environment, env.Reuse.Environment)
+		}
+		if reused.Reuse != nil && reused.Reuse.Active {
+			return result, fmt.Errorf("environment %q reuses %q, which itself reuses another environment", request.Environment, env.Reuse.Environment)
+		}
+		config = reused
+	}
+
+	if variantID, ok := e.target(config.Targets); ok {
+		result.VariantID, result.Reason = variantID, FeatureFlagReasonTargetMatch
+		return result, nil
+	}
+
+	for i, raw := range config.Rules {
+		var rule FeatureFlagRule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			return result, fmt.Errorf("could not decode rule %d: %w", i+1, err)
+		}
+		matched, err := e.conditions(rule.Conditions, map[string]bool{})
+		if err != nil {
+			return result, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		if !matched {
+			continue
+		}
+		variantID, err := e.outcome(rule.Outcome)
+		result.VariantID, result.Reason, result.RuleIndex, result.RuleID = variantID, FeatureFlagReasonRuleMatch, i, rule.ID
+		return result, err
+	}
+
+	variantID, err := e.outcome(config.Fallthrough)
+	result.VariantID, result.Reason = variantID, FeatureFlagReasonFallthrough
+	return result, err
+}
+
+func (e featureFlagEvaluator) target(targets map[string]map[string]map[string][]FeatureFlagSegmentValue) (string, bool) {
+	variantIDs := make([]string, 0, len(targets))
+	for variantID := range targets {
+		variantIDs = append(variantIDs, variantID)
+	}
+	sort.Strings(variantIDs)
+
+	for _, variantID := range variantIDs {
+		if e.matchesValues(targets[variantID]) {
+			return variantID, true
+		}
+	}
+	return "", false
+}
+
+// matchesValues reports whether any entity attribute equals one of the listed
+// values, as used by targets and segment include and exclude lists.
+func (e featureFlagEvaluator) matchesValues(values map[string]map[string][]FeatureFlagSegmentValue) bool {
+	for kind, attributes := range values {
+		for attribute, candidates := range attributes {
+			value, ok := e.attribute(kind, attribute)
+			if !ok {
+				continue
+			}
+			actual := featureFlagEvaluationString(value)
+			for _, candidate := range candidates {
+				if candidate.Value == actual {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func (e featureFlagEvaluator) attribute(kind, attribute string) (any, bool) {
+	value, ok := e.entities[kind][attribute]
+	return value, ok && value != nil
+}
+
+func (e featureFlagEvaluator) outcome(outcome FeatureFlagOutcome) (string, error) {
+	switch outcome.Type {
+	case "variant":
+		return outcome.VariantID, nil
+	case "split":
+		if outcome.Base == nil {
+			return "", fmt.Errorf("split outcome has no base attribute")
+		}
+		value, ok := e.attribute(outcome.Base.Kind, outcome.Base.Attribute)
+		if !ok {
+			return outcome.DefaultVariantID, nil
+		}
+
+		variantIDs := make([]string, 0, len(outcome.Weights))
+		for variantID, weight := range outcome.Weights {
+			if weight > 0 {
+				variantIDs = append(variantIDs, variantID)
+			}
+		}
+		sort.Strings(variantIDs)
+		switch len(variantIDs) {
+		case 0:
+			return outcome.DefaultVariantID, nil
+		case 1:
+			return variantIDs[0], nil
+		default:
+			return "", fmt.Errorf("%w: %s %q is split between variants %s", ErrFeatureFlagBucketed, outcome.Base.Kind, featureFlagEvaluationString(value), strings.Join(variantIDs, ", "))
+		}
+	default:
+		return "", fmt.Errorf("unsupported outcome type %q", outcome.Type)
+	}
+}
+
+func (e featureFlagEvaluator) conditions(conditions []FeatureFlagSegmentCondition, visiting map[string]bool) (bool, error) {
+	for _, condition := range conditions {
+		matched, err := e.condition(condition, visiting)
+		if err != nil || !matched {
+			return false, err
+		}
+	}
+	return true, nil
+}
+
+func (e featureFlagEvaluator) condition(condition FeatureFlagSegmentCondition, visiting map[string]bool) (bool, error) {
+	switch condition.LHS.Type {
+	case "segment":
+		segmentIDs, err := featureFlagEvaluationList(condition.RHS)
+		if err != nil {
+			return false, err
+		}
+		in := false
+		for _, segmentID := range segmentIDs {
+			member, err := e.inSegment(segmentID, visiting)
+			if err != nil {
+				return false, err
+			}
+			if member {
+				in = true
+				break
+			}
+		}
+		switch condition.CMP {
+		case "oneOf":
+			return in, nil
+		case "!oneOf":
+			return !in, nil
+		default:
+			return false, fmt.Errorf("unsupported segment operator %q", condition.CMP)
+		}
+	case "entity":
+		value, ok := e.attribute(condition.LHS.Kind, condition.LHS.Attribute)
+		switch condition.CMP {
+		case "ex":
+			return ok, nil
+		case "!ex":
+			return !ok, nil
+		}
+		// Every other comparison needs a value to compare, negated ones included.
+		if !ok {
+			return false, nil
+		}
+		if negated, found := strings.CutPrefix(condition.CMP, "!"); found {
+			matched, err := featureFlagCompare(negated, value, condition.RHS)
+			return !matched, err
+		}
+		return featureFlagCompare(condition.CMP, value, condition.RHS)
+	default:
+		return false, fmt.Errorf("unsupported condition type %q", condition.LHS.Type)
+	}
+}
+
+func (e featureFlagEvaluator) inSegment(segmentID string, visiting map[string]bool) (bool, error) {
+	segment, ok := e.segments[segmentID]
+	if !ok {
+		return false, fmt.Errorf("segment %q is referenced but was not provided", segmentID)
+	}
+	if visiting[segmentID] {
+		return false, fmt.Errorf("segment %q references itself", segmentID)
+	}
+	visiting[segmentID] = true
+	defer delete(visiting, segmentID)
+
+	// Explicit exclusions win over inclusions and rules.
+	if e.matchesValues(segment.Data.Exclude) {
+		return false, nil
+	}
+	if e.matchesValues(segment.Data.Include) {
+		return true, nil
+	}
+
+	for i, rule := range segment.Data.Rules {
+		matched, err := e.conditions(rule.Conditions, visiting)
+		if err != nil {
+			return false, fmt.Errorf("segment %q rule %d: %w", segmentID, i+1, err)
+		}
+		if !matched {
+			continue
+		}
+		switch rule.Outcome.Type {
+		case "all":
+			return true, nil
+		case "split":
+			if rule.Outcome.Base == nil || rule.Outcome.PassPromille == nil {
+				return false, fmt.Errorf("segment %q rule %d has an incomplete split outcome", segmentID, i+1)
+			}
+			value, ok := e.attribute(rule.Outcome.Base.Kind, rule.Outcome.Base.Attribute)
+			if !ok || *rule.Outcome.PassPromille <= 0 {
+				continue
+			}
+			if *rule.Outcome.PassPromille >= 1000 {
+				return true, nil
+			}
+			return false, fmt.Errorf("%w: segment %q rule %d includes %g%% of %s %q", ErrFeatureFlagBucketed, segmentID, i+1, *rule.Outcome.PassPromille/10, rule.Outcome.Base.Kind, featureFlagEvaluationString(value))
+		default:
+			return false, fmt.Errorf("segment %q rule %d has unsupported outcome type %q", segmentID, i+1, rule.Outcome.Type)
+		}
+	}
+	return false, nil
+}
+
+func featureFlagCompare(cmp string, value, rhs any) (bool, error) {
+	switch cmp {
+	case "eq":
+		return featureFlagEvaluationString(value) == featureFlagEvaluationString(rhs), nil
+	case "contains":
+		return strings.Contains(featureFlagEvaluationString(value), featureFlagEvaluationString(rhs)), nil
+	case "startsWith":
+		return strings.HasPrefix(featureFlagEvaluationString(value), featureFlagEvaluationString(rhs)), nil
+	case "endsWith":
+		return strings.HasSuffix(featureFlagEvaluationString(value), featureFlagEvaluationString(rhs)), nil
+	case "oneOf":
+		list, err := featureFlagEvaluationList(rhs)
+		if err != nil {
+			return false, err
+		}
+		actual := featureFlagEvaluationString(value)
+		for _, candidate := range list {
+			if candidate == actual {
+				return true, nil
+			}
+		}
+		return false, nil
+	case "containsAllOf", "containsAnyOf", "containsNoneOf":
+		list, err := featureFlagEvaluationList(rhs)
+		if err != nil {
+			return false, err
+		}
+		actual := map[string]bool{}
+		if items, ok := value.([]any); ok {
+			for _, item := range items {
+				actual[featureFlagEvaluationString(item)] = true
+			}
+		} else {
+			actual[featureFlagEvaluationString(value)] = true
+		}
+		found := 0
+		for _, candidate := range list {
+			if actual[candidate] {
+				found++
+			}
+		}
+		switch cmp {
+		case "containsAllOf":
+			return found == len(list), nil
+		case "containsAnyOf":
+			return found > 0, nil
+		default:
+			return found == 0, nil
+		}
+	case "gt", "gte", "lt", "lte":
+		left, lok := featureFlagEvaluationNumber(value)
+		right, rok := featureFlagEvaluationNumber(rhs)
+		if !lok || !rok {
+			return false, nil
+		}
+		switch cmp {
+		case "gt":
+			return left > right, nil
+		case "gte":
+			return left >= right, nil
+		case "lt":
+			return left < right, nil
+		default:
+			return left <= right, nil
+		}
+	case "before", "after":
+		left, lok := featureFlagEvaluationTime(value)
+		right, rok := featureFlagEvaluationTime(rhs)
+		if !lok || !rok {
+			return false, nil
+		}
+		if cmp == "before" {
+			return left.Before(right), nil
+		}
+		return left.After(right), nil
+	case "regex":
+		raw, err := json.Marshal(rhs)
+		if err != nil {
+			return false, err
+		}
+		var pattern FeatureFlagRegexRHS
+		if err := json.Unmarshal(raw, &pattern); err != nil || pattern.Type != "regex" {
+			return false, fmt.Errorf("expected a regex, got %s", raw)
+		}
+		flags := ""
+		for _, flag := range pattern.Flags {
+			if strings.ContainsRune("ims", flag) {
+				flags += string(flag)
+			}
+		}
+		if flags != "" {
+			pattern.Pattern = "(?" + flags + ")" + pattern.Pattern
+		}
+		re, err := regexp.Compile(pattern.Pattern)
+		if err != nil {
+			return false, fmt.Errorf("invalid regex %q: %w", pattern.Pattern, err)
+		}
+		return re.MatchString(featureFlagEvaluationString(value)), nil
+	default:
+		return false, fmt.Errorf("unsupported operator %q", cmp)
+	}
+}
+
+func featureFlagEvaluationList(rhs any) ([]string, error) {
+	raw, err := json.Marshal(rhs)
+	if err != nil {
+		return nil, err
+	}
+	var list struct {
+		Type  string `json:"type"`
+		Items []struct {
+			Value any `json:"value"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(raw, &list); err != nil || list.Type != "list" {
+		return nil, fmt.Errorf("expected a list of values, got %s", raw)
+	}
+	out := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		out = append(out, featureFlagEvaluationString(item.Value))
+	}
+	return out, nil
+}
+
+func featureFlagEvaluationString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	case nil:
+		return ""
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func featureFlagEvaluationNumber(value any) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case string:
+		f, err := strconv.ParseFloat(v, 64)
+		return f, err == nil
+	default:
+		return 0, false
+	}
+}
+
+// featureFlagEvaluationTime accepts RFC 3339 timestamps and milliseconds since
+// the Unix epoch.
+func featureFlagEvaluationTime(value any) (time.Time, bool) {
+	if s, ok := value.(string); ok {
+		if t, err := time.Parse(time.RFC3339, s); err == nil {
+			return t, true
+		}
+	}
+	if ms, ok := featureFlagEvaluationNumber(value); ok {
+		return time.UnixMilli(int64(ms)), true
+	}
+	return time.Time{}, false
+}
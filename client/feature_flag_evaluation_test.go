@@ -0,0 +1,281 @@
+package client
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+func mustFeatureFlagRules(t *testing.T, rules ...FeatureFlagRule) []json.RawMessage {
+	t.Helper()
+	out := make([]json.RawMessage, 0, len(rules))
+	for _, rule := range rules {
+		raw, err := json.Marshal(rule)
+		if err != nil {
+			t.Fatalf("marshal rule: %v", err)
+		}
+		out = append(out, raw)
+	}
+	return out
+}
+
+func featureFlagListOf(values ...string) FeatureFlagListRHS {
+	items := make([]FeatureFlagSegmentValue, 0, len(values))
+	for _, value := range values {
+		items = append(items, FeatureFlagSegmentValue{Value: value})
+	}
+	return FeatureFlagListRHS{Type: "list", Items: items}
+}
+
+func TestEvaluateFeatureFlag(t *testing.T) {
+	userID := &FeatureFlagSegmentConditionLHS{Type: "entity", Kind: "user", Attribute: "id"}
+	production := FeatureFlagEnvironment{
+		Active:        true,
+		PausedOutcome: FeatureFlagOutcome{Type: "variant", VariantID: "off"},
+		Fallthrough:   FeatureFlagOutcome{Type: "variant", VariantID: "control"},
+		Targets: map[string]map[string]map[string][]FeatureFlagSegmentValue{
+			"treatment": {"user": {"id": {{Value: "vip"}}}},
+		},
+		Rules: mustFeatureFlagRules(t,
+			FeatureFlagRule{
+				ID: "beta",
+				Conditions: []FeatureFlagSegmentCondition{
+					{LHS: FeatureFlagSegmentConditionLHS{Type: "segment"}, CMP: "oneOf", RHS: featureFlagListOf("seg_beta")},
+				},
+				Outcome: FeatureFlagOutcome{Type: "variant", VariantID: "treatment"},
+			},
+			FeatureFlagRule{
+				ID: "adults",
+				Conditions: []FeatureFlagSegmentCondition{
+					{LHS: FeatureFlagSegmentConditionLHS{Type: "entity", Kind: "user", Attribute: "age"}, CMP: "gte", RHS: 18.0},
+					{LHS: FeatureFlagSegmentConditionLHS{Type: "entity", Kind: "user", Attribute: "email"}, CMP: "!endsWith", RHS: "@competitor.com"},
+				},
+				Outcome: FeatureFlagOutcome{Type: "split", Base: userID, Weights: map[string]float64{"control": 0, "treatment": 1}, DefaultVariantID: "control"},
+			},
+		),
+	}
+	environments := map[string]FeatureFlagEnvironment{
+		"production": production,
+		"preview": {
+			Active:        true,
+			PausedOutcome: FeatureFlagOutcome{Type: "variant", VariantID: "off"},
+			Fallthrough:   FeatureFlagOutcome{Type: "variant", VariantID: "control"},
+			Reuse:         &FeatureFlagReuse{Active: true, Environment: "production"},
+		},
+		"development": {
+			Active:        false,
+			PausedOutcome: FeatureFlagOutcome{Type: "variant", VariantID: "off"},
+			Fallthrough:   FeatureFlagOutcome{Type: "variant", VariantID: "treatment"},
+		},
+	}
+	segments := []FeatureFlagSegment{{
+		ID: "seg_beta",
+		Data: FeatureFlagSegmentData{
+			Include: map[string]map[string][]FeatureFlagSegmentValue{"user": {"email": {{Value: "alice@example.com"}, {Value: "mallory@example.com"}}}},
+			Exclude: map[string]map[string][]FeatureFlagSegmentValue{"user": {"id": {{Value: "mallory"}}}},
+		},
+	}}
+
+	tests := map[string]struct {
+		environment string
+		entities    map[string]map[string]any
+		want        FeatureFlagEvaluation
+	}{
+		"paused": {
+			environment: "development",
+			entities:    map[string]map[string]any{"user": {"id": "vip"}},
+			want:        FeatureFlagEvaluation{VariantID: "off", Reason: FeatureFlagReasonPaused, RuleIndex: -1},
+		},
+		"target": {
+			environment: "production",
+			entities:    map[string]map[string]any{"user": {"id": "vip"}},
+			want:        FeatureFlagEvaluation{VariantID: "treatment", Reason: FeatureFlagReasonTargetMatch, RuleIndex: -1},
+		},
+		"segment include": {
+			environment: "production",
+			entities:    map[string]map[string]any{"user": {"id": "alice", "email": "alice@example.com"}},
+			want:        FeatureFlagEvaluation{VariantID: "treatment", Reason: FeatureFlagReasonRuleMatch, RuleIndex: 0, RuleID: "beta"},
+		},
+		"segment exclude wins": {
+			environment: "production",
+			entities:    map[string]map[string]any{"user": {"id": "mallory", "email": "mallory@example.com"}},
+			want:        FeatureFlagEvaluation{VariantID: "control", Reason: FeatureFlagReasonFallthrough, RuleIndex: -1},
+		},
+		"split": {
+			environment: "production",
+			entities:    map[string]map[string]any{"user": {"id": "bob", "age": 30.0, "email": "bob@example.com"}},
+			want:        FeatureFlagEvaluation{VariantID: "treatment", Reason: FeatureFlagReasonRuleMatch, RuleIndex: 1, RuleID: "adults"},
+		},
+		"negated condition": {
+			environment: "production",
+			entities:    map[string]map[string]any{"user": {"id": "eve", "age": 30.0, "email": "eve@competitor.com"}},
+			want:        FeatureFlagEvaluation{VariantID: "control", Reason: FeatureFlagReasonFallthrough, RuleIndex: -1},
+		},
+		"missing attribute": {
+			environment: "production",
+			entities:    map[string]map[string]any{"user": {"id": "carol"}},
+			want:        FeatureFlagEvaluation{VariantID: "control", Reason: FeatureFlagReasonFallthrough, RuleIndex: -1},
+		},
+		"reuse": {
+			environment: "preview",
+			entities:    map[string]map[string]any{"user": {"id": "vip"}},
+			want:        FeatureFlagEvaluation{VariantID: "treatment", Reason: FeatureFlagReasonTargetMatch, RuleIndex: -1},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got, err := EvaluateFeatureFlag(FeatureFlagEvaluationRequest{
+				Environments: environments,
+				Environment:  tt.environment,
+				Entities:     tt.entities,
+				Segments:     segments,
+			})
+			if err != nil {
+				t.Fatalf("EvaluateFeatureFlag() error = %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("EvaluateFeatureFlag() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluateFeatureFlagSplit(t *testing.T) {
+	split := func(weights map[string]float64) map[string]FeatureFlagEnvironment {
+		return map[string]FeatureFlagEnvironment{"production": {
+			Active: true,
+			Fallthrough: FeatureFlagOutcome{
+				Type:             "split",
+				Base:             &FeatureFlagSegmentConditionLHS{Type: "entity", Kind: "user", Attribute: "id"},
+				Weights:          weights,
+				DefaultVariantID: "control",
+			},
+		}}
+	}
+	user := map[string]map[string]any{"user": {"id": "user_1"}}
+
+	_, err := EvaluateFeatureFlag(FeatureFlagEvaluationRequest{
+		Environments: split(map[string]float64{"control": 90, "treatment": 10}),
+		Environment:  "production",
+		Entities:     user,
+	})
+	if !errors.Is(err, ErrFeatureFlagBucketed) {
+		t.Errorf("weighted split error = %v, want ErrFeatureFlagBucketed", err)
+	}
+
+	single, err := EvaluateFeatureFlag(FeatureFlagEvaluationRequest{
+		Environments: split(map[string]float64{"control": 0, "treatment": 100}),
+		Environment:  "production",
+		Entities:     user,
+	})
+	if err != nil || single.VariantID != "treatment" {
+		t.Errorf("split with a single weighted variant = %+v, %v, want treatment", single, err)
+	}
+
+	missing, err := EvaluateFeatureFlag(FeatureFlagEvaluationRequest{
+		Environments: split(map[string]float64{"control": 90, "treatment": 10}),
+		Environment:  "production",
+	})
+	if err != nil || missing.VariantID != "control" {
+		t.Errorf("without the base attribute = %+v, %v, want the default variant", missing, err)
+	}
+}
+
+func TestEvaluateFeatureFlagSegmentPassPromille(t *testing.T) {
+	all := 1000.0
+	none := 0.0
+	base := &FeatureFlagSegmentConditionLHS{Type: "entity", Kind: "user", Attribute: "id"}
+	rule := func(passPromille *float64) FeatureFlagSegmentRule {
+		return FeatureFlagSegmentRule{
+			ID: "rollout",
+			Conditions: []FeatureFlagSegmentCondition{
+				{LHS: FeatureFlagSegmentConditionLHS{Type: "entity", Kind: "user", Attribute: "country"}, CMP: "oneOf", RHS: featureFlagListOf("NL", "DE")},
+			},
+			Outcome: FeatureFlagSegmentOutcome{Type: "split", Base: base, PassPromille: passPromille},
+		}
+	}
+	environments := map[string]FeatureFlagEnvironment{
+		"production": {
+			Active:      true,
+			Fallthrough: FeatureFlagOutcome{Type: "variant", VariantID: "control"},
+			Rules: mustFeatureFlagRules(t, FeatureFlagRule{
+				ID: "segment",
+				Conditions: []FeatureFlagSegmentCondition{
+					{LHS: FeatureFlagSegmentConditionLHS{Type: "segment"}, CMP: "oneOf", RHS: featureFlagListOf("seg")},
+				},
+				Outcome: FeatureFlagOutcome{Type: "variant", VariantID: "treatment"},
+			}),
+		},
+	}
+	entities := map[string]map[string]any{"user": {"id": "user_1", "country": "NL"}}
+
+	for passPromille, want := range map[*float64]string{&all: "treatment", &none: "control"} {
+		got, err := EvaluateFeatureFlag(FeatureFlagEvaluationRequest{
+			Environments: environments,
+			Environment:  "production",
+			Entities:     entities,
+			Segments:     []FeatureFlagSegment{{ID: "seg", Data: FeatureFlagSegmentData{Rules: []FeatureFlagSegmentRule{rule(passPromille)}}}},
+		})
+		if err != nil {
+			t.Fatalf("EvaluateFeatureFlag() error = %v", err)
+		}
+		if got.VariantID != want {
+			t.Errorf("passPromille %v = %q, want %q", *passPromille, got.VariantID, want)
+		}
+	}
+
+	half := 500.0
+	_, err := EvaluateFeatureFlag(FeatureFlagEvaluationRequest{
+		Environments: environments,
+		Environment:  "production",
+		Entities:     entities,
+		Segments:     []FeatureFlagSegment{{ID: "seg", Data: FeatureFlagSegmentData{Rules: []FeatureFlagSegmentRule{rule(&half)}}}},
+	})
+	if !errors.Is(err, ErrFeatureFlagBucketed) {
+		t.Errorf("passPromille 500 error = %v, want ErrFeatureFlagBucketed", err)
+	}
+
+	_, err = EvaluateFeatureFlag(FeatureFlagEvaluationRequest{
+		Environments: environments,
+		Environment:  "production",
+		Entities:     entities,
+	})
+	if err == nil {
+		t.Error("EvaluateFeatureFlag() succeeded without the referenced segment, want error")
+	}
+}
+
+func TestFeatureFlagCompare(t *testing.T) {
+	tests := []struct {
+		cmp   string
+		value any
+		rhs   any
+		want  bool
+	}{
+		{"eq", "a", "a", true},
+		{"eq", 5.0, "5", true},
+		{"contains", "hello world", "lo w", true},
+		{"startsWith", "hello", "he", true},
+		{"oneOf", "b", featureFlagListOf("a", "b"), true},
+		{"containsAllOf", []any{"a", "b", "c"}, featureFlagListOf("a", "c"), true},
+		{"containsAnyOf", []any{"a"}, featureFlagListOf("b", "c"), false},
+		{"containsNoneOf", []any{"a"}, featureFlagListOf("b", "c"), true},
+		{"gt", 10.0, 5.0, true},
+		{"lte", "5", 5.0, true},
+		{"before", "2024-01-01T00:00:00Z", "2025-01-01T00:00:00Z", true},
+		{"after", 1735689600000.0, "2024-01-01T00:00:00Z", true},
+		{"regex", "Alice", FeatureFlagRegexRHS{Type: "regex", Pattern: "^a", Flags: "i"}, true},
+		{"regex", "Alice", FeatureFlagRegexRHS{Type: "regex", Pattern: "^a"}, false},
+	}
+	for _, tt := range tests {
+		got, err := featureFlagCompare(tt.cmp, tt.value, tt.rhs)
+		if err != nil {
+			t.Errorf("featureFlagCompare(%q, %v, %v) error = %v", tt.cmp, tt.value, tt.rhs, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("featureFlagCompare(%q, %v, %v) = %v, want %v", tt.cmp, tt.value, tt.rhs, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,1277 @@
+package client
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	FeatureFlagExportFormatLaunchDarkly = "launchdarkly"
+	FeatureFlagExportFormatOpenFeature  = "openfeature"
+)
+
+// FeatureFlagExportOptions controls how a flag export from another provider is
+// mapped onto Vercel flags and segments.
+type FeatureFlagExportOptions struct {
+	ProjectID string
+	TeamID    string
+	// Format is one of FeatureFlagExportFormatLaunchDarkly or
+	// FeatureFlagExportFormatOpenFeature. It is detected from the document
+	// when empty.
+	Format string
+	// Environments maps each Vercel environment to the LaunchDarkly
+	// environment key it is imported from. It defaults to production from
+	// `production`, and preview and development from `test`.
+	Environments map[string]string
+	// Entity is the entity kind used for attributes that do not name one. It
+	// defaults to `user`.
+	Entity string
+}
+
+// FeatureFlagExport is the result of converting a flag export. Flags and
+// segments are sorted by slug, and segments should be created first.
+//
+// Conditions that match a segment reference it by slug. Once the segments
+// have been created, ResolveSegmentIDs replaces the slugs with their IDs.
+type FeatureFlagExport struct {
+	Flags    []CreateFeatureFlagRequest
+	Segments []CreateFeatureFlagSegmentRequest
+}
+
+var featureFlagExportVariantIDRegex = regexp.MustCompile(`[^a-z0-9_-]+`)
+
+// ConvertFeatureFlagExport converts a LaunchDarkly or OpenFeature (flagd) flag
+// export into Vercel flag and segment payloads.
+//
+// LaunchDarkly exports may be a flags API response (`{"items": [...]}`), or a
+// document with `flags` and `segments`, each either a list or an object keyed
+// by flag key. Flags without per-environment configuration, such as those in
+// an SDK flag data file, are applied to every Vercel environment.
+//
+// OpenFeature exports use the flagd flag definition format. The same
+// configuration is applied to every Vercel environment, and each of the
+// `$evaluators` becomes a segment.
+//
+// Anything that has no Vercel equivalent, such as prerequisites or semantic
+// version comparisons, is reported as an error rather than dropped.
+func ConvertFeatureFlagExport(data []byte, options FeatureFlagExportOptions) (FeatureFlagExport, error) {
+	if options.Entity == "" {
+		options.Entity = "user"
+	}
+	if options.Environments == nil {
+		options.Environments = map[string]string{
+			"production":  "production",
+			"preview":     "test",
+			"development": "test",
+		}
+	}
+
+	format := options.Format
+	if format == "" {
+		detected, err := detectFeatureFlagExportFormat(data)
+		if err != nil {
+			return FeatureFlagExport{}, err
+		}
+		format = detected
+	}
+
+	var export FeatureFlagExport
+	var err error
+	switch format {
+	case FeatureFlagExportFormatLaunchDarkly:
+		export, err = convertLaunchDarklyExport(data, options)
+	case FeatureFlagExportFormatOpenFeature:
+		export, err = convertOpenFeatureExport(data, options)
+	default:
+		return FeatureFlagExport{}, fmt.Errorf("unsupported feature flag export format %q", format)
+	}
+	if err != nil {
+		return FeatureFlagExport{}, err
+	}
+
+	for i := range export.Flags {
+		export.Flags[i].ProjectID = options.ProjectID
+		export.Flags[i].TeamID = options.TeamID
+	}
+	for i := range export.Segments {
+		export.Segments[i].ProjectID = options.ProjectID
+		export.Segments[i].TeamID = options.TeamID
+	}
+	sort.Slice(export.Flags, func(i, j int) bool {
+		return export.Flags[i].Slug < export.Flags[j].Slug
+	})
+	sort.Slice(export.Segments, func(i, j int) bool {
+		return export.Segments[i].Slug < export.Segments[j].Slug
+	})
+	return export, nil
+}
+
+// ResolveSegmentIDs replaces the segment slugs referenced by flag and segment
+// conditions with the IDs of the created segments.
+func (e *FeatureFlagExport) ResolveSegmentIDs(ids map[string]string) error {
+	resolve := func(conditions []FeatureFlagSegmentCondition) error {
+		for i, condition := range conditions {
+			if condition.LHS.Type != "segment" {
+				continue
+			}
+			list, ok := condition.RHS.(FeatureFlagListRHS)
+			if !ok {
+				return fmt.Errorf("unexpected segment condition value %v", condition.RHS)
+			}
+			items := make([]FeatureFlagSegmentValue, 0, len(list.Items))
+			for _, item := range list.Items {
+				id, ok := ids[item.Value]
+				if !ok {
+					return fmt.Errorf("no ID for segment %q", item.Value)
+				}
+				items = append(items, FeatureFlagSegmentValue{Value: id})
+			}
+			conditions[i].RHS = FeatureFlagListRHS{Type: list.Type, Items: items}
+		}
+		return nil
+	}
+
+	for _, segment := range e.Segments {
+		for _, rule := range segment.Data.Rules {
+			if err := resolve(rule.Conditions); err != nil {
+				return fmt.Errorf("segment %q: %w", segment.Slug, err)
+			}
+		}
+	}
+	for _, flag := range e.Flags {
+		for name, env := range flag.Environments {
+			for i, raw := range env.Rules {
+				rule, err := decodeFeatureFlagExportRule(raw)
+				if err != nil {
+					return fmt.Errorf("flag %q: %w", flag.Slug, err)
+				}
+				if err := resolve(rule.Conditions); err != nil {
+					return fmt.Errorf("flag %q: %w", flag.Slug, err)
+				}
+				env.Rules[i] = mustMarshal(rule)
+			}
+			flag.Environments[name] = env
+		}
+	}
+	return nil
+}
+
+// decodeFeatureFlagExportRule decodes a rule produced by the converter, keeping
+// the right hand side of segment conditions as a FeatureFlagListRHS.
+func decodeFeatureFlagExportRule(raw json.RawMessage) (FeatureFlagRule, error) {
+	var rule struct {
+		FeatureFlagRule
+		Conditions []struct {
+			LHS FeatureFlagSegmentConditionLHS `json:"lhs"`
+			CMP string                         `json:"cmp"`
+			RHS json.RawMessage                `json:"rhs"`
+		} `json:"conditions"`
+	}
+	if err := json.Unmarshal(raw, &rule); err != nil {
+		return FeatureFlagRule{}, err
+	}
+	out := rule.FeatureFlagRule
+	out.Conditions = make([]FeatureFlagSegmentCondition, 0, len(rule.Conditions))
+	for _, condition := range rule.Conditions {
+		converted := FeatureFlagSegmentCondition{LHS: condition.LHS, CMP: condition.CMP}
+		if condition.LHS.Type == "segment" {
+			var list FeatureFlagListRHS
+			if err := json.Unmarshal(condition.RHS, &list); err != nil {
+				return FeatureFlagRule{}, err
+			}
+			converted.RHS = list
+		} else if len(condition.RHS) > 0 {
+			if err := json.Unmarshal(condition.RHS, &converted.RHS); err != nil {
+				return FeatureFlagRule{}, err
+			}
+		}
+		out.Conditions = append(out.Conditions, converted)
+	}
+	return out, nil
+}
+
+func detectFeatureFlagExportFormat(data []byte) (string, error) {
+	var document map[string]json.RawMessage
+	if err := json.Unmarshal(data, &document); err != nil {
+		return "", fmt.Errorf("feature flag export is not a JSON object: %w", err)
+	}
+	if _, ok := document["items"]; ok {
+		return FeatureFlagExportFormatLaunchDarkly, nil
+	}
+	if _, ok := document["$evaluators"]; ok {
+		return FeatureFlagExportFormatOpenFeature, nil
+	}
+
+	var flags map[string]map[string]json.RawMessage
+	if raw, ok := document["flags"]; ok && json.Unmarshal(raw, &flags) == nil {
+		for _, flag := range flags {
+			if _, ok := flag["defaultVariant"]; ok {
+				return FeatureFlagExportFormatOpenFeature, nil
+			}
+			if _, ok := flag["variations"]; ok {
+				return FeatureFlagExportFormatLaunchDarkly, nil
+			}
+		}
+	}
+	if _, ok := document["flags"]; ok {
+		return FeatureFlagExportFormatLaunchDarkly, nil
+	}
+	return "", fmt.Errorf("could not detect the feature flag export format, expected a LaunchDarkly or OpenFeature export")
+}
+
+// featureFlagExportVariantIDs picks stable variant IDs for imported variants.
+// Boolean variants are `on` and `off`, other variants use their name or value,
+// and duplicates are suffixed with their position.
+func featureFlagExportVariantIDs(kind string, names []string, values []any) []string {
+	ids := make([]string, len(values))
+	seen := map[string]bool{}
+	for i, value := range values {
+		candidate := names[i]
+		if kind == "boolean" {
+			candidate = "off"
+			if value == true {
+				candidate = "on"
+			}
+		} else if candidate == "" {
+			candidate = featureFlagExportString(value)
+		}
+		id := strings.Trim(featureFlagExportVariantIDRegex.ReplaceAllString(strings.ToLower(candidate), "-"), "-")
+		if id == "" || seen[id] {
+			id = fmt.Sprintf("variant-%d", i+1)
+		}
+		seen[id] = true
+		ids[i] = id
+	}
+	return ids
+}
+
+// featureFlagExportKind determines the Vercel flag kind from the variant
+// values, which must all have the same JSON type.
+func featureFlagExportKind(values []any) (string, error) {
+	kind := ""
+	for _, value := range values {
+		var valueKind string
+		switch value.(type) {
+		case bool:
+			valueKind = "boolean"
+		case string:
+			valueKind = "string"
+		case float64:
+			valueKind = "number"
+		default:
+			return "", fmt.Errorf("variant value %v is not a boolean, string or number", value)
+		}
+		if kind != "" && kind != valueKind {
+			return "", fmt.Errorf("variants mix %s and %s values", kind, valueKind)
+		}
+		kind = valueKind
+	}
+	if kind == "" {
+		return "", fmt.Errorf("no variants")
+	}
+	if kind == "boolean" && len(values) != 2 {
+		return "", fmt.Errorf("boolean flags must have exactly two variants")
+	}
+	return kind, nil
+}
+
+// featureFlagExportOffVariant is the variant served by an environment the
+// export does not configure: the false variant of a boolean flag, otherwise
+// the first variant.
+func featureFlagExportOffVariant(kind string, variants []FeatureFlagVariant) string {
+	if kind == "boolean" {
+		for _, variant := range variants {
+			if variant.Value == false {
+				return variant.ID
+			}
+		}
+	}
+	return variants[0].ID
+}
+
+func featureFlagExportString(value any) string {
+	switch v := value.(type) {
+	case string:
+		return v
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case bool:
+		return strconv.FormatBool(v)
+	default:
+		return fmt.Sprint(v)
+	}
+}
+
+func featureFlagExportList(values []any) FeatureFlagListRHS {
+	items := make([]FeatureFlagSegmentValue, 0, len(values))
+	for _, value := range values {
+		items = append(items, FeatureFlagSegmentValue{Value: featureFlagExportString(value)})
+	}
+	return FeatureFlagListRHS{Type: "list", Items: items}
+}
+
+func featureFlagExportStringList(values []string) FeatureFlagListRHS {
+	items := make([]FeatureFlagSegmentValue, 0, len(values))
+	for _, value := range values {
+		items = append(items, FeatureFlagSegmentValue{Value: value})
+	}
+	return FeatureFlagListRHS{Type: "list", Items: items}
+}
+
+// featureFlagExportSplit builds a split outcome. The default variant, served
+// when the base attribute is missing, is the variant with the largest weight.
+func featureFlagExportSplit(base FeatureFlagSegmentConditionLHS, weights map[string]float64, order []string) FeatureFlagOutcome {
+	defaultVariantID := ""
+	for _, id := range order {
+		if weight, ok := weights[id]; ok && (defaultVariantID == "" || weight > weights[defaultVariantID]) {
+			defaultVariantID = id
+		}
+	}
+	return FeatureFlagOutcome{
+		Type:             "split",
+		Base:             &base,
+		Weights:          weights,
+		DefaultVariantID: defaultVariantID,
+	}
+}
+
+func featureFlagExportEnvironment(active bool, paused, fallthroughOutcome FeatureFlagOutcome, rules []FeatureFlagRule, targets map[string]map[string]map[string][]FeatureFlagSegmentValue) FeatureFlagEnvironment {
+	raw := make([]json.RawMessage, 0, len(rules))
+	for _, rule := range rules {
+		raw = append(raw, mustMarshal(rule))
+	}
+	return FeatureFlagEnvironment{
+		Active:        active,
+		Rules:         raw,
+		Fallthrough:   fallthroughOutcome,
+		PausedOutcome: paused,
+		Reuse:         &FeatureFlagReuse{Active: false},
+		Targets:       targets,
+	}
+}
+
+// decodeFeatureFlagExportCollection decodes a list of items, or an object of
+// items keyed by their key.
+func decodeFeatureFlagExportCollection[T any](raw json.RawMessage, setKey func(*T, string)) ([]T, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return nil, nil
+	}
+	if raw[0] == '[' {
+		var items []T
+		err := json.Unmarshal(raw, &items)
+		return items, err
+	}
+
+	var keyed map[string]T
+	if err := json.Unmarshal(raw, &keyed); err != nil {
+		return nil, err
+	}
+	keys := make([]string, 0, len(keyed))
+	for key := range keyed {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	items := make([]T, 0, len(keys))
+	for _, key := range keys {
+		item := keyed[key]
+		setKey(&item, key)
+		items = append(items, item)
+	}
+	return items, nil
+}
+
+type launchDarklyExport struct {
+	Items    json.RawMessage `json:"items"`
+	Flags    json.RawMessage `json:"flags"`
+	Segments json.RawMessage `json:"segments"`
+}
+
+type launchDarklyFlag struct {
+	Key          string                                 `json:"key"`
+	Name         string                                 `json:"name"`
+	Description  string                                 `json:"description"`
+	Archived     bool                                   `json:"archived"`
+	Deleted      bool                                   `json:"deleted"`
+	Variations   []json.RawMessage                      `json:"variations"`
+	Environments map[string]launchDarklyFlagEnvironment `json:"environments"`
+	launchDarklyFlagEnvironment
+}
+
+type launchDarklyFlagEnvironment struct {
+	On             bool                 `json:"on"`
+	OffVariation   *int                 `json:"offVariation"`
+	Fallthrough    launchDarklyOutcome  `json:"fallthrough"`
+	Targets        []launchDarklyTarget `json:"targets"`
+	ContextTargets []launchDarklyTarget `json:"contextTargets"`
+	Rules          []launchDarklyRule   `json:"rules"`
+	Prerequisites  []json.RawMessage    `json:"prerequisites"`
+}
+
+type launchDarklyOutcome struct {
+	Variation *int                 `json:"variation"`
+	Rollout   *launchDarklyRollout `json:"rollout"`
+}
+
+type launchDarklyRollout struct {
+	Variations []struct {
+		Variation int `json:"variation"`
+		Weight    int `json:"weight"`
+	} `json:"variations"`
+	BucketBy    string `json:"bucketBy"`
+	ContextKind string `json:"contextKind"`
+}
+
+type launchDarklyTarget struct {
+	ContextKind string   `json:"contextKind"`
+	Values      []string `json:"values"`
+	Variation   int      `json:"variation"`
+}
+
+type launchDarklyRule struct {
+	ID      string               `json:"_id"`
+	Clauses []launchDarklyClause `json:"clauses"`
+	launchDarklyOutcome
+}
+
+type launchDarklyClause struct {
+	ContextKind string `json:"contextKind"`
+	Attribute   string `json:"attribute"`
+	Op          string `json:"op"`
+	Values      []any  `json:"values"`
+	Negate      bool   `json:"negate"`
+}
+
+type launchDarklySegment struct {
+	Key              string                      `json:"key"`
+	Name             string                      `json:"name"`
+	Description      string                      `json:"description"`
+	Deleted          bool                        `json:"deleted"`
+	Unbounded        bool                        `json:"unbounded"`
+	Included         []string                    `json:"included"`
+	Excluded         []string                    `json:"excluded"`
+	IncludedContexts []launchDarklySegmentTarget `json:"includedContexts"`
+	ExcludedContexts []launchDarklySegmentTarget `json:"excludedContexts"`
+	Rules            []launchDarklySegmentRule   `json:"rules"`
+}
+
+type launchDarklySegmentTarget struct {
+	ContextKind string   `json:"contextKind"`
+	Values      []string `json:"values"`
+}
+
+type launchDarklySegmentRule struct {
+	ID                 string               `json:"_id"`
+	Clauses            []launchDarklyClause `json:"clauses"`
+	Weight             *int                 `json:"weight"`
+	BucketBy           string               `json:"bucketBy"`
+	RolloutContextKind string               `json:"rolloutContextKind"`
+}
+
+// launchDarklyFlagConverter holds the variants of the flag being converted.
+type launchDarklyFlagConverter struct {
+	options    FeatureFlagExportOptions
+	kind       string
+	variants   []FeatureFlagVariant
+	variantIDs []string
+}
+
+func convertLaunchDarklyExport(data []byte, options FeatureFlagExportOptions) (FeatureFlagExport, error) {
+	var document launchDarklyExport
+	if err := json.Unmarshal(data, &document); err != nil {
+		return FeatureFlagExport{}, fmt.Errorf("invalid LaunchDarkly export: %w", err)
+	}
+
+	rawFlags := document.Flags
+	if len(document.Items) > 0 {
+		rawFlags = document.Items
+	}
+	flags, err := decodeFeatureFlagExportCollection(rawFlags, func(flag *launchDarklyFlag, key string) {
+		if flag.Key == "" {
+			flag.Key = key
+		}
+	})
+	if err != nil {
+		return FeatureFlagExport{}, fmt.Errorf("invalid LaunchDarkly flags: %w", err)
+	}
+	segments, err := decodeFeatureFlagExportCollection(document.Segments, func(segment *launchDarklySegment, key string) {
+		if segment.Key == "" {
+			segment.Key = key
+		}
+	})
+	if err != nil {
+		return FeatureFlagExport{}, fmt.Errorf("invalid LaunchDarkly segments: %w", err)
+	}
+
+	var export FeatureFlagExport
+	for _, flag := range flags {
+		if flag.Deleted {
+			continue
+		}
+		converted, err := convertLaunchDarklyFlag(flag, options)
+		if err != nil {
+			return FeatureFlagExport{}, fmt.Errorf("flag %q: %w", flag.Key, err)
+		}
+		export.Flags = append(export.Flags, converted)
+	}
+	for _, segment := range segments {
+		if segment.Deleted {
+			continue
+		}
+		converted, err := convertLaunchDarklySegment(segment, options)
+		if err != nil {
+			return FeatureFlagExport{}, fmt.Errorf("segment %q: %w", segment.Key, err)
+		}
+		export.Segments = append(export.Segments, converted)
+	}
+	return export, nil
+}
+
+func convertLaunchDarklyFlag(flag launchDarklyFlag, options FeatureFlagExportOptions) (CreateFeatureFlagRequest, error) {
+	names := make([]string, len(flag.Variations))
+	descriptions := make([]string, len(flag.Variations))
+	values := make([]any, len(flag.Variations))
+	for i, raw := range flag.Variations {
+		var variation struct {
+			Value       *any   `json:"value"`
+			Name        string `json:"name"`
+			Description string `json:"description"`
+		}
+		if err := json.Unmarshal(raw, &variation); err == nil && variation.Value != nil {
+			names[i] = variation.Name
+			descriptions[i] = variation.Description
+			values[i] = *variation.Value
+			continue
+		}
+		if err := json.Unmarshal(raw, &values[i]); err != nil {
+			return CreateFeatureFlagRequest{}, fmt.Errorf("variation %d: %w", i, err)
+		}
+	}
+
+	kind, err := featureFlagExportKind(values)
+	if err != nil {
+		return CreateFeatureFlagRequest{}, err
+	}
+	converter := launchDarklyFlagConverter{
+		options:    options,
+		kind:       kind,
+		variantIDs: featureFlagExportVariantIDs(kind, names, values),
+	}
+	for i, value := range values {
+		converter.variants = append(converter.variants, FeatureFlagVariant{
+			ID:          converter.variantIDs[i],
+			Label:       names[i],
+			Description: descriptions[i],
+			Value:       value,
+		})
+	}
+
+	out := CreateFeatureFlagRequest{
+		Slug:         flag.Key,
+		Kind:         kind,
+		Description:  flag.Description,
+		State:        "active",
+		Variants:     converter.variants,
+		Environments: map[string]FeatureFlagEnvironment{},
+	}
+	if out.Description == "" {
+		out.Description = flag.Name
+	}
+	if flag.Archived {
+		out.State = "archived"
+	}
+
+	for _, name := range []string{"production", "preview", "development"} {
+		source := flag.launchDarklyFlagEnvironment
+		if flag.Environments != nil {
+			env, ok := flag.Environments[options.Environments[name]]
+			if !ok {
+				off := FeatureFlagOutcome{Type: "variant", VariantID: featureFlagExportOffVariant(kind, converter.variants)}
+				out.Environments[name] = featureFlagExportEnvironment(false, off, off, nil, nil)
+				continue
+			}
+			source = env
+		}
+		env, err := converter.environment(source)
+		if err != nil {
+			return CreateFeatureFlagRequest{}, fmt.Errorf("%s: %w", name, err)
+		}
+		out.Environments[name] = env
+	}
+	return out, nil
+}
+
+func (c launchDarklyFlagConverter) environment(env launchDarklyFlagEnvironment) (FeatureFlagEnvironment, error) {
+	if len(env.Prerequisites) > 0 {
+		return FeatureFlagEnvironment{}, fmt.Errorf("prerequisites are not supported")
+	}
+
+	paused := FeatureFlagOutcome{Type: "variant", VariantID: featureFlagExportOffVariant(c.kind, c.variants)}
+	if env.OffVariation != nil {
+		id, err := c.variantID(*env.OffVariation)
+		if err != nil {
+			return FeatureFlagEnvironment{}, fmt.Errorf("off variation: %w", err)
+		}
+		paused.VariantID = id
+	}
+
+	fallthroughOutcome := paused
+	if env.Fallthrough.Variation != nil || env.Fallthrough.Rollout != nil {
+		outcome, err := c.outcome(env.Fallthrough)
+		if err != nil {
+			return FeatureFlagEnvironment{}, fmt.Errorf("fallthrough: %w", err)
+		}
+		fallthroughOutcome = outcome
+	}
+
+	var targets map[string]map[string]map[string][]FeatureFlagSegmentValue
+	for _, target := range append(append([]launchDarklyTarget{}, env.Targets...), env.ContextTargets...) {
+		// Context targets for the user kind only point back at the legacy
+		// targets, and carry no values of their own.
+		if len(target.Values) == 0 {
+			continue
+		}
+		id, err := c.variantID(target.Variation)
+		if err != nil {
+			return FeatureFlagEnvironment{}, fmt.Errorf("targets: %w", err)
+		}
+		kind := target.ContextKind
+		if kind == "" {
+			kind = c.options.Entity
+		}
+		if targets == nil {
+			targets = map[string]map[string]map[string][]FeatureFlagSegmentValue{}
+		}
+		if targets[id] == nil {
+			targets[id] = map[string]map[string][]FeatureFlagSegmentValue{}
+		}
+		if targets[id][kind] == nil {
+			targets[id][kind] = map[string][]FeatureFlagSegmentValue{}
+		}
+		for _, value := range target.Values {
+			targets[id][kind]["key"] = append(targets[id][kind]["key"], FeatureFlagSegmentValue{Value: value})
+		}
+	}
+
+	rules := make([]FeatureFlagRule, 0, len(env.Rules))
+	for i, rule := range env.Rules {
+		conditions, err := launchDarklyConditions(rule.Clauses, c.options.Entity)
+		if err != nil {
+			return FeatureFlagEnvironment{}, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		outcome, err := c.outcome(rule.launchDarklyOutcome)
+		if err != nil {
+			return FeatureFlagEnvironment{}, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		id := rule.ID
+		if id == "" {
+			id = fmt.Sprintf("rule-%d", i+1)
+		}
+		rules = append(rules, FeatureFlagRule{ID: id, Conditions: conditions, Outcome: outcome})
+	}
+
+	return featureFlagExportEnvironment(env.On, paused, fallthroughOutcome, rules, targets), nil
+}
+
+func (c launchDarklyFlagConverter) variantID(index int) (string, error) {
+	if index < 0 || index >= len(c.variantIDs) {
+		return "", fmt.Errorf("variation %d does not exist", index)
+	}
+	return c.variantIDs[index], nil
+}
+
+func (c launchDarklyFlagConverter) outcome(outcome launchDarklyOutcome) (FeatureFlagOutcome, error) {
+	if outcome.Variation != nil {
+		id, err := c.variantID(*outcome.Variation)
+		if err != nil {
+			return FeatureFlagOutcome{}, err
+		}
+		return FeatureFlagOutcome{Type: "variant", VariantID: id}, nil
+	}
+	if outcome.Rollout == nil {
+		return FeatureFlagOutcome{}, fmt.Errorf("no variation or rollout")
+	}
+
+	base := FeatureFlagSegmentConditionLHS{Type: "entity", Kind: outcome.Rollout.ContextKind, Attribute: outcome.Rollout.BucketBy}
+	if base.Kind == "" {
+		base.Kind = c.options.Entity
+	}
+	if base.Attribute == "" {
+		base.Attribute = "key"
+	}
+	// LaunchDarkly weights are in thousandths of a percent.
+	weights := map[string]float64{}
+	for _, variation := range outcome.Rollout.Variations {
+		id, err := c.variantID(variation.Variation)
+		if err != nil {
+			return FeatureFlagOutcome{}, err
+		}
+		weights[id] += float64(variation.Weight) / 1000
+	}
+	return featureFlagExportSplit(base, weights, c.variantIDs), nil
+}
+
+// launchDarklyOperators maps LaunchDarkly clause operators to Vercel
+// comparators.
+var launchDarklyOperators = map[string]string{
+	"in":                 "oneOf",
+	"contains":           "contains",
+	"startsWith":         "startsWith",
+	"endsWith":           "endsWith",
+	"matches":            "regex",
+	"lessThan":           "lt",
+	"lessThanOrEqual":    "lte",
+	"greaterThan":        "gt",
+	"greaterThanOrEqual": "gte",
+	"before":             "before",
+	"after":              "after",
+	"segmentMatch":       "oneOf",
+}
+
+// featureFlagExportNegation returns the comparator matching the opposite of
+// cmp. Dates have no negation, as neither comparator includes the instant
+// itself.
+func featureFlagExportNegation(cmp string) (string, bool) {
+	if negated, ok := strings.CutPrefix(cmp, "!"); ok {
+		return negated, true
+	}
+	switch cmp {
+	case "lt":
+		return "gte", true
+	case "lte":
+		return "gt", true
+	case "gt":
+		return "lte", true
+	case "gte":
+		return "lt", true
+	case "before", "after":
+		return "", false
+	}
+	return "!" + cmp, true
+}
+
+func launchDarklyConditions(clauses []launchDarklyClause, entity string) ([]FeatureFlagSegmentCondition, error) {
+	conditions := make([]FeatureFlagSegmentCondition, 0, len(clauses))
+	for _, clause := range clauses {
+		cmp, ok := launchDarklyOperators[clause.Op]
+		if !ok {
+			return nil, fmt.Errorf("the %q operator is not supported", clause.Op)
+		}
+		if clause.Negate {
+			if cmp, ok = featureFlagExportNegation(cmp); !ok {
+				return nil, fmt.Errorf("the negated %q operator is not supported", clause.Op)
+			}
+		}
+		if len(clause.Values) == 0 {
+			return nil, fmt.Errorf("the %q clause has no values", clause.Op)
+		}
+
+		if clause.Op == "segmentMatch" {
+			conditions = append(conditions, FeatureFlagSegmentCondition{
+				LHS: FeatureFlagSegmentConditionLHS{Type: "segment"},
+				CMP: cmp,
+				RHS: featureFlagExportList(clause.Values),
+			})
+			continue
+		}
+
+		condition := FeatureFlagSegmentCondition{
+			LHS: FeatureFlagSegmentConditionLHS{Type: "entity", Kind: clause.ContextKind, Attribute: clause.Attribute},
+			CMP: cmp,
+		}
+		if condition.LHS.Kind == "" {
+			condition.LHS.Kind = entity
+		}
+		if clause.Op == "in" {
+			condition.RHS = featureFlagExportList(clause.Values)
+			conditions = append(conditions, condition)
+			continue
+		}
+
+		// Other operators match any of several values, which Vercel
+		// conditions cannot express.
+		if len(clause.Values) != 1 {
+			return nil, fmt.Errorf("the %q clause on %q matches several values, which is not supported", clause.Op, clause.Attribute)
+		}
+		value := clause.Values[0]
+		switch clause.Op {
+		case "matches":
+			condition.RHS = FeatureFlagRegexRHS{Type: "regex", Pattern: featureFlagExportString(value)}
+		case "lessThan", "lessThanOrEqual", "greaterThan", "greaterThanOrEqual":
+			number, ok := value.(float64)
+			if !ok {
+				return nil, fmt.Errorf("the %q clause compares numbers, but %v is not a number", clause.Op, value)
+			}
+			condition.RHS = number
+		case "before", "after":
+			timestamp, err := launchDarklyTimestamp(value)
+			if err != nil {
+				return nil, err
+			}
+			condition.RHS = timestamp
+		default:
+			condition.RHS = featureFlagExportString(value)
+		}
+		conditions = append(conditions, condition)
+	}
+	return conditions, nil
+}
+
+// launchDarklyTimestamp converts a LaunchDarkly date, either milliseconds
+// since the epoch or an RFC 3339 string, to RFC 3339.
+func launchDarklyTimestamp(value any) (string, error) {
+	switch v := value.(type) {
+	case float64:
+		if v != math.Trunc(v) {
+			return "", fmt.Errorf("%v is not a timestamp in milliseconds", v)
+		}
+		return time.UnixMilli(int64(v)).UTC().Format(time.RFC3339), nil
+	case string:
+		if _, err := time.Parse(time.RFC3339, v); err != nil {
+			return "", fmt.Errorf("%q is not an RFC 3339 timestamp", v)
+		}
+		return v, nil
+	default:
+		return "", fmt.Errorf("%v is not a timestamp", value)
+	}
+}
+
+func convertLaunchDarklySegment(segment launchDarklySegment, options FeatureFlagExportOptions) (CreateFeatureFlagSegmentRequest, error) {
+	if segment.Unbounded {
+		return CreateFeatureFlagSegmentRequest{}, fmt.Errorf("big segments are not supported")
+	}
+
+	out := CreateFeatureFlagSegmentRequest{
+		Slug:        segment.Key,
+		Label:       segment.Name,
+		Description: segment.Description,
+	}
+	if out.Label == "" {
+		out.Label = segment.Key
+	}
+
+	matches := func(legacy []string, targets []launchDarklySegmentTarget) map[string]map[string][]FeatureFlagSegmentValue {
+		out := map[string]map[string][]FeatureFlagSegmentValue{}
+		add := func(kind string, values []string) {
+			if kind == "" {
+				kind = options.Entity
+			}
+			for _, value := range values {
+				if out[kind] == nil {
+					out[kind] = map[string][]FeatureFlagSegmentValue{}
+				}
+				out[kind]["key"] = append(out[kind]["key"], FeatureFlagSegmentValue{Value: value})
+			}
+		}
+		add("", legacy)
+		for _, target := range targets {
+			add(target.ContextKind, target.Values)
+		}
+		if len(out) == 0 {
+			return nil
+		}
+		return out
+	}
+	out.Data.Include = matches(segment.Included, segment.IncludedContexts)
+	out.Data.Exclude = matches(segment.Excluded, segment.ExcludedContexts)
+
+	for i, rule := range segment.Rules {
+		conditions, err := launchDarklyConditions(rule.Clauses, options.Entity)
+		if err != nil {
+			return CreateFeatureFlagSegmentRequest{}, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		outcome := FeatureFlagSegmentOutcome{Type: "all"}
+		if rule.Weight != nil {
+			base := FeatureFlagSegmentConditionLHS{Type: "entity", Kind: rule.RolloutContextKind, Attribute: rule.BucketBy}
+			if base.Kind == "" {
+				base.Kind = options.Entity
+			}
+			if base.Attribute == "" {
+				base.Attribute = "key"
+			}
+			// LaunchDarkly weights are out of 100000.
+			passPromille := float64(*rule.Weight) / 100
+			outcome = FeatureFlagSegmentOutcome{Type: "split", Base: &base, PassPromille: &passPromille}
+		}
+		id := rule.ID
+		if id == "" {
+			id = fmt.Sprintf("rule-%d", i+1)
+		}
+		out.Data.Rules = append(out.Data.Rules, FeatureFlagSegmentRule{ID: id, Conditions: conditions, Outcome: outcome})
+	}
+	return out, nil
+}
+
+type openFeatureExport struct {
+	Flags      map[string]openFeatureFlag `json:"flags"`
+	Evaluators map[string]any             `json:"$evaluators"`
+}
+
+type openFeatureFlag struct {
+	State          string         `json:"state"`
+	Variants       map[string]any `json:"variants"`
+	DefaultVariant string         `json:"defaultVariant"`
+	Targeting      any            `json:"targeting"`
+	Metadata       map[string]any `json:"metadata"`
+}
+
+// openFeatureConverter converts flagd JSON Logic targeting. Each entry of
+// `$evaluators` is converted to a segment that `$ref` conditions match.
+type openFeatureConverter struct {
+	options    FeatureFlagExportOptions
+	evaluators map[string]any
+}
+
+func convertOpenFeatureExport(data []byte, options FeatureFlagExportOptions) (FeatureFlagExport, error) {
+	var document openFeatureExport
+	if err := json.Unmarshal(data, &document); err != nil {
+		return FeatureFlagExport{}, fmt.Errorf("invalid OpenFeature export: %w", err)
+	}
+	converter := openFeatureConverter{options: options, evaluators: document.Evaluators}
+
+	var export FeatureFlagExport
+	for key, flag := range document.Flags {
+		converted, err := converter.flag(key, flag)
+		if err != nil {
+			return FeatureFlagExport{}, fmt.Errorf("flag %q: %w", key, err)
+		}
+		export.Flags = append(export.Flags, converted)
+	}
+	for name, logic := range document.Evaluators {
+		converted, err := converter.segment(name, logic)
+		if err != nil {
+			return FeatureFlagExport{}, fmt.Errorf("evaluator %q: %w", name, err)
+		}
+		export.Segments = append(export.Segments, converted)
+	}
+	return export, nil
+}
+
+func (c openFeatureConverter) flag(key string, flag openFeatureFlag) (CreateFeatureFlagRequest, error) {
+	names := make([]string, 0, len(flag.Variants))
+	for name := range flag.Variants {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	values := make([]any, 0, len(names))
+	for _, name := range names {
+		values = append(values, flag.Variants[name])
+	}
+	kind, err := featureFlagExportKind(values)
+	if err != nil {
+		return CreateFeatureFlagRequest{}, err
+	}
+	if _, ok := flag.Variants[flag.DefaultVariant]; !ok {
+		return CreateFeatureFlagRequest{}, fmt.Errorf("default variant %q does not exist", flag.DefaultVariant)
+	}
+
+	// flagd variants are already identified by name, so those are kept as
+	// the variant IDs.
+	variants := make([]FeatureFlagVariant, 0, len(names))
+	for i, name := range names {
+		variants = append(variants, FeatureFlagVariant{ID: name, Value: values[i]})
+	}
+
+	defaultOutcome := FeatureFlagOutcome{Type: "variant", VariantID: flag.DefaultVariant}
+	fallthroughOutcome := defaultOutcome
+	var rules []FeatureFlagRule
+	if targeting, ok := flag.Targeting.(map[string]any); ok && len(targeting) > 0 {
+		rules, fallthroughOutcome, err = c.targeting(targeting, flag.Variants, defaultOutcome, names)
+		if err != nil {
+			return CreateFeatureFlagRequest{}, fmt.Errorf("targeting: %w", err)
+		}
+	}
+
+	out := CreateFeatureFlagRequest{
+		Slug:         key,
+		Kind:         kind,
+		State:        "active",
+		Variants:     variants,
+		Environments: map[string]FeatureFlagEnvironment{},
+	}
+	if description, ok := flag.Metadata["description"].(string); ok {
+		out.Description = description
+	}
+	for _, name := range []string{"production", "preview", "development"} {
+		out.Environments[name] = featureFlagExportEnvironment(flag.State == "ENABLED", defaultOutcome, fallthroughOutcome, rules, nil)
+	}
+	return out, nil
+}
+
+// targeting converts an `if` chain into ordered rules. A trailing else branch,
+// or a targeting expression without conditions, becomes the fallthrough.
+func (c openFeatureConverter) targeting(logic map[string]any, variants map[string]any, fallthroughOutcome FeatureFlagOutcome, order []string) ([]FeatureFlagRule, FeatureFlagOutcome, error) {
+	var rules []FeatureFlagRule
+	current := any(logic)
+	for {
+		node, ok := current.(map[string]any)
+		args, isIf := node["if"].([]any)
+		if !ok || !isIf {
+			if current != nil {
+				outcome, err := c.outcome(current, variants, order)
+				if err != nil {
+					return nil, FeatureFlagOutcome{}, err
+				}
+				fallthroughOutcome = outcome
+			}
+			return rules, fallthroughOutcome, nil
+		}
+
+		current = nil
+		for i := 0; i < len(args); i += 2 {
+			if i+1 == len(args) {
+				current = args[i]
+				break
+			}
+			conditions, err := c.conditions(args[i])
+			if err != nil {
+				return nil, FeatureFlagOutcome{}, fmt.Errorf("rule %d: %w", len(rules)+1, err)
+			}
+			outcome, err := c.outcome(args[i+1], variants, order)
+			if err != nil {
+				return nil, FeatureFlagOutcome{}, fmt.Errorf("rule %d: %w", len(rules)+1, err)
+			}
+			rules = append(rules, FeatureFlagRule{
+				ID:         fmt.Sprintf("rule-%d", len(rules)+1),
+				Conditions: conditions,
+				Outcome:    outcome,
+			})
+		}
+	}
+}
+
+func (c openFeatureConverter) outcome(logic any, variants map[string]any, order []string) (FeatureFlagOutcome, error) {
+	if variant, ok := logic.(string); ok {
+		if _, ok := variants[variant]; !ok {
+			return FeatureFlagOutcome{}, fmt.Errorf("variant %q does not exist", variant)
+		}
+		return FeatureFlagOutcome{Type: "variant", VariantID: variant}, nil
+	}
+
+	node, _ := logic.(map[string]any)
+	args, ok := node["fractional"].([]any)
+	if !ok || len(node) != 1 {
+		return FeatureFlagOutcome{}, fmt.Errorf("outcome %v is not a variant or fractional split", logic)
+	}
+
+	// Without a bucketing expression flagd buckets by the targeting key.
+	base := FeatureFlagSegmentConditionLHS{Type: "entity", Kind: c.options.Entity, Attribute: "targetingKey"}
+	if len(args) > 0 {
+		if expression, ok := args[0].(map[string]any); ok {
+			lhs, err := c.variable(expression)
+			if err != nil {
+				return FeatureFlagOutcome{}, fmt.Errorf("fractional bucketing: %w", err)
+			}
+			base = lhs
+			args = args[1:]
+		}
+	}
+
+	weights := map[string]float64{}
+	for _, arg := range args {
+		bucket, ok := arg.([]any)
+		if !ok || len(bucket) == 0 || len(bucket) > 2 {
+			return FeatureFlagOutcome{}, fmt.Errorf("fractional bucket %v must be a variant and an optional weight", arg)
+		}
+		variant, ok := bucket[0].(string)
+		if _, exists := variants[variant]; !ok || !exists {
+			return FeatureFlagOutcome{}, fmt.Errorf("fractional bucket %v does not name a variant", arg)
+		}
+		weight := 1.0
+		if len(bucket) == 2 {
+			if weight, ok = bucket[1].(float64); !ok {
+				return FeatureFlagOutcome{}, fmt.Errorf("fractional bucket %v has a non-numeric weight", arg)
+			}
+		}
+		weights[variant] += weight
+	}
+	return featureFlagExportSplit(base, weights, order), nil
+}
+
+// openFeatureComparators maps JSON Logic operators to Vercel comparators,
+// with the variable on the left and on the right.
+var openFeatureComparators = map[string][2]string{
+	"==":          {"eq", "eq"},
+	"===":         {"eq", "eq"},
+	"!=":          {"!eq", "!eq"},
+	"!==":         {"!eq", "!eq"},
+	"starts_with": {"startsWith", ""},
+	"ends_with":   {"endsWith", ""},
+	"<":           {"lt", "gt"},
+	"<=":          {"lte", "gte"},
+	">":           {"gt", "lt"},
+	">=":          {"gte", "lte"},
+}
+
+func (c openFeatureConverter) conditions(logic any) ([]FeatureFlagSegmentCondition, error) {
+	node, ok := logic.(map[string]any)
+	if !ok || len(node) != 1 {
+		return nil, fmt.Errorf("condition %v is not a single operator", logic)
+	}
+	if args, ok := node["and"].([]any); ok {
+		var out []FeatureFlagSegmentCondition
+		for _, arg := range args {
+			conditions, err := c.conditions(arg)
+			if err != nil {
+				return nil, err
+			}
+			out = append(out, conditions...)
+		}
+		return out, nil
+	}
+	condition, err := c.condition(node, false)
+	if err != nil {
+		return nil, err
+	}
+	return []FeatureFlagSegmentCondition{condition}, nil
+}
+
+func (c openFeatureConverter) condition(node map[string]any, negate bool) (FeatureFlagSegmentCondition, error) {
+	var op string
+	var value any
+	for op, value = range node {
+	}
+
+	switch op {
+	case "!":
+		if args, ok := value.([]any); ok && len(args) == 1 {
+			value = args[0]
+		}
+		inner, ok := value.(map[string]any)
+		if !ok || len(inner) != 1 {
+			return FeatureFlagSegmentCondition{}, fmt.Errorf("negation %v is not a single operator", value)
+		}
+		return c.condition(inner, !negate)
+	case "$ref":
+		name, ok := value.(string)
+		if _, exists := c.evaluators[name]; !ok || !exists {
+			return FeatureFlagSegmentCondition{}, fmt.Errorf("evaluator %v does not exist", value)
+		}
+		cmp := "oneOf"
+		if negate {
+			cmp = "!oneOf"
+		}
+		return FeatureFlagSegmentCondition{
+			LHS: FeatureFlagSegmentConditionLHS{Type: "segment"},
+			CMP: cmp,
+			RHS: featureFlagExportStringList([]string{name}),
+		}, nil
+	}
+
+	args, ok := value.([]any)
+	if !ok || len(args) != 2 {
+		return FeatureFlagSegmentCondition{}, fmt.Errorf("the %q operator is not supported with arguments %v", op, value)
+	}
+	left, leftIsVariable := args[0].(map[string]any)
+	right, rightIsVariable := args[1].(map[string]any)
+
+	var condition FeatureFlagSegmentCondition
+	var variable map[string]any
+	switch {
+	case op == "in" && leftIsVariable:
+		// `in` is list membership with the variable on the left.
+		list, ok := args[1].([]any)
+		if !ok {
+			return FeatureFlagSegmentCondition{}, fmt.Errorf("the \"in\" operator must compare a variable with a list of values")
+		}
+		condition = FeatureFlagSegmentCondition{CMP: "oneOf", RHS: featureFlagExportList(list)}
+		variable = left
+	case op == "in" && rightIsVariable:
+		// and a substring match with the variable on the right.
+		substring, ok := args[0].(string)
+		if !ok {
+			return FeatureFlagSegmentCondition{}, fmt.Errorf("the \"in\" operator must look for a string in a variable")
+		}
+		condition = FeatureFlagSegmentCondition{CMP: "contains", RHS: substring}
+		variable = right
+	default:
+		comparators, ok := openFeatureComparators[op]
+		if !ok {
+			return FeatureFlagSegmentCondition{}, fmt.Errorf("the %q operator is not supported", op)
+		}
+		cmp, literal := comparators[0], args[1]
+		variable = left
+		if !leftIsVariable {
+			cmp, literal, variable = comparators[1], args[0], right
+		}
+		if variable == nil || cmp == "" {
+			return FeatureFlagSegmentCondition{}, fmt.Errorf("the %q operator must compare a variable with a value", op)
+		}
+		condition = FeatureFlagSegmentCondition{CMP: cmp, RHS: featureFlagExportString(literal)}
+		switch op {
+		case "<", "<=", ">", ">=":
+			number, ok := literal.(float64)
+			if !ok {
+				return FeatureFlagSegmentCondition{}, fmt.Errorf("the %q operator compares numbers, but %v is not a number", op, literal)
+			}
+			condition.RHS = number
+		}
+	}
+
+	lhs, err := c.variable(variable)
+	if err != nil {
+		return FeatureFlagSegmentCondition{}, err
+	}
+	condition.LHS = lhs
+	if negate {
+		condition.CMP, _ = featureFlagExportNegation(condition.CMP)
+	}
+	return condition, nil
+}
+
+// variable converts a `var` expression. Dotted paths name the entity kind
+// first, such as `organization.plan`, and other paths are attributes of the
+// default entity.
+func (c openFeatureConverter) variable(node map[string]any) (FeatureFlagSegmentConditionLHS, error) {
+	value, ok := node["var"]
+	if !ok || len(node) != 1 {
+		return FeatureFlagSegmentConditionLHS{}, fmt.Errorf("expression %v is not a variable", node)
+	}
+	if args, ok := value.([]any); ok && len(args) > 0 {
+		value = args[0]
+	}
+	name, ok := value.(string)
+	if !ok || name == "" {
+		return FeatureFlagSegmentConditionLHS{}, fmt.Errorf("variable %v is not a name", value)
+	}
+	if strings.HasPrefix(name, "$flagd.") {
+		return FeatureFlagSegmentConditionLHS{}, fmt.Errorf("the %q variable is not supported", name)
+	}
+	kind, attribute, found := strings.Cut(name, ".")
+	if !found {
+		kind, attribute = c.options.Entity, name
+	}
+	return FeatureFlagSegmentConditionLHS{Type: "entity", Kind: kind, Attribute: attribute}, nil
+}
+
+// segment converts an evaluator into a segment. Each branch of a top level
+// `or` becomes a segment rule, as a segment matches when any rule does.
+func (c openFeatureConverter) segment(name string, logic any) (CreateFeatureFlagSegmentRequest, error) {
+	branches := []any{logic}
+	if node, ok := logic.(map[string]any); ok {
+		if args, ok := node["or"].([]any); ok && len(node) == 1 {
+			branches = args
+		}
+	}
+
+	out := CreateFeatureFlagSegmentRequest{Slug: name, Label: name}
+	for i, branch := range branches {
+		conditions, err := c.conditions(branch)
+		if err != nil {
+			return CreateFeatureFlagSegmentRequest{}, fmt.Errorf("rule %d: %w", i+1, err)
+		}
+		out.Data.Rules = append(out.Data.Rules, FeatureFlagSegmentRule{
+			ID:         fmt.Sprintf("rule-%d", i+1),
+			Conditions: conditions,
+			Outcome:    FeatureFlagSegmentOutcome{Type: "all"},
+		})
+	}
+	return out, nil
+}
@@ -0,0 +1,409 @@
+package client
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func readFeatureFlagExport(t *testing.T, name string) []byte {
+	t.Helper()
+	data, err := os.ReadFile(filepath.Join("testdata", "feature_flags", name))
+	if err != nil {
+		t.Fatalf("reading %s: %v", name, err)
+	}
+	return data
+}
+
+// assertFeatureFlagExportJSON compares the JSON encoding of got with want,
+// ignoring formatting and key order.
+func assertFeatureFlagExportJSON(t *testing.T, name string, got any, want string) {
+	t.Helper()
+	var gotValue, wantValue any
+	if err := json.Unmarshal(mustMarshal(got), &gotValue); err != nil {
+		t.Fatalf("%s: decoding result: %v", name, err)
+	}
+	if err := json.Unmarshal([]byte(want), &wantValue); err != nil {
+		t.Fatalf("%s: decoding expectation: %v", name, err)
+	}
+	if !reflect.DeepEqual(gotValue, wantValue) {
+		t.Errorf("%s =\n%s\nwant\n%s", name, mustMarshal(got), want)
+	}
+}
+
+func featureFlagExportSlugs[T any](items []T, slug func(T) string) []string {
+	out := make([]string, 0, len(items))
+	for _, item := range items {
+		out = append(out, slug(item))
+	}
+	return out
+}
+
+func TestConvertFeatureFlagExportLaunchDarkly(t *testing.T) {
+	export, err := ConvertFeatureFlagExport(readFeatureFlagExport(t, "launchdarkly.json"), FeatureFlagExportOptions{
+		ProjectID: "prj_123",
+		TeamID:    "team_123",
+	})
+	if err != nil {
+		t.Fatalf("ConvertFeatureFlagExport() error = %v", err)
+	}
+
+	if got := featureFlagExportSlugs(export.Flags, func(f CreateFeatureFlagRequest) string { return f.Slug }); !reflect.DeepEqual(got, []string{"checkout-banner", "new-checkout"}) {
+		t.Fatalf("flags = %v", got)
+	}
+	banner, checkout := export.Flags[0], export.Flags[1]
+	if checkout.ProjectID != "prj_123" || checkout.TeamID != "team_123" || export.Segments[0].ProjectID != "prj_123" {
+		t.Errorf("project and team were not set on the converted payloads")
+	}
+
+	assertFeatureFlagExportJSON(t, "new-checkout", checkout, `{
+		"slug": "new-checkout",
+		"kind": "boolean",
+		"description": "Rolls out the redesigned checkout",
+		"state": "active",
+		"variants": [
+			{"id": "on", "label": "Enabled", "value": true},
+			{"id": "off", "label": "Disabled", "value": false}
+		],
+		"environments": {
+			"production": {
+				"active": true,
+				"pausedOutcome": {"type": "variant", "variantId": "off"},
+				"fallthrough": {
+					"type": "split",
+					"base": {"type": "entity", "kind": "user", "attribute": "key"},
+					"weights": {"on": 25, "off": 75},
+					"defaultVariantId": "off"
+				},
+				"reuse": {"active": false, "environment": ""},
+				"targets": {
+					"on": {
+						"user": {"key": [{"value": "user-qa-1"}, {"value": "user-qa-2"}]},
+						"organization": {"key": [{"value": "org-acme"}]}
+					}
+				},
+				"rules": [
+					{
+						"id": "beta-testers",
+						"conditions": [
+							{"lhs": {"type": "segment"}, "cmp": "oneOf", "rhs": {"type": "list", "items": [{"value": "beta-testers"}]}}
+						],
+						"outcome": {"type": "variant", "variantId": "on"}
+					},
+					{
+						"id": "internal",
+						"conditions": [
+							{"lhs": {"type": "entity", "kind": "user", "attribute": "email"}, "cmp": "endsWith", "rhs": "@example.com"},
+							{"lhs": {"type": "entity", "kind": "user", "attribute": "country"}, "cmp": "!oneOf", "rhs": {"type": "list", "items": [{"value": "NL"}, {"value": "DE"}]}}
+						],
+						"outcome": {"type": "variant", "variantId": "on"}
+					},
+					{
+						"id": "heavy-users",
+						"conditions": [
+							{"lhs": {"type": "entity", "kind": "user", "attribute": "orders"}, "cmp": "gte", "rhs": 10},
+							{"lhs": {"type": "entity", "kind": "user", "attribute": "signedUpAt"}, "cmp": "before", "rhs": "2024-01-01T00:00:00Z"}
+						],
+						"outcome": {
+							"type": "split",
+							"base": {"type": "entity", "kind": "organization", "attribute": "id"},
+							"weights": {"on": 50, "off": 50},
+							"defaultVariantId": "on"
+						}
+					}
+				]
+			},
+			"preview": {
+				"active": false,
+				"pausedOutcome": {"type": "variant", "variantId": "off"},
+				"fallthrough": {"type": "variant", "variantId": "on"},
+				"reuse": {"active": false, "environment": ""},
+				"rules": []
+			},
+			"development": {
+				"active": false,
+				"pausedOutcome": {"type": "variant", "variantId": "off"},
+				"fallthrough": {"type": "variant", "variantId": "on"},
+				"reuse": {"active": false, "environment": ""},
+				"rules": []
+			}
+		}
+	}`)
+
+	if banner.State != "archived" || banner.Kind != "string" || banner.Description != "Checkout banner" {
+		t.Errorf("checkout-banner = %s %s %q, want an archived string flag described by its name", banner.State, banner.Kind, banner.Description)
+	}
+	variantIDs := featureFlagExportSlugs(banner.Variants, func(v FeatureFlagVariant) string { return v.ID })
+	if !reflect.DeepEqual(variantIDs, []string{"control", "free-shipping", "variant-3"}) {
+		t.Errorf("checkout-banner variant IDs = %v, want duplicate names to fall back to the position", variantIDs)
+	}
+	assertFeatureFlagExportJSON(t, "checkout-banner production rules", banner.Environments["production"].Rules, `[
+		{
+			"id": "rule-1",
+			"conditions": [
+				{"lhs": {"type": "entity", "kind": "user", "attribute": "name"}, "cmp": "regex", "rhs": {"type": "regex", "pattern": "^ad[a-z]+", "flags": ""}}
+			],
+			"outcome": {"type": "variant", "variantId": "variant-3"}
+		}
+	]`)
+	if preview := banner.Environments["preview"]; preview.Active || preview.PausedOutcome.VariantID != "control" {
+		t.Errorf("checkout-banner preview = %+v, want an environment missing from the export to be paused on the first variant", preview)
+	}
+
+	assertFeatureFlagExportJSON(t, "segments", export.Segments, `[
+		{
+			"slug": "beta-testers",
+			"label": "Beta testers",
+			"description": "Users who opted in to beta features",
+			"hint": "",
+			"data": {
+				"include": {
+					"user": {"key": [{"value": "user-1"}]},
+					"organization": {"key": [{"value": "org-acme"}]}
+				},
+				"exclude": {
+					"user": {"key": [{"value": "user-2"}]}
+				},
+				"rules": [
+					{
+						"id": "opted-in",
+						"conditions": [
+							{"lhs": {"type": "entity", "kind": "user", "attribute": "beta"}, "cmp": "oneOf", "rhs": {"type": "list", "items": [{"value": "true"}]}}
+						],
+						"outcome": {"type": "all"}
+					},
+					{
+						"id": "dutch-sample",
+						"conditions": [
+							{"lhs": {"type": "entity", "kind": "user", "attribute": "country"}, "cmp": "oneOf", "rhs": {"type": "list", "items": [{"value": "NL"}]}}
+						],
+						"outcome": {"type": "split", "base": {"type": "entity", "kind": "user", "attribute": "key"}, "passPromille": 100}
+					}
+				]
+			}
+		}
+	]`)
+}
+
+func TestConvertFeatureFlagExportLaunchDarklyFlagData(t *testing.T) {
+	// The SDK flag data format has a single environment and plain variation values.
+	export, err := ConvertFeatureFlagExport([]byte(`{
+		"flags": {
+			"max-items": {
+				"on": true,
+				"variations": [10, 100],
+				"offVariation": 0,
+				"fallthrough": {"variation": 1}
+			}
+		},
+		"segments": {}
+	}`), FeatureFlagExportOptions{})
+	if err != nil {
+		t.Fatalf("ConvertFeatureFlagExport() error = %v", err)
+	}
+	if len(export.Flags) != 1 || len(export.Segments) != 0 {
+		t.Fatalf("export = %+v, want a single flag", export)
+	}
+	flag := export.Flags[0]
+	if flag.Slug != "max-items" || flag.Kind != "number" {
+		t.Errorf("flag = %s %s, want the number flag max-items", flag.Slug, flag.Kind)
+	}
+	for _, name := range []string{"production", "preview", "development"} {
+		env := flag.Environments[name]
+		if !env.Active || env.Fallthrough.VariantID != "100" || env.PausedOutcome.VariantID != "10" {
+			t.Errorf("%s = %+v, want every environment to use the flag data", name, env)
+		}
+	}
+}
+
+func TestConvertFeatureFlagExportOpenFeature(t *testing.T) {
+	export, err := ConvertFeatureFlagExport(readFeatureFlagExport(t, "openfeature.json"), FeatureFlagExportOptions{})
+	if err != nil {
+		t.Fatalf("ConvertFeatureFlagExport() error = %v", err)
+	}
+
+	if got := featureFlagExportSlugs(export.Flags, func(f CreateFeatureFlagRequest) string { return f.Slug }); !reflect.DeepEqual(got, []string{"checkout-banner", "max-items", "new-checkout"}) {
+		t.Fatalf("flags = %v", got)
+	}
+	banner, maxItems, checkout := export.Flags[0], export.Flags[1], export.Flags[2]
+
+	if checkout.Kind != "boolean" || checkout.State != "active" || checkout.Description != "Rolls out the redesigned checkout" {
+		t.Errorf("new-checkout = %s %s %q, want an active boolean flag described by its metadata", checkout.Kind, checkout.State, checkout.Description)
+	}
+	assertFeatureFlagExportJSON(t, "new-checkout variants", checkout.Variants, `[
+		{"id": "off", "value": false},
+		{"id": "on", "value": true}
+	]`)
+	assertFeatureFlagExportJSON(t, "new-checkout production", checkout.Environments["production"], `{
+		"active": true,
+		"pausedOutcome": {"type": "variant", "variantId": "off"},
+		"fallthrough": {
+			"type": "split",
+			"base": {"type": "entity", "kind": "user", "attribute": "targetingKey"},
+			"weights": {"on": 25, "off": 75},
+			"defaultVariantId": "off"
+		},
+		"reuse": {"active": false, "environment": ""},
+		"rules": [
+			{
+				"id": "rule-1",
+				"conditions": [
+					{"lhs": {"type": "segment"}, "cmp": "oneOf", "rhs": {"type": "list", "items": [{"value": "beta-testers"}]}}
+				],
+				"outcome": {"type": "variant", "variantId": "on"}
+			},
+			{
+				"id": "rule-2",
+				"conditions": [
+					{"lhs": {"type": "entity", "kind": "user", "attribute": "email"}, "cmp": "endsWith", "rhs": "@example.com"},
+					{"lhs": {"type": "entity", "kind": "user", "attribute": "country"}, "cmp": "!oneOf", "rhs": {"type": "list", "items": [{"value": "NL"}, {"value": "DE"}]}}
+				],
+				"outcome": {"type": "variant", "variantId": "on"}
+			},
+			{
+				"id": "rule-3",
+				"conditions": [
+					{"lhs": {"type": "entity", "kind": "organization", "attribute": "seats"}, "cmp": "gte", "rhs": 50}
+				],
+				"outcome": {
+					"type": "split",
+					"base": {"type": "entity", "kind": "organization", "attribute": "id"},
+					"weights": {"on": 50, "off": 50},
+					"defaultVariantId": "off"
+				}
+			}
+		]
+	}`)
+	if !reflect.DeepEqual(checkout.Environments["production"], checkout.Environments["preview"]) || !reflect.DeepEqual(checkout.Environments["production"], checkout.Environments["development"]) {
+		t.Errorf("new-checkout environments differ, want the flagd configuration in every environment")
+	}
+
+	assertFeatureFlagExportJSON(t, "checkout-banner production", banner.Environments["production"], `{
+		"active": false,
+		"pausedOutcome": {"type": "variant", "variantId": "control"},
+		"fallthrough": {"type": "variant", "variantId": "control"},
+		"reuse": {"active": false, "environment": ""},
+		"rules": [
+			{
+				"id": "rule-1",
+				"conditions": [
+					{"lhs": {"type": "entity", "kind": "user", "attribute": "email"}, "cmp": "contains", "rhs": "@vercel.com"}
+				],
+				"outcome": {"type": "variant", "variantId": "free-shipping"}
+			},
+			{
+				"id": "rule-2",
+				"conditions": [
+					{"lhs": {"type": "entity", "kind": "user", "attribute": "plan"}, "cmp": "eq", "rhs": "premium"}
+				],
+				"outcome": {"type": "variant", "variantId": "free-shipping"}
+			}
+		]
+	}`)
+
+	if maxItems.Kind != "number" || len(maxItems.Environments["production"].Rules) != 0 || maxItems.Environments["production"].Fallthrough.VariantID != "small" {
+		t.Errorf("max-items = %+v, want a number flag serving its default variant", maxItems)
+	}
+
+	assertFeatureFlagExportJSON(t, "segments", export.Segments, `[
+		{
+			"slug": "beta-testers",
+			"label": "beta-testers",
+			"hint": "",
+			"data": {
+				"rules": [
+					{
+						"id": "rule-1",
+						"conditions": [
+							{"lhs": {"type": "entity", "kind": "user", "attribute": "email"}, "cmp": "oneOf", "rhs": {"type": "list", "items": [{"value": "alice@example.com"}, {"value": "bob@example.com"}]}}
+						],
+						"outcome": {"type": "all"}
+					},
+					{
+						"id": "rule-2",
+						"conditions": [
+							{"lhs": {"type": "entity", "kind": "user", "attribute": "beta"}, "cmp": "eq", "rhs": "true"}
+						],
+						"outcome": {"type": "all"}
+					}
+				]
+			}
+		}
+	]`)
+}
+
+func TestFeatureFlagExportResolveSegmentIDs(t *testing.T) {
+	export, err := ConvertFeatureFlagExport(readFeatureFlagExport(t, "launchdarkly.json"), FeatureFlagExportOptions{})
+	if err != nil {
+		t.Fatalf("ConvertFeatureFlagExport() error = %v", err)
+	}
+
+	if err := export.ResolveSegmentIDs(map[string]string{}); err == nil || !strings.Contains(err.Error(), "beta-testers") {
+		t.Errorf("ResolveSegmentIDs() error = %v, want an error naming the missing segment", err)
+	}
+	if err := export.ResolveSegmentIDs(map[string]string{"beta-testers": "segment_123"}); err != nil {
+		t.Fatalf("ResolveSegmentIDs() error = %v", err)
+	}
+
+	rule, err := decodeFeatureFlagExportRule(export.Flags[1].Environments["production"].Rules[0])
+	if err != nil {
+		t.Fatalf("decoding rule: %v", err)
+	}
+	assertFeatureFlagExportJSON(t, "segment condition", rule.Conditions[0], `{"lhs": {"type": "segment"}, "cmp": "oneOf", "rhs": {"type": "list", "items": [{"value": "segment_123"}]}}`)
+}
+
+func TestConvertFeatureFlagExportErrors(t *testing.T) {
+	tests := map[string]struct {
+		export string
+		err    string
+	}{
+		"unknown format": {
+			export: `{"features": []}`,
+			err:    "could not detect",
+		},
+		"prerequisites": {
+			export: `{"items": [{"key": "a", "variations": [{"value": true}, {"value": false}], "environments": {"production": {"on": true, "fallthrough": {"variation": 0}, "prerequisites": [{"key": "b", "variation": 0}]}}}]}`,
+			err:    "prerequisites are not supported",
+		},
+		"semantic versions": {
+			export: `{"items": [{"key": "a", "variations": [{"value": true}, {"value": false}], "environments": {"production": {"on": true, "fallthrough": {"variation": 0}, "rules": [{"clauses": [{"attribute": "version", "op": "semVerEqual", "values": ["1.0.0"]}], "variation": 0}]}}}]}`,
+			err:    `"semVerEqual" operator is not supported`,
+		},
+		"several string values": {
+			export: `{"items": [{"key": "a", "variations": [{"value": true}, {"value": false}], "environments": {"production": {"on": true, "fallthrough": {"variation": 0}, "rules": [{"clauses": [{"attribute": "email", "op": "endsWith", "values": ["@a.com", "@b.com"]}], "variation": 0}]}}}]}`,
+			err:    "matches several values",
+		},
+		"missing variation": {
+			export: `{"items": [{"key": "a", "variations": [{"value": true}, {"value": false}], "environments": {"production": {"on": true, "fallthrough": {"variation": 2}}}}]}`,
+			err:    "variation 2 does not exist",
+		},
+		"json variants": {
+			export: `{"flags": {"a": {"state": "ENABLED", "variants": {"a": {"color": "red"}}, "defaultVariant": "a"}}}`,
+			err:    "is not a boolean, string or number",
+		},
+		"or in flag targeting": {
+			export: `{"flags": {"a": {"state": "ENABLED", "variants": {"on": true, "off": false}, "defaultVariant": "off", "targeting": {"if": [{"or": [{"==": [{"var": "a"}, "b"]}, {"==": [{"var": "a"}, "c"]}]}, "on", "off"]}}}}`,
+			err:    `the "or" operator is not supported`,
+		},
+		"flagd variables": {
+			export: `{"flags": {"a": {"state": "ENABLED", "variants": {"on": true, "off": false}, "defaultVariant": "off", "targeting": {"if": [{">": [{"var": "$flagd.timestamp"}, 0]}, "on", "off"]}}}}`,
+			err:    `the "$flagd.timestamp" variable is not supported`,
+		},
+		"missing evaluator": {
+			export: `{"flags": {"a": {"state": "ENABLED", "variants": {"on": true, "off": false}, "defaultVariant": "off", "targeting": {"if": [{"$ref": "missing"}, "on", "off"]}}}}`,
+			err:    "evaluator missing does not exist",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := ConvertFeatureFlagExport([]byte(tt.export), FeatureFlagExportOptions{})
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("ConvertFeatureFlagExport() error = %v, want an error containing %q", err, tt.err)
+			}
+		})
+	}
+}
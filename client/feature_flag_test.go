@@ -448,6 +448,91 @@ func TestFeatureFlagRequestAliasesUseLegacyFlagID(t *testing.T) {
 	})
 }
 
+func TestListFeatureFlags(t *testing.T) {
+	t.Parallel()
+
+	client := newFeatureFlagTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, "GET", "/v1/projects/prj_123/feature-flags/flags", "team_123", nil)
+		if state := r.URL.Query().Get("state"); state != "archived" {
+			t.Fatalf("expected state archived, got %q", state)
+		}
+		if kind := r.URL.Query().Get("kind"); kind != "string" {
+			t.Fatalf("expected kind string, got %q", kind)
+		}
+		if r.URL.Query().Get("until") == "" {
+			_, _ = w.Write([]byte(`{
+				"data":[
+					{"id":"flag_123","slug":"checkout-banner","kind":"string","state":"archived","projectId":"prj_123","variants":[{"id":"control","value":"control"}]}
+				],
+				"pagination":{"count":1,"next":1700000000000}
+			}`))
+			return
+		}
+		if until := r.URL.Query().Get("until"); until != "1700000000000" {
+			t.Fatalf("expected until 1700000000000, got %q", until)
+		}
+		_, _ = w.Write([]byte(`{
+			"data":[
+				{"id":"flag_456","slug":"new-nav","kind":"string","state":"archived","projectId":"prj_123","variants":[{"id":"old","value":"old"},{"id":"new","value":"new"}]}
+			],
+			"pagination":{"count":1,"next":null}
+		}`))
+	})
+
+	flags, err := client.ListFeatureFlags(context.Background(), vercelclient.ListFeatureFlagsRequest{
+		ProjectID: "prj_123",
+		TeamID:    "team_123",
+		State:     "archived",
+		Kind:      "string",
+	})
+	if err != nil {
+		t.Fatalf("ListFeatureFlags returned error: %v", err)
+	}
+
+	if len(flags) != 2 {
+		t.Fatalf("expected 2 flags, got %d", len(flags))
+	}
+	if flags[1].Slug != "new-nav" || len(flags[1].Variants) != 2 {
+		t.Fatalf("unexpected second flag %+v", flags[1])
+	}
+}
+
+func TestListFeatureFlagVersions(t *testing.T) {
+	t.Parallel()
+
+	client := newFeatureFlagTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, "GET", "/v1/projects/prj_123/feature-flags/flags/flag_123/versions", "team_123", nil)
+		_, _ = w.Write([]byte(`{
+			"data":[
+				{"id":"ver_2","revision":2,"message":"run-42","createdBy":"user_123","createdAt":1700000100000,"environments":{"production":{"active":true,"revision":2,"rules":[],"fallthrough":{"type":"variant","variantId":"on"},"pausedOutcome":{"type":"variant","variantId":"off"}}}},
+				{"id":"ver_1","revision":1,"createdAt":1700000000000}
+			]
+		}`))
+	})
+
+	versions, err := client.ListFeatureFlagVersions(context.Background(), vercelclient.ListFeatureFlagVersionsRequest{
+		ProjectID: "prj_123",
+		FlagID:    "flag_123",
+		TeamID:    "team_123",
+	})
+	if err != nil {
+		t.Fatalf("ListFeatureFlagVersions returned error: %v", err)
+	}
+
+	if len(versions) != 2 {
+		t.Fatalf("expected 2 versions, got %d", len(versions))
+	}
+	if versions[0].Message != "run-42" || versions[0].Revision != 2 || versions[0].CreatedAt != 1700000100000 {
+		t.Fatalf("unexpected first version %+v", versions[0])
+	}
+	if revision := versions[0].Environments["production"].Revision; revision == nil || *revision != 2 {
+		t.Fatalf("expected production revision 2, got %v", revision)
+	}
+	if versions[1].Message != "" || versions[1].Environments != nil {
+		t.Fatalf("unexpected second version %+v", versions[1])
+	}
+}
+
 func TestCreateFeatureFlagSegment(t *testing.T) {
 	t.Parallel()
 
@@ -761,6 +846,34 @@ func TestFeatureFlagSegmentRequestAliasesUseLegacySegmentID(t *testing.T) {
 	})
 }
 
+func TestListFeatureFlagSegments(t *testing.T) {
+	t.Parallel()
+
+	client := newFeatureFlagTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, "GET", "/v1/projects/prj_123/feature-flags/segments", "team_123", nil)
+		_, _ = w.Write([]byte(`{
+			"data":[
+				{"id":"segment_123","slug":"beta-users","label":"Beta Users","projectId":"prj_123","usedByFlags":["flag_123"],"data":{"include":{"user":{"id":[{"value":"user_123"}]}}}}
+			]
+		}`))
+	})
+
+	segments, err := client.ListFeatureFlagSegments(context.Background(), vercelclient.ListFeatureFlagSegmentsRequest{
+		ProjectID: "prj_123",
+		TeamID:    "team_123",
+	})
+	if err != nil {
+		t.Fatalf("ListFeatureFlagSegments returned error: %v", err)
+	}
+
+	if len(segments) != 1 || segments[0].Slug != "beta-users" {
+		t.Fatalf("unexpected segments %+v", segments)
+	}
+	if !reflect.DeepEqual(segments[0].UsedByFlags, []string{"flag_123"}) {
+		t.Fatalf("expected usedByFlags [flag_123], got %v", segments[0].UsedByFlags)
+	}
+}
+
 func TestCreateFeatureFlagSDKKey(t *testing.T) {
 	t.Parallel()
 
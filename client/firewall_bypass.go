@@ -3,6 +3,10 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
 type FirewallBypassRule struct {
@@ -99,3 +103,46 @@ func (c *Client) RemoveFirewallBypass(ctx context.Context, teamID, projectID str
 	}
 	return FirewallBypass{}, err
 }
+
+// ListFirewallBypasses returns every bypass rule for a project. The endpoint
+// pages by rule ID: each response carries the ID to pass as the offset for the
+// next page.
+func (c *Client) ListFirewallBypasses(ctx context.Context, teamID, projectID string) ([]FirewallBypass, error) {
+	query := url.Values{}
+	query.Set("projectId", projectID)
+	if tid := c.TeamID(teamID); tid != "" {
+		query.Set("teamId", tid)
+	}
+	query.Set("limit", strconv.Itoa(defaultPaginationLimit))
+
+	var all []FirewallBypass
+	for {
+		var res struct {
+			Result     []FirewallBypass `json:"result"`
+			Pagination *struct {
+				Id string `json:"Id"`
+			} `json:"pagination"`
+		}
+		requestURL := urlWithQuery(fmt.Sprintf("%s/v1/security/firewall/bypass", c.baseURL), query)
+		tflog.Info(ctx, "listing firewall bypasses", map[string]any{
+			"url": requestURL,
+		})
+		err := c.doRequest(clientRequest{
+			ctx:    ctx,
+			method: "GET",
+			url:    requestURL,
+		}, &res)
+		if err != nil {
+			return nil, err
+		}
+		all = append(all, res.Result...)
+
+		if res.Pagination == nil || res.Pagination.Id == "" || len(res.Result) < defaultPaginationLimit {
+			return all, nil
+		}
+		if res.Pagination.Id == query.Get("offset") {
+			return nil, fmt.Errorf("pagination cursor did not advance")
+		}
+		query.Set("offset", res.Pagination.Id)
+	}
+}
@@ -0,0 +1,54 @@
+package client_test
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"testing"
+
+	vercelclient "github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestListFirewallBypasses(t *testing.T) {
+	t.Parallel()
+
+	requests := 0
+	client := newFeatureFlagTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		assertRequest(t, r, "GET", "/v1/security/firewall/bypass", "team_123", nil)
+		if got := r.URL.Query().Get("projectId"); got != "prj_123" {
+			t.Fatalf("expected projectId prj_123, got %q", got)
+		}
+		requests++
+
+		switch offset := r.URL.Query().Get("offset"); offset {
+		case "":
+			// A full page, so the client asks for the next one.
+			entries := make([]string, 100)
+			for i := range entries {
+				entries[i] = fmt.Sprintf(`{"Id":"byp_%d","Domain":"example.com","Ip":"10.0.0.%d","IsProjectRule":false}`, i, i)
+			}
+			_, _ = fmt.Fprintf(w, `{"result":[%s],"pagination":{"OwnerId":"team_123","Id":"byp_99"}}`, strings.Join(entries, ","))
+		case "byp_99":
+			_, _ = w.Write([]byte(`{"result":[{"Id":"byp_100","Ip":"192.0.2.0/24","IsProjectRule":true,"Note":"office"}],"pagination":{"OwnerId":"team_123","Id":"byp_100"}}`))
+		default:
+			t.Fatalf("unexpected offset %q", offset)
+		}
+	})
+
+	bypasses, err := client.ListFirewallBypasses(context.Background(), "team_123", "prj_123")
+	if err != nil {
+		t.Fatalf("ListFirewallBypasses returned error: %v", err)
+	}
+	if requests != 2 {
+		t.Fatalf("expected 2 requests, got %d", requests)
+	}
+	if len(bypasses) != 101 {
+		t.Fatalf("expected 101 bypasses, got %d", len(bypasses))
+	}
+	last := bypasses[100]
+	want := vercelclient.FirewallBypass{Id: "byp_100", Ip: "192.0.2.0/24", IsProjectRule: true, Note: "office"}
+	if last != want {
+		t.Fatalf("last bypass = %+v, want %+v", last, want)
+	}
+}
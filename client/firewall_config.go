@@ -18,6 +18,25 @@ type FirewallConfig struct {
 type ManagedRule struct {
 	Active bool   `json:"active"`
 	Action string `json:"action,omitempty"`
+
+	// The settings below only apply to the owasp managed ruleset.
+	ParanoiaLevel     *int64                         `json:"paranoiaLevel,omitempty"`
+	AnomalyThresholds *AnomalyThresholds             `json:"anomalyThresholds,omitempty"`
+	RuleOverrides     map[string]ManagedRuleOverride `json:"ruleOverrides,omitempty"`
+}
+
+// AnomalyThresholds are the anomaly scores at which the OWASP core rule set
+// takes action on a request or response.
+type AnomalyThresholds struct {
+	Inbound  *int64 `json:"inbound,omitempty"`
+	Outbound *int64 `json:"outbound,omitempty"`
+}
+
+// ManagedRuleOverride changes the behavior of a single rule, by ID, within a
+// managed ruleset.
+type ManagedRuleOverride struct {
+	Active *bool  `json:"active,omitempty"`
+	Action string `json:"action,omitempty"`
 }
 
 type FirewallRule struct {
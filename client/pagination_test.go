@@ -225,3 +225,113 @@ func TestGetTeamMemberPaginatesProjectAssignments(t *testing.T) {
 		t.Fatalf("project IDs = %#v, want %#v", got, want)
 	}
 }
+
+func TestListProjectsPaginates(t *testing.T) {
+	client := newPaginationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v8/projects" {
+			t.Fatalf("path = %q, want /v8/projects", r.URL.Path)
+		}
+		requireQuery(t, r, "teamId", "team_123")
+		requireQuery(t, r, "limit", "100")
+
+		switch r.URL.Query().Get("until") {
+		case "":
+			fmt.Fprintln(w, `{
+				"projects": [{"id":"prj_1","name":"one"}],
+				"pagination": {"count":1,"next":123}
+			}`)
+		case "123":
+			fmt.Fprintln(w, `{
+				"projects": [{"id":"prj_2","name":"two"}],
+				"pagination": {"count":1}
+			}`)
+		default:
+			t.Fatalf("unexpected until %q", r.URL.Query().Get("until"))
+		}
+	})
+
+	projects, err := client.ListProjects(context.Background(), "team_123")
+	if err != nil {
+		t.Fatalf("ListProjects() error = %v", err)
+	}
+
+	if got, want := []string{projects[0].ID, projects[1].ID}, []string{"prj_1", "prj_2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("project IDs = %#v, want %#v", got, want)
+	}
+	if projects[0].TeamID != "team_123" || projects[1].TeamID != "team_123" {
+		t.Fatalf("TeamID values = %#v, %#v; want team_123", projects[0].TeamID, projects[1].TeamID)
+	}
+}
+
+func TestListDNSRecordsPaginatesAndSetsDomain(t *testing.T) {
+	client := newPaginationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/domains/example.com/records" {
+			t.Fatalf("path = %q, want /v4/domains/example.com/records", r.URL.Path)
+		}
+		requireQuery(t, r, "limit", "100")
+
+		switch r.URL.Query().Get("until") {
+		case "":
+			fmt.Fprintln(w, `{
+				"records": [{"id":"rec_1","name":"www","type":"A","value":"127.0.0.1"}],
+				"pagination": {"count":1,"next":123}
+			}`)
+		case "123":
+			fmt.Fprintln(w, `{
+				"records": [{"id":"rec_2","name":"","type":"MX","value":"mail.example.com","mxPriority":10}],
+				"pagination": {"count":1}
+			}`)
+		default:
+			t.Fatalf("unexpected until %q", r.URL.Query().Get("until"))
+		}
+	})
+
+	records, err := client.ListDNSRecords(context.Background(), "example.com", "")
+	if err != nil {
+		t.Fatalf("ListDNSRecords() error = %v", err)
+	}
+
+	if got, want := []string{records[0].ID, records[1].ID}, []string{"rec_1", "rec_2"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("record IDs = %#v, want %#v", got, want)
+	}
+	if records[0].RecordType != "A" || records[1].RecordType != "MX" || records[1].MXPriority != 10 {
+		t.Fatalf("records = %+v, want the list type and mxPriority fields decoded", records)
+	}
+	if records[0].Domain != "example.com" || records[1].Domain != "example.com" {
+		t.Fatalf("Domain values = %#v, %#v; want example.com", records[0].Domain, records[1].Domain)
+	}
+}
+
+func TestListProjectDomainsPaginates(t *testing.T) {
+	client := newPaginationTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v9/projects/prj_123/domains" {
+			t.Fatalf("path = %q, want /v9/projects/prj_123/domains", r.URL.Path)
+		}
+		requireQuery(t, r, "teamId", "team_123")
+		requireQuery(t, r, "limit", "100")
+
+		switch r.URL.Query().Get("until") {
+		case "":
+			fmt.Fprintln(w, `{
+				"domains": [{"name":"one.example.com","projectId":"prj_123"}],
+				"pagination": {"count":1,"next":123}
+			}`)
+		case "123":
+			fmt.Fprintln(w, `{
+				"domains": [{"name":"two.example.com","projectId":"prj_123"}],
+				"pagination": {"count":1}
+			}`)
+		default:
+			t.Fatalf("unexpected until %q", r.URL.Query().Get("until"))
+		}
+	})
+
+	domains, err := client.ListProjectDomains(context.Background(), "prj_123", "team_123")
+	if err != nil {
+		t.Fatalf("ListProjectDomains() error = %v", err)
+	}
+
+	if got, want := []string{domains[0].Name, domains[1].Name}, []string{"one.example.com", "two.example.com"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("domain names = %#v, want %#v", got, want)
+	}
+}
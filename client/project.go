@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -311,30 +312,60 @@ func (c *Client) GetProject(ctx context.Context, projectID, teamID string) (r Pr
 	return r, err
 }
 
-// ListProjects lists the top 100 projects (no pagination) from within Vercel.
-func (c *Client) ListProjects(ctx context.Context, teamID string) (r []ProjectResponse, err error) {
-	url := fmt.Sprintf("%s/v8/projects?limit=100", c.baseURL)
-	if c.TeamID(teamID) != "" {
-		url = fmt.Sprintf("%s&teamId=%s", url, c.TeamID(teamID))
+type ListProjectsRequest struct {
+	TeamID string
+	Limit  int
+	Until  *int64
+	Since  *int64
+}
+
+type ListProjectsResponse struct {
+	Projects   []ProjectResponse
+	Pagination PageInfo
+}
+
+// ListProjectsPage lists a single page of projects from within Vercel.
+func (c *Client) ListProjectsPage(ctx context.Context, request ListProjectsRequest) (ListProjectsResponse, error) {
+	baseURL := fmt.Sprintf("%s/v8/projects", c.baseURL)
+	query := url.Values{}
+	if c.TeamID(request.TeamID) != "" {
+		query.Set("teamId", c.TeamID(request.TeamID))
 	}
+	url := urlWithQuery(baseURL, paginationQuery(query, request.Limit, request.Until, request.Since))
 
 	pr := struct {
-		Projects []ProjectResponse `json:"projects"`
+		Projects   []ProjectResponse `json:"projects"`
+		Pagination PageInfo          `json:"pagination"`
 	}{}
 	tflog.Info(ctx, "listing projects", map[string]any{
 		"url": url,
 	})
-	err = c.doRequest(clientRequest{
+	err := c.doRequest(clientRequest{
 		ctx:    ctx,
 		method: "GET",
 		url:    url,
 		body:   "",
 	}, &pr)
 	for i := range pr.Projects {
-		pr.Projects[i].TeamID = c.TeamID(teamID)
+		pr.Projects[i].TeamID = c.TeamID(request.TeamID)
 		pr.Projects[i].normalizeBuildMachineType()
 	}
-	return pr.Projects, err
+	return ListProjectsResponse{
+		Projects:   pr.Projects,
+		Pagination: pr.Pagination,
+	}, err
+}
+
+// ListProjects lists all projects from within Vercel, following pagination.
+func (c *Client) ListProjects(ctx context.Context, teamID string) ([]ProjectResponse, error) {
+	return collectPages(func(until *int64) ([]ProjectResponse, PageInfo, error) {
+		response, err := c.ListProjectsPage(ctx, ListProjectsRequest{
+			TeamID: teamID,
+			Limit:  defaultPaginationLimit,
+			Until:  until,
+		})
+		return response.Projects, response.Pagination, err
+	})
 }
 
 // UpdateProjectRequest defines the possible fields that can be updated within a vercel project.
@@ -3,6 +3,7 @@ package client
 import (
 	"context"
 	"fmt"
+	"net/url"
 
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
@@ -131,3 +132,60 @@ func (c *Client) UpdateProjectDomain(ctx context.Context, projectID, domain, tea
 	r.TeamID = c.TeamID(teamID)
 	return r, err
 }
+
+type ListProjectDomainsRequest struct {
+	ProjectID string
+	TeamID    string
+	Limit     int
+	Until     *int64
+	Since     *int64
+}
+
+type ListProjectDomainsResponse struct {
+	Domains    []ProjectDomainResponse
+	Pagination PageInfo
+}
+
+// ListProjectDomainsPage lists a single page of domains associated with a project.
+func (c *Client) ListProjectDomainsPage(ctx context.Context, request ListProjectDomainsRequest) (ListProjectDomainsResponse, error) {
+	baseURL := fmt.Sprintf("%s/v9/projects/%s/domains", c.baseURL, request.ProjectID)
+	query := url.Values{}
+	if c.TeamID(request.TeamID) != "" {
+		query.Set("teamId", c.TeamID(request.TeamID))
+	}
+	url := urlWithQuery(baseURL, paginationQuery(query, request.Limit, request.Until, request.Since))
+
+	response := struct {
+		Domains    []ProjectDomainResponse `json:"domains"`
+		Pagination PageInfo                `json:"pagination"`
+	}{}
+	tflog.Info(ctx, "listing project domains", map[string]any{
+		"url": url,
+	})
+	err := c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "GET",
+		url:    url,
+		body:   "",
+	}, &response)
+	for i := 0; i < len(response.Domains); i++ {
+		response.Domains[i].TeamID = c.TeamID(request.TeamID)
+	}
+	return ListProjectDomainsResponse{
+		Domains:    response.Domains,
+		Pagination: response.Pagination,
+	}, err
+}
+
+// ListProjectDomains lists all the domains associated with a project, following pagination.
+func (c *Client) ListProjectDomains(ctx context.Context, projectID, teamID string) ([]ProjectDomainResponse, error) {
+	return collectPages(func(until *int64) ([]ProjectDomainResponse, PageInfo, error) {
+		response, err := c.ListProjectDomainsPage(ctx, ListProjectDomainsRequest{
+			ProjectID: projectID,
+			TeamID:    teamID,
+			Limit:     defaultPaginationLimit,
+			Until:     until,
+		})
+		return response.Domains, response.Pagination, err
+	})
+}
@@ -73,6 +73,7 @@ type projectRouteVersionsResponse struct {
 }
 
 type ProjectRoutingRuleInput struct {
+	ID          string                 `json:"id,omitempty"`
 	Name        string                 `json:"name"`
 	Description *string                `json:"description,omitempty"`
 	Enabled     *bool                  `json:"enabled,omitempty"`
@@ -116,6 +117,12 @@ type updateProjectRoutingRuleVersionResponse struct {
 	Version ProjectRouteVersion `json:"version"`
 }
 
+type StageProjectRoutesRequest struct {
+	TeamID    string                    `json:"-"`
+	ProjectID string                    `json:"-"`
+	Routes    []ProjectRoutingRuleInput `json:"routes"`
+}
+
 type DeleteProjectRoutesRequest struct {
 	TeamID    string   `json:"-"`
 	ProjectID string   `json:"-"`
@@ -254,6 +261,38 @@ func (c *Client) DeleteProjectRoutes(ctx context.Context, request DeleteProjectR
 	return response, nil
 }
 
+// StageProjectRoutes replaces the full ordered list of routing rules in a single
+// staged version. Rules that set an ID keep it, rules without one are created,
+// and existing rules missing from the list are removed. The staged version is
+// not promoted.
+func (c *Client) StageProjectRoutes(ctx context.Context, request StageProjectRoutesRequest) (response ProjectRoutingRulesResponse, err error) {
+	urlStr := fmt.Sprintf("%s/v1/projects/%s/routes", c.baseURL, request.ProjectID)
+	if c.TeamID(request.TeamID) != "" {
+		urlStr = fmt.Sprintf("%s?teamId=%s", urlStr, c.TeamID(request.TeamID))
+	}
+
+	if request.Routes == nil {
+		request.Routes = []ProjectRoutingRuleInput{}
+	}
+	payload := string(mustMarshal(request))
+	tflog.Info(ctx, "staging project routing rules", map[string]any{
+		"url":     urlStr,
+		"payload": payload,
+	})
+
+	err = c.doRequest(clientRequest{
+		ctx:    ctx,
+		method: "PUT",
+		url:    urlStr,
+		body:   payload,
+	}, &response)
+	if err != nil {
+		return response, fmt.Errorf("unable to stage project routing rules: %w", err)
+	}
+
+	return response, nil
+}
+
 func (c *Client) UpdateProjectRoutingRuleVersion(ctx context.Context, request UpdateProjectRoutingRuleVersionRequest) (version ProjectRouteVersion, err error) {
 	urlStr := fmt.Sprintf("%s/v1/projects/%s/routes/versions", c.baseURL, request.ProjectID)
 	if c.TeamID(request.TeamID) != "" {
@@ -0,0 +1,93 @@
+package client_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestStageProjectRoutes(t *testing.T) {
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPut || r.URL.Path != "/v1/projects/prj_123/routes" {
+			t.Fatalf("unexpected request: %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.URL.Query().Get("teamId"); got != "team_123" {
+			t.Errorf("teamId = %q, want team_123", got)
+		}
+		var body struct {
+			Routes []map[string]any `json:"routes"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("unable to decode body: %s", err)
+		}
+		if len(body.Routes) != 2 {
+			t.Fatalf("got %d routes, want 2", len(body.Routes))
+		}
+		if body.Routes[0]["id"] != "rt_1" {
+			t.Errorf("first route id = %v, want rt_1", body.Routes[0]["id"])
+		}
+		if _, ok := body.Routes[1]["id"]; ok {
+			t.Errorf("new route should not send an id, got %v", body.Routes[1]["id"])
+		}
+		fmt.Fprint(w, `{
+			"routes": [
+				{"id": "rt_1", "name": "first", "route": {"src": "/a", "dest": "/b"}},
+				{"id": "rt_2", "name": "second", "route": {"src": "/c", "status": 404}}
+			],
+			"version": {"id": "ver_1", "isStaging": true, "isLive": false, "ruleCount": 2}
+		}`)
+	}))
+	t.Cleanup(h.Close)
+	cl := client.New("INVALID").WithBaseURL(fmt.Sprintf("http://%s", h.Listener.Addr().String()))
+
+	dest := "/b"
+	status := int64(404)
+	response, err := cl.StageProjectRoutes(context.Background(), client.StageProjectRoutesRequest{
+		TeamID:    "team_123",
+		ProjectID: "prj_123",
+		Routes: []client.ProjectRoutingRuleInput{
+			{ID: "rt_1", Name: "first", Route: client.ProjectRouteDefinition{Src: "/a", Dest: &dest}},
+			{Name: "second", Route: client.ProjectRouteDefinition{Src: "/c", Status: &status}},
+		},
+	})
+	if err != nil {
+		t.Fatalf("StageProjectRoutes() error = %s", err)
+	}
+	if response.Version.ID != "ver_1" || !response.Version.IsStaging {
+		t.Errorf("unexpected version %+v", response.Version)
+	}
+	if len(response.Routes) != 2 || response.Routes[1].ID != "rt_2" {
+		t.Errorf("unexpected routes %+v", response.Routes)
+	}
+}
+
+func TestStageProjectRoutesEmpty(t *testing.T) {
+	h := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var body map[string]json.RawMessage
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			t.Fatalf("unable to decode body: %s", err)
+		}
+		if got := string(body["routes"]); got != "[]" {
+			t.Errorf("routes = %s, want []", got)
+		}
+		fmt.Fprint(w, `{"routes": [], "version": {"id": "ver_2", "isStaging": true}}`)
+	}))
+	t.Cleanup(h.Close)
+	cl := client.New("INVALID").WithBaseURL(fmt.Sprintf("http://%s", h.Listener.Addr().String()))
+
+	response, err := cl.StageProjectRoutes(context.Background(), client.StageProjectRoutesRequest{
+		TeamID:    "team_123",
+		ProjectID: "prj_123",
+	})
+	if err != nil {
+		t.Fatalf("StageProjectRoutes() error = %s", err)
+	}
+	if response.Version.ID != "ver_2" {
+		t.Errorf("version = %q, want ver_2", response.Version.ID)
+	}
+}
@@ -0,0 +1,20 @@
+resource "vercel_blob_store" "example" {
+  name = "example-blob-store"
+}
+
+# List every object under the assets/ prefix.
+data "vercel_blob_objects" "assets" {
+  store_id = vercel_blob_store.example.id
+  prefix   = "assets/"
+}
+
+# List only the objects and folders directly under the assets/ prefix.
+data "vercel_blob_objects" "assets_top_level" {
+  store_id = vercel_blob_store.example.id
+  prefix   = "assets/"
+  mode     = "folded"
+}
+
+output "asset_urls" {
+  value = { for object in data.vercel_blob_objects.assets.objects : object.pathname => object.url }
+}
@@ -0,0 +1,9 @@
+data "vercel_dns_zonefile" "example" {
+  domain = "example.com"
+}
+
+# Keep an audit copy of the records for the domain.
+resource "local_file" "zone" {
+  filename = "${path.module}/example.com.zone"
+  content  = data.vercel_dns_zonefile.example.zone_file
+}
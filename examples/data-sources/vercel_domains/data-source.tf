@@ -0,0 +1,5 @@
+data "vercel_domains" "example" {}
+
+output "unverified_domains" {
+  value = [for d in data.vercel_domains.example.domains : d.name if !d.verified]
+}
@@ -0,0 +1,8 @@
+data "vercel_edge_config_backups" "example" {
+  edge_config_id = "ecfg_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  limit          = 5
+}
+
+output "latest_backup_items" {
+  value = data.vercel_edge_config_backups.example.backups[0].items
+}
@@ -0,0 +1,9 @@
+data "vercel_feature_flag_revisions" "example" {
+  project_id  = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  flag_id     = "flag_xxxxxxxxxxxxxxxxxxxxxxxx"
+  environment = "production"
+}
+
+output "latest_production_change" {
+  value = data.vercel_feature_flag_revisions.example.revisions[0].message
+}
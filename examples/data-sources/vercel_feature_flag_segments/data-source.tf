@@ -0,0 +1,11 @@
+data "vercel_feature_flag_segments" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+# Segments that no flag or segment references.
+output "unused_segments" {
+  value = [
+    for segment in data.vercel_feature_flag_segments.example.segments : segment.slug
+    if length(segment.used_by_flags) == 0 && length(segment.used_by_segments) == 0
+  ]
+}
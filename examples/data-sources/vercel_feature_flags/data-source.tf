@@ -0,0 +1,9 @@
+data "vercel_feature_flags" "example" {
+  project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  state      = "active"
+  kind       = "boolean"
+}
+
+output "boolean_flag_keys" {
+  value = data.vercel_feature_flags.example.flags[*].key
+}
@@ -0,0 +1,11 @@
+data "vercel_firewall_bypasses" "example" {
+  project_id = vercel_project.example.id
+}
+
+# Fail the plan if any bypass rule applies to every domain of the project.
+check "no_project_wide_bypasses" {
+  assert {
+    condition     = alltrue([for b in data.vercel_firewall_bypasses.example.bypasses : b.domain != "*"])
+    error_message = "Project-wide firewall bypass rules are not allowed."
+  }
+}
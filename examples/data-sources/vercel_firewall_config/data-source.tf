@@ -0,0 +1,15 @@
+data "vercel_firewall_config" "example" {
+  project_id = vercel_project.example.id
+}
+
+check "firewall_baseline" {
+  assert {
+    condition     = data.vercel_firewall_config.example.enabled
+    error_message = "The firewall must be enabled."
+  }
+
+  assert {
+    condition     = try(data.vercel_firewall_config.example.managed_rulesets.owasp.sqli.active, false)
+    error_message = "The OWASP SQL injection rule must be active."
+  }
+}
@@ -0,0 +1,22 @@
+# Assert that the rollout behaves as intended before it is applied.
+check "checkout_rollout" {
+  assert {
+    condition = provider::vercel::evaluate_feature_flag(
+      vercel_feature_flag_config.example,
+      "production",
+      { user = { id = "user_1", email = "alice@example.com" } },
+      [vercel_feature_flag_segment.beta],
+    ).variant_id == "treatment"
+    error_message = "Beta testers should get the new checkout in production."
+  }
+
+  assert {
+    condition = provider::vercel::evaluate_feature_flag(
+      vercel_feature_flag_config.example,
+      "production",
+      { user = { id = "user_2", plan = "hobby" } },
+      [vercel_feature_flag_segment.beta],
+    ).reason == "fallthrough"
+    error_message = "Hobby users should not match any rule."
+  }
+}
@@ -0,0 +1,18 @@
+# Migrate the records exported from another DNS provider, such as Route53 or Cloudflare.
+locals {
+  records = provider::vercel::parse_dns_zonefile("example.com", file("${path.module}/example.com.zone"))
+}
+
+resource "vercel_dns_record" "migrated" {
+  for_each = { for i, r in local.records : "${r.type}-${r.name}-${i}" => r }
+
+  domain      = "example.com"
+  name        = each.value.name
+  type        = each.value.type
+  value       = each.value.value
+  ttl         = each.value.ttl
+  mx_priority = each.value.mx_priority
+  srv         = each.value.srv
+  https       = each.value.https
+  comment     = each.value.comment
+}
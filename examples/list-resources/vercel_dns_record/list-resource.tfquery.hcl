@@ -0,0 +1,7 @@
+list "vercel_dns_record" "example_com" {
+  provider = vercel
+
+  config {
+    domain = "example.com"
+  }
+}
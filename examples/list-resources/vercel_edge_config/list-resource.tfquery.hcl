@@ -0,0 +1,3 @@
+list "vercel_edge_config" "all" {
+  provider = vercel
+}
@@ -0,0 +1,7 @@
+list "vercel_project" "all" {
+  provider = vercel
+
+  config {
+    team_id = "team_xxxxxxxxxxxxxxxxxxxxxxxx"
+  }
+}
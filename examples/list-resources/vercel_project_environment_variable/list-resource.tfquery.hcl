@@ -0,0 +1,7 @@
+list "vercel_project_environment_variable" "example" {
+  provider = vercel
+
+  config {
+    project_id = "prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+  }
+}
@@ -0,0 +1,3 @@
+list "vercel_shared_environment_variable" "all" {
+  provider = vercel
+}
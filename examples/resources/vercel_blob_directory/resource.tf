@@ -0,0 +1,26 @@
+resource "vercel_blob_store" "example" {
+  name = "example-static-assets"
+}
+
+resource "vercel_blob_directory" "example" {
+  store_id = vercel_blob_store.example.id
+  source   = "${path.module}/public"
+  prefix   = "static/"
+
+  ignore_patterns = ["*.map", "drafts/"]
+
+  content_types = {
+    ".wasm" = "application/wasm"
+  }
+
+  # Cache fingerprinted assets for a year, but HTML only briefly.
+  cache_control_max_age = 86400
+  cache_control = [
+    { pattern = "*.html", max_age = 60 },
+    { pattern = "assets/**", max_age = 31536000 },
+  ]
+}
+
+output "logo_url" {
+  value = vercel_blob_directory.example.objects["static/assets/logo.svg"].url
+}
@@ -0,0 +1,33 @@
+resource "vercel_blob_store" "example" {
+  name = "example-blob-store"
+}
+
+resource "vercel_blob_store" "archive" {
+  name = "example-blob-archive"
+}
+
+resource "vercel_blob_object" "logo" {
+  store_id = vercel_blob_store.example.id
+  pathname = "branding/logo.svg"
+  source   = "${path.module}/files/logo.svg"
+}
+
+# Copy the object to another pathname in the same store.
+resource "vercel_blob_object_copy" "favicon" {
+  source_store_id = vercel_blob_object.logo.store_id
+  source_pathname = vercel_blob_object.logo.pathname
+  pathname        = "favicon.svg"
+
+  lifecycle {
+    replace_triggered_by = [vercel_blob_object.logo.etag]
+  }
+}
+
+# Copy the object into another store, with a longer cache lifetime.
+resource "vercel_blob_object_copy" "archived_logo" {
+  source_store_id       = vercel_blob_object.logo.store_id
+  source_pathname       = vercel_blob_object.logo.pathname
+  store_id              = vercel_blob_store.archive.id
+  pathname              = "2026/branding/logo.svg"
+  cache_control_max_age = 31536000
+}
@@ -0,0 +1,27 @@
+resource "vercel_project" "example" {
+	name = "example-project"
+}
+
+# Stage the redirects without publishing them. The staged version can be
+# tested through its preview alias before it is promoted.
+resource "vercel_bulk_redirects" "example" {
+	project_id = vercel_project.example.id
+	promote    = false
+	redirects = [
+		{
+			source      = "/old-path"
+			destination = "/new-path"
+			status_code = 308
+		},
+	]
+}
+
+output "redirects_preview_alias" {
+	value = vercel_bulk_redirects.example.preview_alias
+}
+
+# Publish the staged version. Set version_id to an earlier version to roll back.
+resource "vercel_bulk_redirects_promotion" "example" {
+	project_id = vercel_project.example.id
+	version_id = vercel_bulk_redirects.example.version_id
+}
@@ -0,0 +1,10 @@
+# If importing into a personal account, or with a team configured on
+# the provider, simply use the project_id and deploy hook id.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+# - the deploy hook id can be found in the `deploy_hooks` of the project.
+terraform import vercel_deploy_hook.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/xxxxxxxxxx
+
+# Alternatively, you can import via the team_id, project_id and deploy hook id.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+terraform import vercel_deploy_hook.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/xxxxxxxxxx
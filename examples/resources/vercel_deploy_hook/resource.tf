@@ -0,0 +1,19 @@
+resource "vercel_project" "example" {
+  name = "example-project-with-deploy-hook"
+  git_repository = {
+    type = "github"
+    repo = "vercel/some-repo"
+  }
+}
+
+resource "vercel_deploy_hook" "main" {
+  project_id = vercel_project.example.id
+  name       = "main"
+  ref        = "main"
+}
+
+resource "vercel_deploy_hook" "staging" {
+  project_id = vercel_project.example.id
+  name       = "staging"
+  ref        = "staging"
+}
@@ -0,0 +1,44 @@
+resource "vercel_domain" "example" {
+  name = "example.com"
+}
+
+# Declare every record on the domain individually.
+resource "vercel_dns_zone" "example" {
+  domain = vercel_domain.example.name
+  records = [
+    {
+      name  = "www"
+      type  = "CNAME"
+      value = "cname.vercel-dns.com"
+    },
+    {
+      name        = ""
+      type        = "MX"
+      value       = "mail.example.com"
+      mx_priority = 10
+    },
+    {
+      name    = ""
+      type    = "TXT"
+      value   = "v=spf1 include:_spf.example.com ~all"
+      ttl     = 3600
+      comment = "SPF"
+    },
+    {
+      name = "_sip._tcp"
+      type = "SRV"
+      srv = {
+        priority = 10
+        weight   = 5
+        port     = 5060
+        target   = "sip.example.com"
+      }
+    },
+  ]
+}
+
+# Or, manage the domain's records from a BIND zone file.
+resource "vercel_dns_zone" "from_file" {
+  domain    = "example.org"
+  zone_file = file("${path.module}/example.org.zone")
+}
@@ -0,0 +1,22 @@
+resource "vercel_domain" "example" {
+  name            = "example.com"
+  nameserver_mode = "vercel"
+}
+
+# DNS records can reference the domain, so that they are only
+# created once the domain has been added to the team.
+resource "vercel_dns_record" "www" {
+  domain = vercel_domain.example.name
+  name   = "www"
+  type   = "CNAME"
+  ttl    = 60
+  value  = "cname.vercel-dns.com."
+}
+
+# Transfer a domain in from another registrar.
+resource "vercel_domain" "transferred" {
+  name                    = "example.org"
+  transfer_auth_code      = var.example_org_auth_code
+  transfer_expected_price = 20
+  auto_renew              = true
+}
@@ -0,0 +1,38 @@
+resource "vercel_edge_config" "example" {
+  name = "example"
+}
+
+resource "vercel_edge_config_schema" "example" {
+  id = vercel_edge_config.example.id
+  definition = jsonencode({
+    type = "object"
+    properties = {
+      greeting = { type = "string" }
+      flags = {
+        type = "object"
+        properties = {
+          new_checkout = { type = "boolean" }
+          rollout      = { type = "number", minimum = 0, maximum = 1 }
+        }
+      }
+      blocked_countries = { type = "array", items = { type = "string" } }
+    }
+  })
+}
+
+resource "vercel_edge_config_items" "example" {
+  edge_config_id = vercel_edge_config.example.id
+  check_digest   = true
+
+  # Validate items during plan, before the schema has been written.
+  schema_definition = vercel_edge_config_schema.example.definition
+
+  items = {
+    greeting = jsonencode("hello world")
+    flags = jsonencode({
+      new_checkout = true
+      rollout      = 0.25
+    })
+    blocked_countries = jsonencode(["AQ", "BV"])
+  }
+}
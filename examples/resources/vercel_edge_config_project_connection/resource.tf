@@ -0,0 +1,15 @@
+resource "vercel_edge_config" "example" {
+  name = "example"
+}
+
+resource "vercel_project" "example" {
+  name = "example"
+}
+
+# Adds an EDGE_CONFIG environment variable to the project, which the
+# Edge Config client SDK reads by default.
+resource "vercel_edge_config_project_connection" "example" {
+  edge_config_id = vercel_edge_config.example.id
+  project_id     = vercel_project.example.id
+  target         = ["preview", "production"]
+}
@@ -0,0 +1,9 @@
+data "vercel_edge_config_backups" "example" {
+  edge_config_id = "ecfg_xxxxxxxxxxxxxxxxxxxxxxxxxxxx"
+}
+
+# Roll the Edge Config back to an earlier backup.
+resource "vercel_edge_config_restore" "example" {
+  edge_config_id = data.vercel_edge_config_backups.example.edge_config_id
+  backup_id      = data.vercel_edge_config_backups.example.backups[1].id
+}
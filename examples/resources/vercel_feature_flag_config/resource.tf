@@ -21,20 +21,82 @@ resource "vercel_feature_flag_definition" "example" {
   ]
 }
 
-resource "vercel_feature_flag_config" "example" {
+resource "vercel_feature_flag_segment" "beta" {
   project_id = vercel_project.example.id
-  flag_id    = vercel_feature_flag_definition.example.id
+  slug       = "beta-testers"
+  name       = "Beta testers"
+  include = [
+    {
+      entity    = "user"
+      attribute = "email"
+      values    = ["alice@example.com", "bob@example.com"]
+    },
+  ]
+}
+
+variable "change_message" {
+  description = "Recorded in the flag's revision history, for example a CI run ID."
+  type        = string
+  default     = null
+}
+
+resource "vercel_feature_flag_config" "example" {
+  project_id     = vercel_project.example.id
+  flag_id        = vercel_feature_flag_definition.example.id
+  change_message = var.change_message
 
   production = {
     enabled             = true
     default_variant_id  = "control"
     disabled_variant_id = "control"
+
+    # Rules are evaluated in order, and the first match wins.
+    rules = [
+      {
+        # Everyone in the beta segment gets the new checkout.
+        conditions = [
+          {
+            segment_ids = [vercel_feature_flag_segment.beta.id]
+            operator    = "oneOf"
+          },
+        ]
+        variant_id = "treatment"
+      },
+      {
+        # Roll out to 10% of paying customers.
+        conditions = [
+          {
+            entity    = "user"
+            attribute = "plan"
+            operator  = "oneOf"
+            values    = ["pro", "enterprise"]
+          },
+        ]
+        split = {
+          entity              = "user"
+          attribute           = "id"
+          weights             = { control = 90, treatment = 10 }
+          fallback_variant_id = "control"
+        }
+      },
+    ]
+
+    # Individual users that always get a specific variant.
+    targets = [
+      {
+        variant_id = "treatment"
+        entity     = "user"
+        attribute  = "id"
+        values     = ["user_123"]
+      },
+    ]
   }
 
+  # Preview serves exactly what production serves.
   preview = {
     enabled             = true
-    default_variant_id  = "treatment"
     disabled_variant_id = "control"
+    reuse_environment   = "production"
   }
 
   development = {
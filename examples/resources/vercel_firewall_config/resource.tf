@@ -46,6 +46,10 @@ resource "vercel_firewall_config" "example" {
       action = {
         action = "challenge"
       }
+      # log matching requests for three days before challenging them
+      staged_rollout = {
+        review_period = "72h"
+      }
     }
 
     rule {
@@ -175,6 +179,16 @@ resource "vercel_firewall_config" "managed" {
       lfi  = { action = "deny" }
       rfi  = { action = "deny" }
       gen  = { action = "deny" }
+
+      paranoia_level            = 2
+      inbound_anomaly_threshold = 7
+
+      # Turn off a single SQL injection rule that causes false positives,
+      # and only log another, without changing the rest of the group.
+      rule_overrides = {
+        "942100" = { active = false }
+        "942200" = { action = "log" }
+      }
     }
 
     bot_protection {
@@ -0,0 +1,10 @@
+# If importing with a team configured on the provider, use the project ID and ip rule ID.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+# - ip_rule_id can be read from the Vercel firewall config API.
+terraform import vercel_firewall_ip_rule.example prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/ip_xxxxxxxxxxxxxxxxxxxx
+
+# Alternatively, you can import via the team_id, project_id, and ip_rule_id.
+# - team_id can be found in the team `settings` tab in the Vercel UI.
+# - project_id can be found in the project `settings` tab in the Vercel UI.
+# - ip_rule_id can be read from the Vercel firewall config API.
+terraform import vercel_firewall_ip_rule.example team_xxxxxxxxxxxxxxxxxxxxxxxx/prj_xxxxxxxxxxxxxxxxxxxxxxxxxxxx/ip_xxxxxxxxxxxxxxxxxxxx
@@ -0,0 +1,18 @@
+resource "vercel_project" "example" {
+  name = "firewall-ip-rule-example"
+}
+
+resource "vercel_firewall_ip_rule" "office" {
+  project_id = vercel_project.example.id
+  hostname   = "example.com"
+  ip         = "203.0.113.0/24"
+  notes      = "Office network"
+  action     = "bypass"
+}
+
+resource "vercel_firewall_ip_rule" "abuse" {
+  project_id = vercel_project.example.id
+  hostname   = "example.com"
+  ip         = "198.51.100.7"
+  action     = "deny"
+}
@@ -0,0 +1,48 @@
+resource "vercel_project" "example" {
+  name = "firewall-rule-example"
+}
+
+# Owned by the platform security team.
+resource "vercel_firewall_rule" "block_bots" {
+  project_id  = vercel_project.example.id
+  name        = "Block bad bots"
+  description = "Known scraper user agents"
+  priority    = 0
+  action = {
+    action = "deny"
+  }
+  condition_group = [{
+    conditions = [{
+      type   = "user_agent"
+      op     = "inc"
+      values = ["badbot", "scraperbot"]
+    }]
+  }]
+}
+
+# Owned by the application team.
+resource "vercel_firewall_rule" "api_rate_limit" {
+  project_id = vercel_project.example.id
+  name       = "Rate limit the API"
+  # Log the rule until someone has reviewed its matches, then set promote = true.
+  staged_rollout = {
+    promote = false
+  }
+  action = {
+    action = "rate_limit"
+    rate_limit = {
+      algo   = "fixed_window"
+      window = 60
+      limit  = 100
+      keys   = ["ip"]
+      action = "deny"
+    }
+  }
+  condition_group = [{
+    conditions = [{
+      type  = "path"
+      op    = "pre"
+      value = "/api"
+    }]
+  }]
+}
@@ -0,0 +1,39 @@
+variable "project_ids" {
+  type = set(string)
+}
+
+# The same rules are kept in sync across every project in the set.
+resource "vercel_firewall_ruleset" "shared" {
+  name        = "shared-protections"
+  project_ids = var.project_ids
+
+  rules = [
+    {
+      name        = "Block abusive networks"
+      description = "Known abusive autonomous systems"
+      action = {
+        action = "deny"
+      }
+      condition_group = [{
+        conditions = [{
+          type   = "geo_as_number"
+          op     = "inc"
+          values = ["64496", "64511"]
+        }]
+      }]
+    },
+    {
+      name = "Block bad bots"
+      action = {
+        action = "deny"
+      }
+      condition_group = [{
+        conditions = [{
+          type   = "user_agent"
+          op     = "inc"
+          values = ["badbot", "scraperbot"]
+        }]
+      }]
+    },
+  ]
+}
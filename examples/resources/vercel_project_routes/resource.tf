@@ -0,0 +1,38 @@
+resource "vercel_project" "example" {
+	name = "example-project"
+}
+
+resource "vercel_project_routes" "example" {
+	project_id = vercel_project.example.id
+	rules = [
+		{
+			name = "redirect-legacy-docs"
+			route = {
+				src    = "/docs/:path*"
+				dest   = "/guides/:path*"
+				status = 308
+			}
+		},
+		{
+			name = "rewrite-eu-campaign"
+			route = {
+				src  = "/promo"
+				dest = "/campaigns/eu"
+				has = [
+					{
+						type  = "header"
+						key   = "x-region"
+						value = "eu"
+					}
+				]
+			}
+		},
+		{
+			name = "hide-admin"
+			route = {
+				src    = "/admin/:path*"
+				status = 404
+			}
+		},
+	]
+}
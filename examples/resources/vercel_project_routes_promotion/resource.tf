@@ -0,0 +1,30 @@
+resource "vercel_project" "example" {
+	name = "example-project"
+}
+
+# Stage the routing table without publishing it. The staged version can be
+# tested through its preview alias before it is promoted.
+resource "vercel_project_routes" "example" {
+	project_id = vercel_project.example.id
+	promote    = false
+	rules = [
+		{
+			name = "redirect-legacy-docs"
+			route = {
+				src    = "/docs/:path*"
+				dest   = "/guides/:path*"
+				status = 308
+			}
+		},
+	]
+}
+
+output "routes_preview_alias" {
+	value = vercel_project_routes.example.preview_alias
+}
+
+# Publish the staged version. Set version_id to an earlier version to roll back.
+resource "vercel_project_routes_promotion" "example" {
+	project_id = vercel_project.example.id
+	version_id = vercel_project_routes.example.version_id
+}
@@ -5,6 +5,7 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"fmt"
+	"io"
 	"mime"
 	"os"
 	"path"
@@ -141,14 +142,22 @@ func inferBlobObjectContentType(pathname string) string {
 	return contentType
 }
 
-func readBlobObjectSource(filename string) (content []byte, sourceSHA256 string, etag string, err error) {
-	content, err = os.ReadFile(filename)
+// hashBlobObjectSource streams the source file to work out its size, its
+// SHA-256 and the MD5 ETag a single request upload of it would have, without
+// holding the file in memory.
+func hashBlobObjectSource(filename string) (size int64, sourceSHA256 string, etag string, err error) {
+	f, err := os.Open(filename)
 	if err != nil {
-		return nil, "", "", err
+		return 0, "", "", err
 	}
+	defer f.Close()
 
-	shaSum := sha256.Sum256(content)
-	md5Sum := md5.Sum(content)
+	shaSum := sha256.New()
+	md5Sum := md5.New()
+	size, err = io.Copy(io.MultiWriter(shaSum, md5Sum), f)
+	if err != nil {
+		return 0, "", "", err
+	}
 
-	return content, hex.EncodeToString(shaSum[:]), hex.EncodeToString(md5Sum[:]), nil
+	return size, hex.EncodeToString(shaSum.Sum(nil)), hex.EncodeToString(md5Sum.Sum(nil)), nil
 }
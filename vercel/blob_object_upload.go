@@ -0,0 +1,192 @@
+package vercel
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+// Sources at least this large are uploaded in parts rather than in a single
+// request, so that they are never held in memory in full.
+const blobObjectMultipartThreshold int64 = 100 * 1024 * 1024
+
+// blobObjectUploadDir holds a journal for each multipart upload in progress,
+// so that an upload interrupted by a failed apply resumes from the last part
+// uploaded. It is a variable so tests can redirect it.
+var blobObjectUploadDir = func() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "terraform-provider-vercel", "blob-uploads"), nil
+}
+
+type blobObjectUploadJournal struct {
+	StoreID      string                     `json:"storeId"`
+	Pathname     string                     `json:"pathname"`
+	SourceSHA256 string                     `json:"sourceSha256"`
+	Upload       client.BlobMultipartUpload `json:"upload"`
+	Parts        []client.BlobMultipartPart `json:"parts"`
+}
+
+// uploadBlobObject uploads the source of a Blob object, switching to a
+// multipart upload for large sources.
+func uploadBlobObject(ctx context.Context, c *client.Client, plan BlobObjectResourceModel) (client.BlobObject, error) {
+	f, err := os.Open(plan.Source.ValueString())
+	if err != nil {
+		return client.BlobObject{}, err
+	}
+	defer f.Close()
+
+	info, err := f.Stat()
+	if err != nil {
+		return client.BlobObject{}, err
+	}
+
+	if info.Size() < blobObjectMultipartThreshold {
+		content, err := io.ReadAll(f)
+		if err != nil {
+			return client.BlobObject{}, err
+		}
+		return c.PutBlobObject(ctx, client.PutBlobObjectRequest{
+			Body:               content,
+			CacheControlMaxAge: plan.CacheControlMaxAge.ValueInt64(),
+			ContentType:        plan.ContentType.ValueString(),
+			Pathname:           plan.Pathname.ValueString(),
+			StoreID:            plan.StoreID.ValueString(),
+			TeamID:             plan.TeamID.ValueString(),
+		})
+	}
+
+	journalPath, err := blobObjectUploadJournalPath(plan.StoreID.ValueString(), plan.Pathname.ValueString())
+	if err != nil {
+		tflog.Warn(ctx, "blob object upload cannot be resumed if it fails", map[string]any{
+			"error": err.Error(),
+		})
+	}
+	journal := blobObjectUploadJournal{
+		StoreID:      plan.StoreID.ValueString(),
+		Pathname:     plan.Pathname.ValueString(),
+		SourceSHA256: plan.SourceSHA256.ValueString(),
+	}
+	request := client.PutBlobObjectMultipartRequest{
+		Body:               f,
+		CacheControlMaxAge: plan.CacheControlMaxAge.ValueInt64(),
+		ContentType:        plan.ContentType.ValueString(),
+		Pathname:           plan.Pathname.ValueString(),
+		StoreID:            plan.StoreID.ValueString(),
+		TeamID:             plan.TeamID.ValueString(),
+	}
+	if journalPath != "" {
+		if previous, ok := readBlobObjectUploadJournal(journalPath); ok && previous.SourceSHA256 == journal.SourceSHA256 {
+			tflog.Info(ctx, "resuming blob object upload", map[string]any{
+				"pathname":       journal.Pathname,
+				"upload_id":      previous.Upload.UploadID,
+				"uploaded_parts": len(previous.Parts),
+			})
+			journal = previous
+			request.Upload = &previous.Upload
+			request.CompletedParts = previous.Parts
+		}
+		request.OnPartUploaded = func(upload client.BlobMultipartUpload, part client.BlobMultipartPart) {
+			journal.Upload = upload
+			journal.Parts = append(journal.Parts, part)
+			if err := writeBlobObjectUploadJournal(journalPath, journal); err != nil {
+				tflog.Warn(ctx, "could not record blob object upload progress", map[string]any{
+					"error": err.Error(),
+				})
+			}
+		}
+	}
+
+	object, err := c.PutBlobObjectMultipart(ctx, request)
+	if request.Upload != nil && client.NotFound(err) {
+		// The upload being resumed has expired, so start again from the
+		// beginning of the source.
+		tflog.Info(ctx, "blob object upload expired, restarting", map[string]any{
+			"pathname":  journal.Pathname,
+			"upload_id": request.Upload.UploadID,
+		})
+		if _, err := f.Seek(0, io.SeekStart); err != nil {
+			return object, err
+		}
+		journal.Parts = nil
+		request.Upload, request.CompletedParts = nil, nil
+		object, err = c.PutBlobObjectMultipart(ctx, request)
+	}
+	if err != nil {
+		return object, err
+	}
+
+	if journalPath != "" {
+		if err := os.Remove(journalPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+			tflog.Warn(ctx, "could not remove blob object upload journal", map[string]any{
+				"error": err.Error(),
+				"path":  journalPath,
+			})
+		}
+	}
+	if object.Size == 0 {
+		object.Size = info.Size()
+	}
+	return object, nil
+}
+
+// copyBlobObject copies an object on the server and reads the copy back, as
+// the copy response does not include the ETag, size or upload time.
+func copyBlobObject(ctx context.Context, c *client.Client, request client.CopyBlobObjectRequest) (client.BlobObject, error) {
+	if _, err := c.CopyBlobObject(ctx, request); err != nil {
+		return client.BlobObject{}, err
+	}
+	return c.GetBlobObject(ctx, client.GetBlobObjectRequest{
+		Pathname: request.Pathname,
+		StoreID:  request.StoreID,
+		TeamID:   request.TeamID,
+	})
+}
+
+func blobObjectUploadJournalPath(storeID, pathname string) (string, error) {
+	dir, err := blobObjectUploadDir()
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256([]byte(blobObjectID(storeID, pathname)))
+	return filepath.Join(dir, hex.EncodeToString(sum[:])+".json"), nil
+}
+
+func readBlobObjectUploadJournal(filename string) (journal blobObjectUploadJournal, ok bool) {
+	content, err := os.ReadFile(filename)
+	if err != nil {
+		return journal, false
+	}
+	if err := json.Unmarshal(content, &journal); err != nil || journal.Upload.UploadID == "" {
+		return journal, false
+	}
+	return journal, true
+}
+
+func writeBlobObjectUploadJournal(filename string, journal blobObjectUploadJournal) error {
+	if err := os.MkdirAll(filepath.Dir(filename), 0o700); err != nil {
+		return err
+	}
+	content, err := json.Marshal(journal)
+	if err != nil {
+		return err
+	}
+	// Write then rename, so a crash never leaves a truncated journal behind.
+	tmp := fmt.Sprintf("%s.%d.tmp", filename, os.Getpid())
+	if err := os.WriteFile(tmp, content, 0o600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, filename)
+}
@@ -0,0 +1,73 @@
+package vercel
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestHashBlobObjectSource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "object.txt")
+	if err := os.WriteFile(source, []byte("foo"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	size, sourceSHA256, etag, err := hashBlobObjectSource(source)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if size != 3 {
+		t.Errorf("expected size 3, got %d", size)
+	}
+	if want := "2c26b46b68ffc68ff99b453c1d30413413422d706483bfa0f98a5e886266e7ae"; sourceSHA256 != want {
+		t.Errorf("expected sha256 %s, got %s", want, sourceSHA256)
+	}
+	if want := "acbd18db4cc2f85cedef654fccc4a4d8"; etag != want {
+		t.Errorf("expected etag %s, got %s", want, etag)
+	}
+}
+
+func TestBlobObjectUploadJournal(t *testing.T) {
+	dir := t.TempDir()
+	original := blobObjectUploadDir
+	defer func() { blobObjectUploadDir = original }()
+	blobObjectUploadDir = func() (string, error) { return dir, nil }
+
+	journalPath, err := blobObjectUploadJournalPath("store_123", "media/large.bin")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	other, _ := blobObjectUploadJournalPath("store_123", "media/other.bin")
+	if journalPath == other || filepath.Dir(journalPath) != dir {
+		t.Fatalf("expected a distinct journal per object within %s, got %s and %s", dir, journalPath, other)
+	}
+
+	if _, ok := readBlobObjectUploadJournal(journalPath); ok {
+		t.Fatal("expected no journal before the upload starts")
+	}
+
+	journal := blobObjectUploadJournal{
+		StoreID:      "store_123",
+		Pathname:     "media/large.bin",
+		SourceSHA256: "abc",
+		Upload:       client.BlobMultipartUpload{Key: "key", UploadID: "upload_1"},
+		Parts:        []client.BlobMultipartPart{{PartNumber: 1, ETag: "etag-1"}},
+	}
+	if err := writeBlobObjectUploadJournal(journalPath, journal); err != nil {
+		t.Fatalf("unexpected error writing journal: %s", err)
+	}
+	got, ok := readBlobObjectUploadJournal(journalPath)
+	if !ok || !reflect.DeepEqual(got, journal) {
+		t.Errorf("expected journal %+v, got %+v", journal, got)
+	}
+
+	if err := os.WriteFile(journalPath, []byte("{"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := readBlobObjectUploadJournal(journalPath); ok {
+		t.Error("expected a corrupt journal to be ignored")
+	}
+}
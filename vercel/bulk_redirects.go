@@ -101,13 +101,36 @@ func findLiveBulkRedirectVersion(versions []client.BulkRedirectVersion) (client.
 	return client.BulkRedirectVersion{}, false
 }
 
+func findStagedBulkRedirectVersion(versions []client.BulkRedirectVersion) (client.BulkRedirectVersion, bool) {
+	for _, version := range versions {
+		if version.IsStaging {
+			return version, true
+		}
+	}
+
+	return client.BulkRedirectVersion{}, false
+}
+
 func readLiveBulkRedirects(ctx context.Context, c *client.Client, projectID, teamID string) (client.BulkRedirects, bool, error) {
+	return readBulkRedirects(ctx, c, projectID, teamID, false)
+}
+
+// readBulkRedirects reads the live redirects. When staged is true and the
+// project has an unpublished staged version, that version is read instead.
+// The boolean result is false if there is no version to read.
+func readBulkRedirects(ctx context.Context, c *client.Client, projectID, teamID string, staged bool) (client.BulkRedirects, bool, error) {
 	versions, err := c.GetBulkRedirectVersions(ctx, projectID, teamID)
 	if err != nil {
 		return client.BulkRedirects{}, false, err
 	}
 
-	version, ok := findLiveBulkRedirectVersion(versions)
+	version, ok := client.BulkRedirectVersion{}, false
+	if staged {
+		version, ok = findStagedBulkRedirectVersion(versions)
+	}
+	if !ok {
+		version, ok = findLiveBulkRedirectVersion(versions)
+	}
 	if !ok {
 		return client.BulkRedirects{
 			ProjectID: projectID,
@@ -65,3 +65,47 @@ func TestFlattenAndExpandBulkRedirects(t *testing.T) {
 		t.Fatalf("expected query %t, got %#v", query, redirect.Query)
 	}
 }
+
+func TestFindStagedBulkRedirectVersion(t *testing.T) {
+	t.Parallel()
+
+	if _, ok := findStagedBulkRedirectVersion([]client.BulkRedirectVersion{{ID: "ver_live", IsLive: true}}); ok {
+		t.Fatal("expected no staged version")
+	}
+
+	version, ok := findStagedBulkRedirectVersion([]client.BulkRedirectVersion{
+		{ID: "ver_live", IsLive: true},
+		{ID: "ver_staging", IsStaging: true},
+	})
+	if !ok {
+		t.Fatal("expected to find a staged version")
+	}
+	if version.ID != "ver_staging" {
+		t.Fatalf("expected staged version %q, got %q", "ver_staging", version.ID)
+	}
+}
+
+func TestBulkRedirectsResourceStateFromResponse(t *testing.T) {
+	t.Parallel()
+
+	alias := "redirects-preview.vercel.app"
+	result := bulkRedirectsResourceStateFromResponse(client.BulkRedirects{
+		ProjectID: "prj_123",
+		TeamID:    "team_123",
+		Version:   &client.BulkRedirectVersion{ID: "ver_staging", IsStaging: true, Alias: &alias},
+	}, false)
+	if result.Promote.ValueBool() {
+		t.Fatal("expected promote to be false")
+	}
+	if result.VersionID.ValueString() != "ver_staging" {
+		t.Fatalf("expected version ver_staging, got %q", result.VersionID.ValueString())
+	}
+	if result.PreviewAlias.ValueString() != alias {
+		t.Fatalf("expected preview alias %q, got %q", alias, result.PreviewAlias.ValueString())
+	}
+
+	result = bulkRedirectsResourceStateFromResponse(client.BulkRedirects{ProjectID: "prj_123"}, true)
+	if !result.VersionID.IsNull() || !result.PreviewAlias.IsNull() {
+		t.Fatalf("expected no version, got %q %q", result.VersionID.ValueString(), result.PreviewAlias.ValueString())
+	}
+}
@@ -0,0 +1,199 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &blobObjectsDataSource{}
+	_ datasource.DataSourceWithConfigure = &blobObjectsDataSource{}
+)
+
+func newBlobObjectsDataSource() datasource.DataSource {
+	return &blobObjectsDataSource{}
+}
+
+type blobObjectsDataSource struct {
+	client *client.Client
+}
+
+type BlobObjectsDataSourceModel struct {
+	ID      types.String                `tfsdk:"id"`
+	StoreID types.String                `tfsdk:"store_id"`
+	TeamID  types.String                `tfsdk:"team_id"`
+	Prefix  types.String                `tfsdk:"prefix"`
+	Mode    types.String                `tfsdk:"mode"`
+	Objects []BlobObjectsDataSourceItem `tfsdk:"objects"`
+	Folders []string                    `tfsdk:"folders"`
+}
+
+type BlobObjectsDataSourceItem struct {
+	Pathname    types.String `tfsdk:"pathname"`
+	Size        types.Int64  `tfsdk:"size"`
+	UploadedAt  types.String `tfsdk:"uploaded_at"`
+	URL         types.String `tfsdk:"url"`
+	DownloadURL types.String `tfsdk:"download_url"`
+	ContentType types.String `tfsdk:"content_type"`
+}
+
+func (d *blobObjectsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blob_objects"
+}
+
+func (d *blobObjectsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *blobObjectsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a list of the objects in a Vercel Blob store.
+
+Objects can be limited to those under a pathname prefix. In ` + "`folded`" + ` mode, only the objects directly under the prefix are listed, and the folders below it are listed in ` + "`folders`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The unique identifier for this list. Format: `store_id/prefix`.",
+			},
+			"store_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Blob store to list the objects of.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team that owns the Blob store. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"prefix": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list objects whose pathname starts with this prefix, such as `assets/`.",
+			},
+			"mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Either `expanded`, to list every object under the prefix, or `folded`, to list only the objects directly under the prefix and the folders below it. Defaults to `expanded`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("expanded", "folded"),
+				},
+			},
+			"objects": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The objects in the store, ordered by pathname.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pathname": schema.StringAttribute{
+							Computed:    true,
+							Description: "The pathname of the Blob object within the store.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The size of the Blob object in bytes.",
+						},
+						"uploaded_at": schema.StringAttribute{
+							Computed:    true,
+							Description: "The timestamp at which the Blob object was uploaded.",
+						},
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The canonical URL for the Blob object.",
+						},
+						"download_url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Blob object URL with download semantics enabled.",
+						},
+						"content_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The content type stored on the Blob object, if the store reports it when listing.",
+						},
+					},
+				},
+			},
+			"folders": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The folders directly under the prefix. Only set in `folded` mode.",
+			},
+		},
+	}
+}
+
+func (d *blobObjectsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config BlobObjectsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Mode.IsNull() || config.Mode.IsUnknown() {
+		config.Mode = types.StringValue("expanded")
+	}
+	list, err := d.client.ListBlobObjects(ctx, client.ListBlobObjectsRequest{
+		Mode:    config.Mode.ValueString(),
+		Prefix:  config.Prefix.ValueString(),
+		StoreID: config.StoreID.ValueString(),
+		TeamID:  config.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Blob objects",
+			fmt.Sprintf("Could not list Blob objects in store %s, unexpected error: %s", config.StoreID.ValueString(), err),
+		)
+		return
+	}
+
+	config.ID = types.StringValue(blobObjectID(config.StoreID.ValueString(), config.Prefix.ValueString()))
+	config.TeamID = toTeamID(d.client.TeamID(config.TeamID.ValueString()))
+	config.Objects = make([]BlobObjectsDataSourceItem, len(list.Blobs))
+	for i, blob := range list.Blobs {
+		contentType := types.StringValue(blob.ContentType)
+		if blob.ContentType == "" {
+			contentType = types.StringNull()
+		}
+		config.Objects[i] = BlobObjectsDataSourceItem{
+			Pathname:    types.StringValue(blob.Pathname),
+			Size:        types.Int64Value(blob.Size),
+			UploadedAt:  types.StringValue(blob.UploadedAt),
+			URL:         types.StringValue(blob.URL),
+			DownloadURL: types.StringValue(blob.DownloadURL),
+			ContentType: contentType,
+		}
+	}
+	config.Folders = list.Folders
+	if config.Folders == nil {
+		config.Folders = []string{}
+	}
+
+	tflog.Info(ctx, "read blob objects data source", map[string]any{
+		"store_id": config.StoreID.ValueString(),
+		"prefix":   config.Prefix.ValueString(),
+		"count":    len(config.Objects),
+	})
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,79 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_BlobObjectsDataSource(t *testing.T) {
+	suffix := acctest.RandString(16)
+	storeName := fmt.Sprintf("test-acc-blob-list-%s", suffix)
+	sourceOne := testBlobObjectSourcePath(t, "object-one.txt")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccBlobObjectsDataSourceConfig(storeName, sourceOne)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.expanded", "mode", "expanded"),
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.expanded", "team_id", testTeam(t)),
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.expanded", "objects.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.expanded", "objects.0.pathname", "terraform/a.txt"),
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.expanded", "objects.1.pathname", "terraform/nested/b.txt"),
+					resource.TestCheckResourceAttrPair("data.vercel_blob_objects.expanded", "objects.0.url", "vercel_blob_object.a", "url"),
+					resource.TestCheckResourceAttrSet("data.vercel_blob_objects.expanded", "objects.0.size"),
+					resource.TestCheckResourceAttrSet("data.vercel_blob_objects.expanded", "objects.0.uploaded_at"),
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.expanded", "folders.#", "0"),
+
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.folded", "objects.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.folded", "objects.0.pathname", "terraform/a.txt"),
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.folded", "folders.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_blob_objects.folded", "folders.0", "terraform/nested/"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlobObjectsDataSourceConfig(storeName, source string) string {
+	return fmt.Sprintf(`
+resource "vercel_blob_store" "test" {
+  name = "%s"
+}
+
+resource "vercel_blob_object" "a" {
+  store_id = vercel_blob_store.test.id
+  pathname = "terraform/a.txt"
+  source   = %[2]s
+}
+
+resource "vercel_blob_object" "b" {
+  store_id = vercel_blob_store.test.id
+  pathname = "terraform/nested/b.txt"
+  source   = %[2]s
+}
+
+data "vercel_blob_objects" "expanded" {
+  store_id = vercel_blob_store.test.id
+  prefix   = "terraform/"
+  depends_on = [
+    vercel_blob_object.a,
+    vercel_blob_object.b,
+  ]
+}
+
+data "vercel_blob_objects" "folded" {
+  store_id = vercel_blob_store.test.id
+  prefix   = "terraform/"
+  mode     = "folded"
+  depends_on = [
+    vercel_blob_object.a,
+    vercel_blob_object.b,
+  ]
+}
+`, storeName, hclStringLiteral(source))
+}
@@ -0,0 +1,128 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &dnsZonefileDataSource{}
+	_ datasource.DataSourceWithConfigure = &dnsZonefileDataSource{}
+)
+
+func newDNSZonefileDataSource() datasource.DataSource {
+	return &dnsZonefileDataSource{}
+}
+
+type dnsZonefileDataSource struct {
+	client *client.Client
+}
+
+func (d *dnsZonefileDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zonefile"
+}
+
+func (d *dnsZonefileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *dnsZonefileDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the DNS records for a domain rendered as an RFC 1035 (BIND) zone file.
+
+This can be used to audit or export the records for a domain. To go the other way, and turn a zone file into records, use the ` + "`parse_dns_zonefile`" + ` function.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The team ID that the domain and DNS records belong to. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain name whose DNS records should be exported.",
+			},
+			"ignore_system_records": schema.BoolAttribute{
+				Optional:    true,
+				Description: "Whether records that Vercel creates and manages automatically, such as the apex `ALIAS` and `CAA` records, should be left out of the zone file. Defaults to `false`.",
+			},
+			"zone_file": schema.StringAttribute{
+				Computed:    true,
+				Description: "The DNS records for the domain, as an RFC 1035 (BIND) zone file.",
+			},
+		},
+	}
+}
+
+// DNSZonefileDataSourceModel reflects the structure of the vercel_dns_zonefile data source.
+type DNSZonefileDataSourceModel struct {
+	TeamID              types.String `tfsdk:"team_id"`
+	Domain              types.String `tfsdk:"domain"`
+	IgnoreSystemRecords types.Bool   `tfsdk:"ignore_system_records"`
+	ZoneFile            types.String `tfsdk:"zone_file"`
+}
+
+func (d *dnsZonefileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DNSZonefileDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := config.Domain.ValueString()
+	records, err := d.client.ListDNSRecords(ctx, domain, config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone file",
+			fmt.Sprintf("Could not list DNS records for %s, unexpected error: %s", domain, err),
+		)
+		return
+	}
+
+	if config.IgnoreSystemRecords.ValueBool() {
+		filtered := records[:0]
+		for _, record := range records {
+			if record.Creator != "system" {
+				filtered = append(filtered, record)
+			}
+		}
+		records = filtered
+	}
+
+	result := DNSZonefileDataSourceModel{
+		TeamID:              toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+		Domain:              config.Domain,
+		IgnoreSystemRecords: config.IgnoreSystemRecords,
+		ZoneFile:            types.StringValue(client.RenderZoneFile(domain, records)),
+	}
+	tflog.Info(ctx, "read DNS zone file data source", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  domain,
+		"records": len(records),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
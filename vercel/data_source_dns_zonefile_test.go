@@ -0,0 +1,60 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DNSZonefileDataSource(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccDNSRecordDestroy(testClient(t), "vercel_dns_record.test", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+locals {
+  records = provider::vercel::parse_dns_zonefile("%[1]s", <<-EOT
+    $ORIGIN %[1]s.
+    zonefile-%[2]s 120 IN TXT "exported" ; from a zone file
+  EOT
+  )
+}
+
+resource "vercel_dns_record" "test" {
+  domain      = "%[1]s"
+  name        = local.records[0].name
+  type        = local.records[0].type
+  value       = local.records[0].value
+  ttl         = local.records[0].ttl
+  mx_priority = local.records[0].mx_priority
+  srv         = local.records[0].srv
+  https       = local.records[0].https
+  comment     = local.records[0].comment
+}
+
+data "vercel_dns_zonefile" "test" {
+  domain = vercel_dns_record.test.domain
+}
+`, testDomain(t), nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_record.test", "name", "zonefile-"+nameSuffix),
+					resource.TestCheckResourceAttr("vercel_dns_record.test", "ttl", "120"),
+					resource.TestCheckResourceAttr("vercel_dns_record.test", "comment", "from a zone file"),
+					resource.TestCheckResourceAttrSet("data.vercel_dns_zonefile.test", "team_id"),
+					resource.TestMatchResourceAttr(
+						"data.vercel_dns_zonefile.test",
+						"zone_file",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^zonefile-%s\t120\tIN\tTXT\t"exported" ; from a zone file$`, nameSuffix)),
+					),
+				),
+			},
+		},
+	})
+}
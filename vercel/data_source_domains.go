@@ -0,0 +1,200 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &domainsDataSource{}
+	_ datasource.DataSourceWithConfigure = &domainsDataSource{}
+)
+
+func newDomainsDataSource() datasource.DataSource {
+	return &domainsDataSource{}
+}
+
+type domainsDataSource struct {
+	client *client.Client
+}
+
+type DomainsDataSourceModel struct {
+	Domains []DomainListItem `tfsdk:"domains"`
+	TeamID  types.String     `tfsdk:"team_id"`
+}
+
+type DomainListItem struct {
+	ID                   types.String `tfsdk:"id"`
+	Name                 types.String `tfsdk:"name"`
+	Verified             types.Bool   `tfsdk:"verified"`
+	NameserverMode       types.String `tfsdk:"nameserver_mode"`
+	ServiceType          types.String `tfsdk:"service_type"`
+	Nameservers          []string     `tfsdk:"nameservers"`
+	IntendedNameservers  []string     `tfsdk:"intended_nameservers"`
+	CustomNameservers    []string     `tfsdk:"custom_nameservers"`
+	AutoRenew            types.Bool   `tfsdk:"auto_renew"`
+	RegisteredWithVercel types.Bool   `tfsdk:"registered_with_vercel"`
+	TransferStatus       types.String `tfsdk:"transfer_status"`
+	CreatedAt            types.Int64  `tfsdk:"created_at"`
+	ExpiresAt            types.Int64  `tfsdk:"expires_at"`
+}
+
+func domainListItemFromResponse(domain client.Domain) DomainListItem {
+	return DomainListItem{
+		ID:                   types.StringValue(domain.ID),
+		Name:                 types.StringValue(domain.Name),
+		Verified:             types.BoolValue(domain.Verified),
+		NameserverMode:       types.StringValue(domainNameserverMode(domain, types.StringNull())),
+		ServiceType:          types.StringValue(domain.ServiceType),
+		Nameservers:          nonNilStrings(domain.Nameservers),
+		IntendedNameservers:  nonNilStrings(domain.IntendedNameservers),
+		CustomNameservers:    nonNilStrings(domain.CustomNameservers),
+		AutoRenew:            types.BoolPointerValue(domain.Renew),
+		RegisteredWithVercel: types.BoolValue(domain.RegisteredWithVercel()),
+		TransferStatus:       types.StringValue(domain.TransferStatus()),
+		CreatedAt:            types.Int64Value(domain.CreatedAt),
+		ExpiresAt:            types.Int64PointerValue(domain.ExpiresAt),
+	}
+}
+
+func (d *domainsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domains"
+}
+
+func (d *domainsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *domainsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Provides the domains that have been added to the configured team or personal account, along with their verification status, nameservers and expiry.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team whose domains should be listed. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"domains": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The domains within the team or personal account, sorted by name.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the domain.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The domain name.",
+						},
+						"verified": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the ownership of the domain has been verified.",
+						},
+						"nameserver_mode": schema.StringAttribute{
+							Computed:    true,
+							Description: "Whether the domain is intended to use Vercel's nameservers (`vercel`), or nameservers hosted elsewhere (`external`).",
+						},
+						"service_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "How the domain's DNS is served. `zeit.world` when Vercel is the DNS provider, `external` when nameservers are hosted elsewhere, or `na` when unknown.",
+						},
+						"nameservers": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The nameservers the domain is currently using.",
+						},
+						"intended_nameservers": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The Vercel nameservers the domain should be pointed at to use Vercel DNS.",
+						},
+						"custom_nameservers": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Custom nameservers configured for a domain registered through Vercel.",
+						},
+						"auto_renew": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the domain is automatically renewed. Only set for domains registered through Vercel.",
+						},
+						"registered_with_vercel": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the domain was purchased from, or transferred in to, Vercel.",
+						},
+						"transfer_status": schema.StringAttribute{
+							Computed:    true,
+							Description: "The status of an inbound registrar transfer. One of `none`, `pending` or `completed`.",
+						},
+						"created_at": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The Unix timestamp, in milliseconds, when the domain was added.",
+						},
+						"expires_at": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The Unix timestamp, in milliseconds, when the domain registration expires. Only set for domains registered through Vercel.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *domainsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config DomainsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domains, err := d.client.ListDomains(ctx, config.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domains",
+			fmt.Sprintf("Could not read domains for team %s, unexpected error: %s", config.TeamID.ValueString(), err),
+		)
+		return
+	}
+
+	sort.Slice(domains, func(i, j int) bool {
+		return domains[i].Name < domains[j].Name
+	})
+	result := DomainsDataSourceModel{
+		Domains: make([]DomainListItem, 0, len(domains)),
+		TeamID:  toTeamID(d.client.TeamID(config.TeamID.ValueString())),
+	}
+	for _, domain := range domains {
+		result.Domains = append(result.Domains, domainListItemFromResponse(domain))
+	}
+
+	tflog.Info(ctx, "read domains data source", map[string]any{
+		"count":   len(result.Domains),
+		"team_id": result.TeamID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
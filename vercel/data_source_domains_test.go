@@ -0,0 +1,47 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_DomainsDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             noopDestroyCheck,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(`data "vercel_domains" "test" {}`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vercel_domains.test", "team_id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_domains.test", "domains.*", map[string]string{
+						"name": testDomain(t),
+					}),
+				),
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+data "vercel_domains" "test" {}
+
+locals {
+  domain = one([for d in data.vercel_domains.test.domains : d if d.name == "%s"])
+}
+
+resource "vercel_dns_record" "test" {
+  domain = local.domain.name
+  name   = "test-acc-domains-data-source"
+  type   = "TXT"
+  ttl    = 120
+  value  = local.domain.nameserver_mode
+}
+`, testDomain(t))),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_record.test", "domain", testDomain(t)),
+					resource.TestCheckResourceAttrSet("vercel_dns_record.test", "value"),
+				),
+			},
+		},
+	})
+}
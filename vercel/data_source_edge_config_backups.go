@@ -0,0 +1,192 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &edgeConfigBackupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &edgeConfigBackupsDataSource{}
+)
+
+func newEdgeConfigBackupsDataSource() datasource.DataSource {
+	return &edgeConfigBackupsDataSource{}
+}
+
+type edgeConfigBackupsDataSource struct {
+	client *client.Client
+}
+
+type EdgeConfigBackupsDataSourceModel struct {
+	ID           types.String                      `tfsdk:"id"`
+	EdgeConfigID types.String                      `tfsdk:"edge_config_id"`
+	TeamID       types.String                      `tfsdk:"team_id"`
+	Limit        types.Int64                       `tfsdk:"limit"`
+	Backups      []EdgeConfigBackupsDataSourceItem `tfsdk:"backups"`
+}
+
+type EdgeConfigBackupsDataSourceItem struct {
+	ID         types.String `tfsdk:"id"`
+	CreatedAt  types.Int64  `tfsdk:"created_at"`
+	ItemsCount types.Int64  `tfsdk:"items_count"`
+	Digest     types.String `tfsdk:"digest"`
+	Items      types.Map    `tfsdk:"items"`
+}
+
+const defaultEdgeConfigBackupsLimit = 10
+
+func (d *edgeConfigBackupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_config_backups"
+}
+
+func (d *edgeConfigBackupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *edgeConfigBackupsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the most recent backups of an Edge Config.
+
+A backup of an Edge Config is kept each time its items change. A backup can be restored with the ` + "`vercel_edge_config_restore`" + ` resource.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the Edge Config.",
+			},
+			"edge_config_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Edge Config to list the backups of.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Edge Config exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"limit": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: fmt.Sprintf("The maximum number of backups to return. Defaults to `%d`.", defaultEdgeConfigBackupsLimit),
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"backups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The backups of the Edge Config, most recent first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the backup.",
+						},
+						"created_at": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The Unix timestamp, in milliseconds, when the backup was taken.",
+						},
+						"items_count": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of items in the backup.",
+						},
+						"digest": schema.StringAttribute{
+							Computed:    true,
+							Description: "The digest of the Edge Config at the time of the backup.",
+						},
+						"items": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The items in the backup, as a map of key to JSON encoded value. Use `jsondecode(...)` to read values.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *edgeConfigBackupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config EdgeConfigBackupsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Limit.IsNull() || config.Limit.IsUnknown() {
+		config.Limit = types.Int64Value(defaultEdgeConfigBackupsLimit)
+	}
+	versions, err := d.client.ListEdgeConfigBackups(ctx, config.EdgeConfigID.ValueString(), config.TeamID.ValueString(), int(config.Limit.ValueInt64()))
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config Backups",
+			fmt.Sprintf("Could not list backups of Edge Config %s, unexpected error: %s", config.EdgeConfigID.ValueString(), err),
+		)
+		return
+	}
+
+	// The list only identifies the backups, so each one is read for its items
+	// and digest.
+	config.Backups = make([]EdgeConfigBackupsDataSourceItem, len(versions))
+	for i, version := range versions {
+		backup, err := d.client.GetEdgeConfigBackup(ctx, config.EdgeConfigID.ValueString(), version.ID, config.TeamID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Edge Config Backups",
+				fmt.Sprintf("Could not read backup %s of Edge Config %s, unexpected error: %s", version.ID, config.EdgeConfigID.ValueString(), err),
+			)
+			return
+		}
+		values, err := edgeConfigBackupItems(backup)
+		if err != nil {
+			resp.Diagnostics.AddError("Error parsing Edge Config Backup", err.Error())
+			return
+		}
+		items, diags := types.MapValueFrom(ctx, types.StringType, values)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		config.Backups[i] = EdgeConfigBackupsDataSourceItem{
+			ID:         types.StringValue(version.ID),
+			CreatedAt:  types.Int64Value(version.LastModified),
+			ItemsCount: types.Int64Value(int64(len(values))),
+			Digest:     types.StringValue(backup.Backup.Digest),
+			Items:      items,
+		}
+	}
+	config.ID = config.EdgeConfigID
+	config.TeamID = toTeamID(d.client.TeamID(config.TeamID.ValueString()))
+
+	tflog.Info(ctx, "read edge config backups", map[string]any{
+		"edge_config_id": config.EdgeConfigID.ValueString(),
+		"count":          len(config.Backups),
+	})
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
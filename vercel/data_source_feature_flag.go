@@ -28,17 +28,17 @@ type featureFlagDataSource struct {
 }
 
 type featureFlagDataSourceModel struct {
-	ID          types.String                 `tfsdk:"id"`
-	ProjectID   types.String                 `tfsdk:"project_id"`
-	TeamID      types.String                 `tfsdk:"team_id"`
-	Key         types.String                 `tfsdk:"key"`
-	Description types.String                 `tfsdk:"description"`
-	Kind        types.String                 `tfsdk:"kind"`
-	Archived    types.Bool                   `tfsdk:"archived"`
-	Variant     []featureFlagVariantModel    `tfsdk:"variant"`
-	Production  *featureFlagEnvironmentModel `tfsdk:"production"`
-	Preview     *featureFlagEnvironmentModel `tfsdk:"preview"`
-	Development *featureFlagEnvironmentModel `tfsdk:"development"`
+	ID          types.String                       `tfsdk:"id"`
+	ProjectID   types.String                       `tfsdk:"project_id"`
+	TeamID      types.String                       `tfsdk:"team_id"`
+	Key         types.String                       `tfsdk:"key"`
+	Description types.String                       `tfsdk:"description"`
+	Kind        types.String                       `tfsdk:"kind"`
+	Archived    types.Bool                         `tfsdk:"archived"`
+	Variant     []featureFlagVariantModel          `tfsdk:"variant"`
+	Production  *featureFlagConfigEnvironmentModel `tfsdk:"production"`
+	Preview     *featureFlagConfigEnvironmentModel `tfsdk:"preview"`
+	Development *featureFlagConfigEnvironmentModel `tfsdk:"development"`
 }
 
 func (d *featureFlagDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -62,6 +62,32 @@ func (d *featureFlagDataSource) Configure(ctx context.Context, req datasource.Co
 	d.client = client
 }
 
+func featureFlagDataSourceSplitSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"entity": schema.StringAttribute{
+				Computed:    true,
+				Description: "The entity type whose attribute decides the bucket, for example `user`.",
+			},
+			"attribute": schema.StringAttribute{
+				Computed:    true,
+				Description: "The entity attribute that is hashed to pick a variant, for example `id`.",
+			},
+			"weights": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Float64Type,
+				Description: "The relative weight of each variant, keyed by variant ID.",
+			},
+			"fallback_variant_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The variant served when the entity or attribute is missing from the evaluation context.",
+			},
+		},
+	}
+}
+
 func featureFlagDataSourceEnvironmentSchema(description string) schema.SingleNestedAttribute {
 	return schema.SingleNestedAttribute{
 		Computed:    true,
@@ -73,12 +99,89 @@ func featureFlagDataSourceEnvironmentSchema(description string) schema.SingleNes
 			},
 			"default_variant_id": schema.StringAttribute{
 				Computed:    true,
-				Description: "The variant served when this environment is enabled and no rules match.",
+				Description: "The variant served when this environment is enabled and no rules match. Null when `default_split` or `reuse_environment` is set.",
 			},
+			"default_split": featureFlagDataSourceSplitSchema("The weighted split of variants served when this environment is enabled and no rules match."),
 			"disabled_variant_id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The variant served while this environment is disabled or paused.",
 			},
+			"reuse_environment": schema.StringAttribute{
+				Computed:    true,
+				Description: "The environment whose rules, targets and default outcome this environment reuses.",
+			},
+			"rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "Targeting rules, evaluated in order. The first rule whose conditions all match decides the outcome.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"conditions": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "The conditions that must all match for this rule to apply.",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"entity": schema.StringAttribute{
+										Computed:    true,
+										Description: "The entity type to compare, for example `user`.",
+									},
+									"attribute": schema.StringAttribute{
+										Computed:    true,
+										Description: "The entity attribute to compare, for example `email`.",
+									},
+									"segment_ids": schema.SetAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+										Description: "The segments the entity is matched against, when matching by segment membership.",
+									},
+									"operator": schema.StringAttribute{
+										Computed:    true,
+										Description: "How the attribute is compared.",
+									},
+									"value": schema.StringAttribute{
+										Computed:    true,
+										Description: "The value compared against, for operators that take a single value.",
+									},
+									"values": schema.SetAttribute{
+										Computed:    true,
+										ElementType: types.StringType,
+										Description: "The values compared against, for operators that take a list.",
+									},
+								},
+							},
+						},
+						"variant_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The variant served when this rule matches.",
+						},
+						"split": featureFlagDataSourceSplitSchema("The weighted split of variants served when this rule matches."),
+					},
+				},
+			},
+			"targets": schema.SetNestedAttribute{
+				Computed:    true,
+				Description: "Individual entities that are always served a specific variant.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"variant_id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The variant served to the targeted entities.",
+						},
+						"entity": schema.StringAttribute{
+							Computed:    true,
+							Description: "The entity type to target, for example `user`.",
+						},
+						"attribute": schema.StringAttribute{
+							Computed:    true,
+							Description: "The entity attribute to match, for example `id`.",
+						},
+						"values": schema.SetAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The exact attribute values that are served this variant.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -88,7 +191,7 @@ func (d *featureFlagDataSource) Schema(_ context.Context, req datasource.SchemaR
 		Description: `
 Provides information about an existing Feature Flag.
 
-This data source reads the simplified static flag shape exposed by this provider and looks up the flag by its stable ` + "`key`" + ` within a project.
+This data source looks up the flag by its stable ` + "`key`" + ` within a project. Each environment is read in the same shape as the ` + "`vercel_feature_flag_config`" + ` resource, including its rules, targets and splits.
 `,
 		Attributes: map[string]schema.Attribute{
 			"team_id": schema.StringAttribute{
@@ -194,7 +297,7 @@ func (d *featureFlagDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	resourceModel, diags := featureFlagFromClient(out, featureFlagModel{
+	resourceModel, diags := featureFlagFromClient(ctx, out, featureFlagModel{
 		ProjectID: config.ProjectID,
 		TeamID:    config.TeamID,
 	})
@@ -0,0 +1,217 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &featureFlagRevisionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &featureFlagRevisionsDataSource{}
+)
+
+func newFeatureFlagRevisionsDataSource() datasource.DataSource {
+	return &featureFlagRevisionsDataSource{}
+}
+
+type featureFlagRevisionsDataSource struct {
+	client *client.Client
+}
+
+type featureFlagRevisionsDataSourceModel struct {
+	ProjectID   types.String                         `tfsdk:"project_id"`
+	TeamID      types.String                         `tfsdk:"team_id"`
+	FlagID      types.String                         `tfsdk:"flag_id"`
+	Environment types.String                         `tfsdk:"environment"`
+	Revisions   []featureFlagRevisionsDataSourceItem `tfsdk:"revisions"`
+}
+
+type featureFlagRevisionsDataSourceItem struct {
+	ID                   types.String     `tfsdk:"id"`
+	Revision             types.Int64      `tfsdk:"revision"`
+	Message              types.String     `tfsdk:"message"`
+	CreatedBy            types.String     `tfsdk:"created_by"`
+	CreatedAt            types.Int64      `tfsdk:"created_at"`
+	EnvironmentRevisions map[string]int64 `tfsdk:"environment_revisions"`
+}
+
+func (d *featureFlagRevisionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flag_revisions"
+}
+
+func (d *featureFlagRevisionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *featureFlagRevisionsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the revision history of a Feature Flag.
+
+Vercel records a revision every time a flag changes, whether the change was made by Terraform or through the dashboard. Changes made by ` + "`vercel_feature_flag_definition`" + ` and ` + "`vercel_feature_flag_config`" + ` carry their ` + "`change_message`" + `.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Vercel project that owns the flag.",
+			},
+			"flag_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the feature flag.",
+			},
+			"environment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list revisions that changed this environment. Must be one of `production`, `preview`, or `development`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(featureFlagEnvironmentNames...),
+				},
+			},
+			"revisions": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The revisions of the flag, newest first.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the revision.",
+						},
+						"revision": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The revision number of the flag.",
+						},
+						"message": schema.StringAttribute{
+							Computed:    true,
+							Description: "The message recorded with the change, if any.",
+						},
+						"created_by": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the user or token that made the change.",
+						},
+						"created_at": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The time the revision was created, as a Unix timestamp in milliseconds.",
+						},
+						"environment_revisions": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.Int64Type,
+							Description: "The revision of each environment at this point in the history, keyed by environment name.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// featureFlagRevisionsFromClient sorts versions newest first. When an
+// environment is given, only the versions where that environment's revision
+// differs from the previous version are kept.
+func featureFlagRevisionsFromClient(versions []client.FeatureFlagVersion, environment string) []featureFlagRevisionsDataSourceItem {
+	sort.Slice(versions, func(i, j int) bool {
+		return versions[i].Revision < versions[j].Revision
+	})
+
+	items := make([]featureFlagRevisionsDataSourceItem, 0, len(versions))
+	var previous *int
+	for _, version := range versions {
+		environmentRevisions := map[string]int64{}
+		for name, env := range version.Environments {
+			if env.Revision != nil {
+				environmentRevisions[name] = int64(*env.Revision)
+			}
+		}
+
+		if environment != "" {
+			current := version.Environments[environment].Revision
+			changed := current != nil && (previous == nil || *previous != *current)
+			previous = current
+			if !changed {
+				continue
+			}
+		}
+
+		items = append(items, featureFlagRevisionsDataSourceItem{
+			ID:                   types.StringValue(version.ID),
+			Revision:             types.Int64Value(int64(version.Revision)),
+			Message:              featureFlagOptionalStringValue(version.Message, types.StringNull()),
+			CreatedBy:            featureFlagOptionalStringValue(version.CreatedBy, types.StringNull()),
+			CreatedAt:            types.Int64Value(version.CreatedAt),
+			EnvironmentRevisions: environmentRevisions,
+		})
+	}
+
+	for i, j := 0, len(items)-1; i < j; i, j = i+1, j-1 {
+		items[i], items[j] = items[j], items[i]
+	}
+	return items
+}
+
+func (d *featureFlagRevisionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config featureFlagRevisionsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.TeamID = types.StringValue(d.client.TeamID(config.TeamID.ValueString()))
+
+	versions, err := d.client.ListFeatureFlagVersions(ctx, client.ListFeatureFlagVersionsRequest{
+		ProjectID: config.ProjectID.ValueString(),
+		TeamID:    config.TeamID.ValueString(),
+		FlagID:    config.FlagID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Feature Flag Revisions",
+			fmt.Sprintf(
+				"Could not list Feature Flag Revisions %s %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				config.FlagID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	config.Revisions = featureFlagRevisionsFromClient(versions, config.Environment.ValueString())
+
+	tflog.Info(ctx, "read feature flag revisions data source", map[string]any{
+		"project_id": config.ProjectID.ValueString(),
+		"team_id":    config.TeamID.ValueString(),
+		"flag_id":    config.FlagID.ValueString(),
+		"count":      len(config.Revisions),
+	})
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,180 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &featureFlagSegmentsDataSource{}
+	_ datasource.DataSourceWithConfigure = &featureFlagSegmentsDataSource{}
+)
+
+func newFeatureFlagSegmentsDataSource() datasource.DataSource {
+	return &featureFlagSegmentsDataSource{}
+}
+
+type featureFlagSegmentsDataSource struct {
+	client *client.Client
+}
+
+type featureFlagSegmentsDataSourceModel struct {
+	ProjectID types.String                        `tfsdk:"project_id"`
+	TeamID    types.String                        `tfsdk:"team_id"`
+	Segments  []featureFlagSegmentsDataSourceItem `tfsdk:"segments"`
+}
+
+type featureFlagSegmentsDataSourceItem struct {
+	ID             types.String `tfsdk:"id"`
+	Slug           types.String `tfsdk:"slug"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Hint           types.String `tfsdk:"hint"`
+	UsedByFlags    []string     `tfsdk:"used_by_flags"`
+	UsedBySegments []string     `tfsdk:"used_by_segments"`
+}
+
+func featureFlagSegmentsDataSourceItemFromClient(segment client.FeatureFlagSegment) featureFlagSegmentsDataSourceItem {
+	return featureFlagSegmentsDataSourceItem{
+		ID:             types.StringValue(segment.ID),
+		Slug:           types.StringValue(segment.Slug),
+		Name:           types.StringValue(segment.Label),
+		Description:    featureFlagSegmentOptionalStringValue(segment.Description, types.StringNull()),
+		Hint:           featureFlagSegmentOptionalStringValue(segment.Hint, types.StringNull()),
+		UsedByFlags:    nonNilStrings(segment.UsedByFlags),
+		UsedBySegments: nonNilStrings(segment.UsedBySegments),
+	}
+}
+
+func (d *featureFlagSegmentsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flag_segments"
+}
+
+func (d *featureFlagSegmentsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *featureFlagSegmentsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the Feature Flag Segments of a project.
+
+Segments with rule logic are listed too, although the ` + "`vercel_feature_flag_segment`" + ` data source can only read the exact-match ones.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Vercel project that owns the segments.",
+			},
+			"segments": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The segments of the project, sorted by slug.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the segment.",
+						},
+						"slug": schema.StringAttribute{
+							Computed:    true,
+							Description: "The stable segment slug used by the Vercel Flags API.",
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "The human-readable segment name shown in the Vercel dashboard.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "A human-readable description of the segment.",
+						},
+						"hint": schema.StringAttribute{
+							Computed:    true,
+							Description: "An optional dashboard hint for the segment.",
+						},
+						"used_by_flags": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the flags whose rules reference this segment.",
+						},
+						"used_by_segments": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the segments whose rules reference this segment.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *featureFlagSegmentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config featureFlagSegmentsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.TeamID = types.StringValue(d.client.TeamID(config.TeamID.ValueString()))
+
+	segments, err := d.client.ListFeatureFlagSegments(ctx, client.ListFeatureFlagSegmentsRequest{
+		ProjectID: config.ProjectID.ValueString(),
+		TeamID:    config.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Feature Flag Segments",
+			fmt.Sprintf(
+				"Could not list Feature Flag Segments %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].Slug < segments[j].Slug
+	})
+	config.Segments = make([]featureFlagSegmentsDataSourceItem, 0, len(segments))
+	for _, segment := range segments {
+		config.Segments = append(config.Segments, featureFlagSegmentsDataSourceItemFromClient(segment))
+	}
+
+	tflog.Info(ctx, "read feature flag segments data source", map[string]any{
+		"project_id": config.ProjectID.ValueString(),
+		"team_id":    config.TeamID.ValueString(),
+		"count":      len(config.Segments),
+	})
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
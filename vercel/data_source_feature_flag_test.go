@@ -69,6 +69,72 @@ func TestAcc_FeatureFlagSDKKeyDataSource(t *testing.T) {
 	})
 }
 
+func TestAcc_FeatureFlagsDataSource(t *testing.T) {
+	projectSuffix := strings.ToLower(acctest.RandString(10))
+	key := fmt.Sprintf("homepage-%s", projectSuffix)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFeatureFlagsDataSourceConfig(projectSuffix, key)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_feature_flags.all", "flags.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_feature_flags.boolean", "flags.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vercel_feature_flags.boolean", "flags.0.id", "vercel_feature_flag_definition.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_feature_flags.boolean", "flags.0.key", key),
+					resource.TestCheckResourceAttr("data.vercel_feature_flags.boolean", "flags.0.variant_ids.#", "2"),
+					resource.TestCheckResourceAttr("data.vercel_feature_flags.archived", "flags.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_feature_flags.archived", "flags.0.kind", "string"),
+					resource.TestCheckResourceAttr("data.vercel_feature_flags.archived", "flags.0.archived", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_FeatureFlagRevisionsDataSource(t *testing.T) {
+	projectSuffix := strings.ToLower(acctest.RandString(10))
+	key := fmt.Sprintf("revisions-%s", projectSuffix)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFeatureFlagRevisionsDataSourceConfig(projectSuffix, key)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_feature_flag_definition.test", "revision"),
+					resource.TestCheckNoResourceAttr("vercel_feature_flag_config.test", "change_message"),
+					resource.TestCheckResourceAttrSet("vercel_feature_flag_config.test", "revisions.production"),
+					resource.TestCheckResourceAttr("data.vercel_feature_flag_revisions.production", "revisions.0.message", "terraform run 42"),
+					resource.TestCheckResourceAttrSet("data.vercel_feature_flag_revisions.production", "revisions.0.created_at"),
+					resource.TestCheckResourceAttrPair("data.vercel_feature_flag_revisions.production", "revisions.0.environment_revisions.production", "vercel_feature_flag_config.test", "revisions.production"),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_FeatureFlagSegmentsDataSource(t *testing.T) {
+	projectSuffix := strings.ToLower(acctest.RandString(10))
+	slug := fmt.Sprintf("beta-users-%s", projectSuffix)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFeatureFlagSegmentsDataSourceConfig(projectSuffix, slug)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_feature_flag_segments.test", "segments.#", "1"),
+					resource.TestCheckResourceAttrPair("data.vercel_feature_flag_segments.test", "segments.0.id", "vercel_feature_flag_segment.test", "id"),
+					resource.TestCheckResourceAttr("data.vercel_feature_flag_segments.test", "segments.0.slug", slug),
+					resource.TestCheckResourceAttr("data.vercel_feature_flag_segments.test", "segments.0.name", "Beta Users"),
+				),
+			},
+		},
+	})
+}
+
 func testAccFeatureFlagDataSourceConfig(projectSuffix, key string) string {
 	return fmt.Sprintf(`
 resource "vercel_project" "test" {
@@ -186,3 +252,136 @@ data "vercel_feature_flag_sdk_key" "test" {
 }
 `, projectSuffix)
 }
+
+func testAccFeatureFlagsDataSourceConfig(projectSuffix, key string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-feature-flags-ds-%[1]s"
+}
+
+resource "vercel_feature_flag_definition" "test" {
+  project_id = vercel_project.test.id
+  key        = "%[2]s"
+  kind       = "boolean"
+  variant = [
+    {
+      id         = "off"
+      value_bool = false
+    },
+    {
+      id         = "on"
+      value_bool = true
+    },
+  ]
+}
+
+resource "vercel_feature_flag_definition" "archived" {
+  project_id = vercel_project.test.id
+  key        = "banner-%[1]s"
+  kind       = "string"
+  archived   = true
+  variant = [
+    {
+      id           = "control"
+      value_string = "control"
+    },
+  ]
+}
+
+data "vercel_feature_flags" "all" {
+  project_id = vercel_project.test.id
+  depends_on = [vercel_feature_flag_definition.test, vercel_feature_flag_definition.archived]
+}
+
+data "vercel_feature_flags" "boolean" {
+  project_id = vercel_project.test.id
+  kind       = "boolean"
+  depends_on = [vercel_feature_flag_definition.test, vercel_feature_flag_definition.archived]
+}
+
+data "vercel_feature_flags" "archived" {
+  project_id = vercel_project.test.id
+  state      = "archived"
+  depends_on = [vercel_feature_flag_definition.test, vercel_feature_flag_definition.archived]
+}
+`, projectSuffix, key)
+}
+
+func testAccFeatureFlagSegmentsDataSourceConfig(projectSuffix, slug string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-feature-flag-segments-ds-%[1]s"
+}
+
+resource "vercel_feature_flag_segment" "test" {
+  project_id = vercel_project.test.id
+  slug       = "%[2]s"
+  name       = "Beta Users"
+  include = [
+    {
+      entity    = "user"
+      attribute = "email"
+      values    = ["beta@example.com"]
+    },
+  ]
+}
+
+data "vercel_feature_flag_segments" "test" {
+  project_id = vercel_project.test.id
+  depends_on = [vercel_feature_flag_segment.test]
+}
+`, projectSuffix, slug)
+}
+
+func testAccFeatureFlagRevisionsDataSourceConfig(projectSuffix, key string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-feature-flag-revisions-ds-%[1]s"
+}
+
+resource "vercel_feature_flag_definition" "test" {
+  project_id     = vercel_project.test.id
+  key            = "%[2]s"
+  kind           = "boolean"
+  change_message = "terraform run 42"
+  variant = [
+    {
+      id         = "off"
+      value_bool = false
+    },
+    {
+      id         = "on"
+      value_bool = true
+    },
+  ]
+}
+
+resource "vercel_feature_flag_config" "test" {
+  project_id     = vercel_project.test.id
+  flag_id        = vercel_feature_flag_definition.test.id
+  change_message = "terraform run 42"
+
+  production = {
+    enabled             = true
+    default_variant_id  = "on"
+    disabled_variant_id = "off"
+  }
+
+  preview = {
+    disabled_variant_id = "off"
+    default_variant_id  = "off"
+  }
+
+  development = {
+    disabled_variant_id = "off"
+    default_variant_id  = "off"
+  }
+}
+
+data "vercel_feature_flag_revisions" "production" {
+  project_id  = vercel_project.test.id
+  flag_id     = vercel_feature_flag_config.test.id
+  environment = "production"
+}
+`, projectSuffix, key)
+}
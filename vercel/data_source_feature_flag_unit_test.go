@@ -0,0 +1,87 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestFeatureFlagDataSourceReadsFullEnvironments(t *testing.T) {
+	ctx := context.Background()
+	rule, err := json.Marshal(client.FeatureFlagRule{
+		ID: "rule_1",
+		Conditions: []client.FeatureFlagSegmentCondition{{
+			LHS: client.FeatureFlagSegmentConditionLHS{Type: "entity", Kind: "user", Attribute: "email"},
+			CMP: "endsWith",
+			RHS: "@vercel.com",
+		}},
+		Outcome: client.FeatureFlagOutcome{Type: "variant", VariantID: "on"},
+	})
+	if err != nil {
+		t.Fatalf("json.Marshal() error = %v", err)
+	}
+	off := client.FeatureFlagOutcome{Type: "variant", VariantID: "off"}
+	out := client.FeatureFlag{
+		ID:   "flag_123",
+		Slug: "new-checkout",
+		Kind: "boolean",
+		Variants: []client.FeatureFlagVariant{
+			{ID: "off", Value: false},
+			{ID: "on", Value: true},
+		},
+		Environments: map[string]client.FeatureFlagEnvironment{
+			"production": {
+				Active: true,
+				Rules:  []json.RawMessage{rule},
+				Fallthrough: client.FeatureFlagOutcome{
+					Type:             "split",
+					Base:             &client.FeatureFlagSegmentConditionLHS{Type: "entity", Kind: "user", Attribute: "id"},
+					Weights:          map[string]float64{"off": 90, "on": 10},
+					DefaultVariantID: "off",
+				},
+				PausedOutcome: off,
+				Targets: map[string]map[string]map[string][]client.FeatureFlagSegmentValue{
+					"on": {"user": {"id": {{Value: "user_1"}}}},
+				},
+			},
+			"preview":     {Active: true, Fallthrough: off, PausedOutcome: off, Reuse: &client.FeatureFlagReuse{Active: true, Environment: "production"}},
+			"development": {Active: false, Fallthrough: off, PausedOutcome: off},
+		},
+	}
+
+	model, diags := featureFlagFromClient(ctx, out, featureFlagModel{ProjectID: types.StringValue("prj_123"), TeamID: types.StringValue("team_123")})
+	if diags.HasError() {
+		t.Fatalf("featureFlagFromClient() diags = %v", diags)
+	}
+
+	production := model.Production
+	if !production.DefaultVariantID.IsNull() || production.DefaultSplit.IsNull() {
+		t.Errorf("production default = %s / %s, want the split instead of its fallback variant", production.DefaultVariantID, production.DefaultSplit)
+	}
+	if got := len(production.Rules.Elements()); got != 1 {
+		t.Errorf("production rules = %d, want 1", got)
+	}
+	if got := len(production.Targets.Elements()); got != 1 {
+		t.Errorf("production targets = %d, want 1", got)
+	}
+	if got := model.Preview.ReuseEnvironment.ValueString(); got != "production" {
+		t.Errorf("preview reuse_environment = %q, want production", got)
+	}
+	if got := model.Development.DefaultVariantID.ValueString(); got != "off" {
+		t.Errorf("development default_variant_id = %q, want off", got)
+	}
+
+	// The model must fit the schema, or Read would fail to set state.
+	resp := &datasource.SchemaResponse{}
+	newFeatureFlagDataSource().Schema(ctx, datasource.SchemaRequest{}, resp)
+	state := tfsdk.State{Schema: resp.Schema}
+	if diags := state.Set(ctx, featureFlagDataSourceModelFromResource(model)); diags.HasError() {
+		t.Fatalf("unexpected error setting state: %v", diags)
+	}
+}
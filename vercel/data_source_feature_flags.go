@@ -0,0 +1,207 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &featureFlagsDataSource{}
+	_ datasource.DataSourceWithConfigure = &featureFlagsDataSource{}
+)
+
+func newFeatureFlagsDataSource() datasource.DataSource {
+	return &featureFlagsDataSource{}
+}
+
+type featureFlagsDataSource struct {
+	client *client.Client
+}
+
+type featureFlagsDataSourceModel struct {
+	ProjectID types.String                 `tfsdk:"project_id"`
+	TeamID    types.String                 `tfsdk:"team_id"`
+	State     types.String                 `tfsdk:"state"`
+	Kind      types.String                 `tfsdk:"kind"`
+	Flags     []featureFlagsDataSourceFlag `tfsdk:"flags"`
+}
+
+type featureFlagsDataSourceFlag struct {
+	ID          types.String `tfsdk:"id"`
+	Key         types.String `tfsdk:"key"`
+	Description types.String `tfsdk:"description"`
+	Kind        types.String `tfsdk:"kind"`
+	Archived    types.Bool   `tfsdk:"archived"`
+	Seed        types.Int64  `tfsdk:"seed"`
+	VariantIDs  []string     `tfsdk:"variant_ids"`
+}
+
+func featureFlagsDataSourceFlagFromClient(flag client.FeatureFlag) featureFlagsDataSourceFlag {
+	variantIDs := make([]string, 0, len(flag.Variants))
+	for _, variant := range flag.Variants {
+		variantIDs = append(variantIDs, variant.ID)
+	}
+	return featureFlagsDataSourceFlag{
+		ID:          types.StringValue(flag.ID),
+		Key:         types.StringValue(flag.Slug),
+		Description: featureFlagOptionalStringValue(flag.Description, types.StringNull()),
+		Kind:        types.StringValue(flag.Kind),
+		Archived:    types.BoolValue(flag.State == "archived"),
+		Seed:        types.Int64Value(int64(flag.Seed)),
+		VariantIDs:  variantIDs,
+	}
+}
+
+func (d *featureFlagsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_feature_flags"
+}
+
+func (d *featureFlagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *featureFlagsDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the Feature Flags of a project.
+
+Use this data source to discover flags that are not managed by Terraform, for example to import them, or to pass their keys to other configuration. Use the ` + "`vercel_feature_flag`" + ` data source to read the variants and environments of a single flag.
+`,
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the Vercel team. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Vercel project that owns the flags.",
+			},
+			"state": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list flags in this state. Must be `active` or `archived`. All flags are listed when unset.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("active", "archived"),
+				},
+			},
+			"kind": schema.StringAttribute{
+				Optional:    true,
+				Description: "Only list flags that return this type of value, such as `boolean`, `string`, or `number`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"flags": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The flags matching the filters, sorted by key.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The ID of the feature flag.",
+						},
+						"key": schema.StringAttribute{
+							Computed:    true,
+							Description: "The stable flag key used in your application code.",
+						},
+						"description": schema.StringAttribute{
+							Computed:    true,
+							Description: "A human-readable description of the flag.",
+						},
+						"kind": schema.StringAttribute{
+							Computed:    true,
+							Description: "The type of value this flag returns.",
+						},
+						"archived": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the flag is archived.",
+						},
+						"seed": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The seed used to bucket entities into weighted splits.",
+						},
+						"variant_ids": schema.ListAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The IDs of the flag's variants, in order.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *featureFlagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config featureFlagsDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.TeamID = types.StringValue(d.client.TeamID(config.TeamID.ValueString()))
+
+	flags, err := d.client.ListFeatureFlags(ctx, client.ListFeatureFlagsRequest{
+		ProjectID: config.ProjectID.ValueString(),
+		TeamID:    config.TeamID.ValueString(),
+		State:     config.State.ValueString(),
+		Kind:      config.Kind.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Feature Flags",
+			fmt.Sprintf(
+				"Could not list Feature Flags %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	sort.Slice(flags, func(i, j int) bool {
+		return flags[i].Slug < flags[j].Slug
+	})
+	config.Flags = make([]featureFlagsDataSourceFlag, 0, len(flags))
+	for _, flag := range flags {
+		// Filter locally as well, in case the API does not apply the kind filter.
+		if !config.Kind.IsNull() && flag.Kind != config.Kind.ValueString() {
+			continue
+		}
+		config.Flags = append(config.Flags, featureFlagsDataSourceFlagFromClient(flag))
+	}
+
+	tflog.Info(ctx, "read feature flags data source", map[string]any{
+		"project_id": config.ProjectID.ValueString(),
+		"team_id":    config.TeamID.ValueString(),
+		"count":      len(config.Flags),
+	})
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
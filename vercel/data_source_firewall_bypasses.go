@@ -0,0 +1,148 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &firewallBypassesDataSource{}
+	_ datasource.DataSourceWithConfigure = &firewallBypassesDataSource{}
+)
+
+func newFirewallBypassesDataSource() datasource.DataSource {
+	return &firewallBypassesDataSource{}
+}
+
+type firewallBypassesDataSource struct {
+	client *client.Client
+}
+
+type firewallBypassesDataSourceModel struct {
+	ProjectID types.String                     `tfsdk:"project_id"`
+	TeamID    types.String                     `tfsdk:"team_id"`
+	Bypasses  []firewallBypassesDataSourceItem `tfsdk:"bypasses"`
+}
+
+type firewallBypassesDataSourceItem struct {
+	ID       types.String `tfsdk:"id"`
+	Domain   types.String `tfsdk:"domain"`
+	SourceIp types.String `tfsdk:"source_ip"`
+	Note     types.String `tfsdk:"note"`
+}
+
+func (d *firewallBypassesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_bypasses"
+}
+
+func (d *firewallBypassesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func (d *firewallBypassesDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the Firewall Bypass Rules of a project.
+
+This lists every bypass rule on the project, including rules created outside Terraform, in the same shape as the ` + "`vercel_firewall_bypass`" + ` resource. It is useful for auditing, for example in a ` + "`check`" + ` block.
+`,
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the Project to list the bypass rules of.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the Project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"bypasses": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The bypass rules of the project.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The identifier for the firewall bypass rule.",
+						},
+						"domain": schema.StringAttribute{
+							Computed:    true,
+							Description: "The domain the bypass rule applies to, or `*` for a rule that applies to every domain of the project.",
+						},
+						"source_ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "The source IP address or CIDR range the bypass rule applies to.",
+						},
+						"note": schema.StringAttribute{
+							Computed:    true,
+							Description: "The note describing the bypass rule.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *firewallBypassesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config firewallBypassesDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	config.TeamID = types.StringValue(d.client.TeamID(config.TeamID.ValueString()))
+	out, err := d.client.ListFirewallBypasses(ctx, config.TeamID.ValueString(), config.ProjectID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Firewall Bypasses",
+			fmt.Sprintf("Could not list Firewall Bypasses %s %s, unexpected error: %s",
+				config.TeamID.ValueString(),
+				config.ProjectID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	config.Bypasses = make([]firewallBypassesDataSourceItem, len(out))
+	for i, bypass := range out {
+		rule := responseToBypassRule(bypass)
+		config.Bypasses[i] = firewallBypassesDataSourceItem{
+			ID:       rule.ID,
+			Domain:   rule.Domain,
+			SourceIp: rule.SourceIp,
+			Note:     rule.Note,
+		}
+	}
+
+	tflog.Info(ctx, "read firewall bypasses", map[string]any{
+		"team_id":    config.TeamID.ValueString(),
+		"project_id": config.ProjectID.ValueString(),
+		"count":      len(config.Bypasses),
+	})
+
+	diags = resp.State.Set(ctx, config)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,59 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_FirewallBypassesDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallBypassesDataSourceConfig(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_firewall_bypasses.test", "bypasses.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_firewall_bypasses.test", "bypasses.*", map[string]string{
+						"domain":    "*",
+						"source_ip": "2.3.4.0/24",
+						"note":      "Test bypass rule for all domains",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.vercel_firewall_bypasses.test", "bypasses.*", map[string]string{
+						"domain":    "*",
+						"source_ip": "5.6.7.8",
+					}),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallBypassesDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+    name = "test-acc-%[1]s"
+}
+
+resource "vercel_firewall_bypass" "all" {
+    project_id = vercel_project.test.id
+    source_ip  = "2.3.4.0/24"
+    domain     = "*"
+    note       = "Test bypass rule for all domains"
+}
+
+resource "vercel_firewall_bypass" "single" {
+    project_id = vercel_project.test.id
+    source_ip  = "5.6.7.8"
+    domain     = "*"
+}
+
+data "vercel_firewall_bypasses" "test" {
+    project_id = vercel_project.test.id
+    depends_on = [vercel_firewall_bypass.all, vercel_firewall_bypass.single]
+}
+`, name)
+}
@@ -0,0 +1,490 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ datasource.DataSource              = &firewallConfigDataSource{}
+	_ datasource.DataSourceWithConfigure = &firewallConfigDataSource{}
+)
+
+func newFirewallConfigDataSource() datasource.DataSource {
+	return &firewallConfigDataSource{}
+}
+
+type firewallConfigDataSource struct {
+	client *client.Client
+}
+
+type firewallConfigDataSourceModel struct {
+	ID                         types.String                             `tfsdk:"id"`
+	ProjectID                  types.String                             `tfsdk:"project_id"`
+	TeamID                     types.String                             `tfsdk:"team_id"`
+	Enabled                    types.Bool                               `tfsdk:"enabled"`
+	AttackChallengeModeEnabled types.Bool                               `tfsdk:"attack_challenge_mode_enabled"`
+	AttackModeActiveUntil      types.Int64                              `tfsdk:"attack_mode_active_until"`
+	ManagedRulesets            *firewallConfigDataSourceManagedRulesets `tfsdk:"managed_rulesets"`
+	Rules                      []firewallConfigDataSourceRule           `tfsdk:"rules"`
+	IPRules                    []IPRule                                 `tfsdk:"ip_rules"`
+}
+
+// firewallConfigDataSourceManagedRulesets has the same shape as the
+// managed_rulesets block of vercel_firewall_config, without the deprecated
+// bot_filter block, so its values can be passed from one to the other.
+type firewallConfigDataSourceManagedRulesets struct {
+	OWASP         *CRSRule             `tfsdk:"owasp"`
+	BotProtection *BotProtectionConfig `tfsdk:"bot_protection"`
+	AiBots        *AiBotsConfig        `tfsdk:"ai_bots"`
+}
+
+type firewallConfigDataSourceRule struct {
+	ID             types.String     `tfsdk:"id"`
+	Name           types.String     `tfsdk:"name"`
+	Description    types.String     `tfsdk:"description"`
+	Active         types.Bool       `tfsdk:"active"`
+	ConditionGroup []ConditionGroup `tfsdk:"condition_group"`
+	Action         Mitigate         `tfsdk:"action"`
+}
+
+func (d *firewallConfigDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_config"
+}
+
+func (d *firewallConfigDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.client = client
+}
+
+func firewallConfigDataSourceRuleAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"active": schema.BoolAttribute{
+			Computed:    true,
+			Description: "Whether the rule is active.",
+		},
+		"action": schema.StringAttribute{
+			Computed:    true,
+			Description: "The action taken when the rule matches.",
+		},
+	}
+}
+
+func firewallConfigDataSourceRuleAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: description,
+		Attributes:  firewallConfigDataSourceRuleAttributes(),
+	}
+}
+
+func firewallConfigDataSourceManagedRulesetsAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Computed:    true,
+		Description: "The managed rulesets, in the same shape as the `managed_rulesets` block of the `vercel_firewall_config` resource. A ruleset that has never been configured is null.",
+		Attributes: map[string]schema.Attribute{
+			"owasp": schema.SingleNestedAttribute{
+				Computed:    true,
+				Description: "The OWASP core ruleset.",
+				Attributes: map[string]schema.Attribute{
+					"xss":  firewallConfigDataSourceRuleAttribute("Cross Site Scripting Rules"),
+					"sqli": firewallConfigDataSourceRuleAttribute("SQL Injection Rules"),
+					"sf":   firewallConfigDataSourceRuleAttribute("Session Fixation Attack"),
+					"lfi":  firewallConfigDataSourceRuleAttribute("Local File Inclusion Rules"),
+					"rfi":  firewallConfigDataSourceRuleAttribute("Remote File Inclusion Rules"),
+					"rce":  firewallConfigDataSourceRuleAttribute("Remote Code Execution Rules"),
+					"sd":   firewallConfigDataSourceRuleAttribute("Scanner Detection Rules"),
+					"ma":   firewallConfigDataSourceRuleAttribute("Multipart Rules"),
+					"php":  firewallConfigDataSourceRuleAttribute("PHP Attack Detection"),
+					"gen":  firewallConfigDataSourceRuleAttribute("Generic Attack Detection"),
+					"java": firewallConfigDataSourceRuleAttribute("Java Attack Detection"),
+					"paranoia_level": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The paranoia level of the core rule set, from 1 to 4.",
+					},
+					"inbound_anomaly_threshold": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The anomaly score at which a request is blocked.",
+					},
+					"outbound_anomaly_threshold": schema.Int64Attribute{
+						Computed:    true,
+						Description: "The anomaly score at which a response is blocked.",
+					},
+					"rule_overrides": schema.MapNestedAttribute{
+						Computed:    true,
+						Description: "Overrides for individual core rule set rules, keyed by rule ID.",
+						NestedObject: schema.NestedAttributeObject{
+							Attributes: firewallConfigDataSourceRuleAttributes(),
+						},
+					},
+				},
+			},
+			"bot_protection": firewallConfigDataSourceRuleAttribute("The bot_protection managed ruleset."),
+			"ai_bots":        firewallConfigDataSourceRuleAttribute("The ai_bots managed ruleset."),
+		},
+	}
+}
+
+func (d *firewallConfigDataSource) Schema(_ context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the live Firewall Config of a project.
+
+This returns the firewall config that is currently active, including rules and IP rules created outside Terraform, with rules and managed rulesets in the same shape as the ` + "`vercel_firewall_config`" + ` resource. It is useful for auditing, for example in a ` + "`check`" + ` block.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The identifier of the firewall config, made up of the team ID and the project ID.",
+			},
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project to read the firewall config of.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+			},
+			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the firewall is enabled.",
+			},
+			"attack_challenge_mode_enabled": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether Attack Challenge Mode is enabled.",
+			},
+			"attack_mode_active_until": schema.Int64Attribute{
+				Computed:    true,
+				Description: "Unix timestamp in milliseconds until which Attack Challenge Mode stays active.",
+			},
+			"managed_rulesets": firewallConfigDataSourceManagedRulesetsAttribute(),
+			"rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The custom rules, in the order they are evaluated.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed:    true,
+							Description: "Name to identify the rule",
+						},
+						"description": schema.StringAttribute{
+							Computed: true,
+						},
+						"active": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Rule is active or disabled",
+						},
+						"action": schema.SingleNestedAttribute{
+							Computed:    true,
+							Description: "Actions to take when the condition groups match a request",
+							Attributes: map[string]schema.Attribute{
+								"action": schema.StringAttribute{
+									Computed:    true,
+									Description: "Base action",
+								},
+								"rate_limit": schema.SingleNestedAttribute{
+									Computed:    true,
+									Description: "Behavior of a rate limiting action",
+									Attributes: map[string]schema.Attribute{
+										"algo": schema.StringAttribute{
+											Computed:    true,
+											Description: "Rate limiting algorithm",
+										},
+										"window": schema.Int64Attribute{
+											Computed:    true,
+											Description: "Time window in seconds",
+										},
+										"limit": schema.Int64Attribute{
+											Computed:    true,
+											Description: "number of requests allowed in the window",
+										},
+										"keys": schema.ListAttribute{
+											Computed:    true,
+											ElementType: types.StringType,
+											Description: "Keys used to bucket an individual client",
+										},
+										"action": schema.StringAttribute{
+											Computed:    true,
+											Description: "Action to take when rate limit is exceeded",
+										},
+									},
+								},
+								"redirect": schema.SingleNestedAttribute{
+									Computed:    true,
+									Description: "How to redirect a request",
+									Attributes: map[string]schema.Attribute{
+										"location": schema.StringAttribute{
+											Computed: true,
+										},
+										"permanent": schema.BoolAttribute{
+											Computed: true,
+										},
+									},
+								},
+								"action_duration": schema.StringAttribute{
+									Computed:    true,
+									Description: "Forward persistence of a rule action",
+								},
+							},
+						},
+						"condition_group": schema.ListNestedAttribute{
+							Computed:    true,
+							Description: "Sets of conditions that may match a request",
+							NestedObject: schema.NestedAttributeObject{
+								Attributes: map[string]schema.Attribute{
+									"conditions": schema.ListNestedAttribute{
+										Computed:    true,
+										Description: "Conditions that must all match within a group",
+										NestedObject: schema.NestedAttributeObject{
+											Attributes: map[string]schema.Attribute{
+												"type": schema.StringAttribute{
+													Computed:    true,
+													Description: "Request key type to match against",
+												},
+												"op": schema.StringAttribute{
+													Computed:    true,
+													Description: "Operator to use for comparison",
+												},
+												"neg": schema.BoolAttribute{
+													Computed:    true,
+													Description: "Whether the condition is negated",
+												},
+												"key": schema.StringAttribute{
+													Computed:    true,
+													Description: "Key within type to match against",
+												},
+												"value": schema.StringAttribute{
+													Computed:    true,
+													Description: "Value to match against",
+												},
+												"values": schema.ListAttribute{
+													Computed:    true,
+													ElementType: types.StringType,
+													Description: "Values to match against if op is inc, ninc",
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"ip_rules": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The IP rules.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"hostname": schema.StringAttribute{
+							Computed:    true,
+							Description: "Hosts the rule applies to",
+						},
+						"ip": schema.StringAttribute{
+							Computed:    true,
+							Description: "IP or CIDR the rule applies to",
+						},
+						"notes": schema.StringAttribute{
+							Computed: true,
+						},
+						"action": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// firewallConfigDataSourceRuleFromClient converts a rule the same way the
+// resource does, without a configured rule to take optional values from.
+func firewallConfigDataSourceRuleFromClient(rule client.FirewallRule) (firewallConfigDataSourceRule, error) {
+	ref := FirewallRule{
+		Description: types.StringValue(rule.Description),
+		Active:      types.BoolValue(rule.Active),
+	}
+	if rule.Description == "" {
+		ref.Description = types.StringNull()
+	}
+	for _, group := range rule.ConditionGroup {
+		var conditions []Condition
+		for _, condition := range group.Conditions {
+			cond := Condition{Key: types.StringNull(), Value: types.StringValue("")}
+			if condition.Key != "" {
+				cond.Key = types.StringValue(condition.Key)
+			}
+			conditions = append(conditions, cond)
+		}
+		ref.ConditionGroup = append(ref.ConditionGroup, ConditionGroup{Conditions: conditions})
+	}
+
+	r, err := fromFirewallRule(rule, ref)
+	if err != nil {
+		return firewallConfigDataSourceRule{}, err
+	}
+	return firewallConfigDataSourceRule{
+		ID:             r.ID,
+		Name:           r.Name,
+		Description:    r.Description,
+		Active:         types.BoolValue(rule.Active),
+		ConditionGroup: r.ConditionGroup,
+		Action:         r.Action,
+	}, nil
+}
+
+// firewallConfigDataSourceManagedRulesetsFromClient reads every managed
+// ruleset the API reports. Unlike the resource, there is no configuration to
+// leave defaults null for, so each value is set as reported.
+func firewallConfigDataSourceManagedRulesetsFromClient(conf client.FirewallConfig) *firewallConfigDataSourceManagedRulesets {
+	rulesets := &firewallConfigDataSourceManagedRulesets{}
+	owasp, owaspExists := conf.ManagedRulesets["owasp"]
+	if owaspExists || len(conf.CRS) > 0 {
+		group := func(name string) *CRSRuleConfig {
+			rule, ok := conf.CRS[name]
+			if !ok {
+				return nil
+			}
+			return &CRSRuleConfig{
+				Active: types.BoolValue(rule.Active),
+				Action: types.StringValue(rule.Action),
+			}
+		}
+		rulesets.OWASP = &CRSRule{
+			XSS:  group("xss"),
+			SQLI: group("sqli"),
+			SF:   group("sf"),
+			LFI:  group("lfi"),
+			RFI:  group("rfi"),
+			RCE:  group("rce"),
+			SD:   group("sd"),
+			MA:   group("ma"),
+			PHP:  group("php"),
+			GEN:  group("gen"),
+			JAVA: group("java"),
+		}
+		// A reference with every setting configured keeps the defaults.
+		rulesets.OWASP.setOWASPSettings(owasp, &CRSRule{
+			ParanoiaLevel:            types.Int64Value(firewallCRSDefaultParanoiaLevel),
+			InboundAnomalyThreshold:  types.Int64Value(firewallCRSDefaultInboundAnomaly),
+			OutboundAnomalyThreshold: types.Int64Value(firewallCRSDefaultOutboundAnomaly),
+		})
+	}
+	if rule, ok := conf.ManagedRulesets["bot_protection"]; ok {
+		rulesets.BotProtection = &BotProtectionConfig{
+			Active: types.BoolValue(rule.Active),
+			Action: types.StringValue(rule.Action),
+		}
+	}
+	if rule, ok := conf.ManagedRulesets["ai_bots"]; ok {
+		rulesets.AiBots = &AiBotsConfig{
+			Active: types.BoolValue(rule.Active),
+			Action: types.StringValue(rule.Action),
+		}
+	}
+	return rulesets
+}
+
+func firewallConfigDataSourceFromClient(conf client.FirewallConfig, acm client.AttackChallengeMode) (firewallConfigDataSourceModel, error) {
+	model := firewallConfigDataSourceModel{
+		ID:                         types.StringValue(conf.TeamID + "/" + conf.ProjectID),
+		ProjectID:                  types.StringValue(conf.ProjectID),
+		TeamID:                     toTeamID(conf.TeamID),
+		Enabled:                    types.BoolValue(conf.Enabled),
+		AttackChallengeModeEnabled: types.BoolValue(acm.Enabled),
+		AttackModeActiveUntil:      types.Int64PointerValue(acm.AttackModeActiveUntil),
+		ManagedRulesets:            firewallConfigDataSourceManagedRulesetsFromClient(conf),
+		Rules:                      []firewallConfigDataSourceRule{},
+		IPRules:                    []IPRule{},
+	}
+	for _, rule := range conf.Rules {
+		r, err := firewallConfigDataSourceRuleFromClient(rule)
+		if err != nil {
+			return model, err
+		}
+		model.Rules = append(model.Rules, r)
+	}
+	for _, rule := range conf.IPRules {
+		notes := types.StringValue(rule.Notes)
+		if rule.Notes == "" {
+			notes = types.StringNull()
+		}
+		model.IPRules = append(model.IPRules, IPRule{
+			ID:       types.StringValue(rule.ID),
+			Hostname: types.StringValue(rule.Hostname),
+			IP:       types.StringValue(rule.IP),
+			Notes:    notes,
+			Action:   types.StringValue(rule.Action),
+		})
+	}
+	return model, nil
+}
+
+func (d *firewallConfigDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config firewallConfigDataSourceModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, teamID := config.ProjectID.ValueString(), d.client.TeamID(config.TeamID.ValueString())
+
+	conf, err := d.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Firewall Config",
+			fmt.Sprintf("Could not read Firewall Config %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+	conf.ProjectID = projectID
+
+	acm, err := d.client.GetAttackChallengeMode(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Firewall Config",
+			fmt.Sprintf("Could not read Attack Challenge Mode %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+
+	result, err := firewallConfigDataSourceFromClient(conf, acm)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Firewall Config", "Could not convert Firewall Config, unexpected error: "+err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "read firewall config", map[string]any{
+		"team_id":    teamID,
+		"project_id": projectID,
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
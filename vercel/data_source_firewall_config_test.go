@@ -0,0 +1,90 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_FirewallConfigDataSource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallConfigDataSourceConfig(name)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "enabled", "true"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "attack_challenge_mode_enabled", "false"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "managed_rulesets.owasp.xss.active", "true"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "managed_rulesets.owasp.xss.action", "deny"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "rules.#", "1"),
+					resource.TestCheckResourceAttrSet("data.vercel_firewall_config.test", "rules.0.id"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "rules.0.name", "test"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "rules.0.active", "true"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "rules.0.action.action", "deny"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "rules.0.condition_group.0.conditions.0.type", "path"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "rules.0.condition_group.0.conditions.0.value", "/test"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "rules.0.condition_group.0.conditions.1.key", "user-agent"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "ip_rules.#", "1"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "ip_rules.0.ip", "5.6.7.8"),
+					resource.TestCheckResourceAttr("data.vercel_firewall_config.test", "ip_rules.0.action", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallConfigDataSourceConfig(name string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+    name = "test-acc-%[1]s"
+}
+
+resource "vercel_firewall_config" "test" {
+    project_id = vercel_project.test.id
+
+    managed_rulesets {
+        owasp {
+            xss = { action = "deny" }
+        }
+    }
+
+    rules {
+        rule {
+            name = "test"
+            action = {
+                action = "deny"
+            }
+            condition_group = [{
+                conditions = [{
+                    type  = "path"
+                    op    = "eq"
+                    value = "/test"
+                },
+                {
+                    type  = "header"
+                    key   = "user-agent"
+                    op    = "sub"
+                    value = "curl"
+                }]
+            }]
+        }
+    }
+
+    ip_rules {
+        rule {
+            action   = "deny"
+            ip       = "5.6.7.8"
+            hostname = "*"
+        }
+    }
+}
+
+data "vercel_firewall_config" "test" {
+    project_id = vercel_firewall_config.test.project_id
+}
+`, name)
+}
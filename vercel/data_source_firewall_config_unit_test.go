@@ -0,0 +1,106 @@
+package vercel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestFirewallConfigDataSourceFromClient(t *testing.T) {
+	ctx := context.Background()
+	activeUntil := int64(1700000000000)
+	paranoiaLevel, inboundAnomaly := int64(2), int64(firewallCRSDefaultInboundAnomaly)
+	conf := client.FirewallConfig{
+		ProjectID: "prj_123",
+		TeamID:    "team_123",
+		Enabled:   true,
+		ManagedRulesets: map[string]client.ManagedRule{
+			"bot_protection": {Active: true, Action: "challenge"},
+			"owasp": {
+				Active:            true,
+				ParanoiaLevel:     &paranoiaLevel,
+				AnomalyThresholds: &client.AnomalyThresholds{Inbound: &inboundAnomaly},
+			},
+		},
+		CRS: map[string]client.CoreRuleSet{
+			"xss": {Active: true, Action: "deny"},
+		},
+		Rules: []client.FirewallRule{{
+			ID:     "rule_1",
+			Name:   "block curl",
+			Active: true,
+			ConditionGroup: []client.ConditionGroup{{
+				Conditions: []client.Condition{
+					{Type: "header", Op: "sub", Key: "user-agent", Value: "curl"},
+					{Type: "path", Op: "inc", Value: []any{"/a", "/b"}},
+				},
+			}},
+			Action: client.Action{Mitigate: client.Mitigate{Action: "deny"}},
+		}},
+		IPRules: []client.IPRule{{ID: "ip_1", Hostname: "*", IP: "192.0.2.1", Action: "deny"}},
+	}
+
+	model, err := firewallConfigDataSourceFromClient(conf, client.AttackChallengeMode{Enabled: true, AttackModeActiveUntil: &activeUntil})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if got := model.ID.ValueString(); got != "team_123/prj_123" {
+		t.Errorf("expected id team_123/prj_123, got %s", got)
+	}
+	if got := model.AttackModeActiveUntil.ValueInt64(); got != activeUntil {
+		t.Errorf("expected attack_mode_active_until %d, got %d", activeUntil, got)
+	}
+	owasp := model.ManagedRulesets.OWASP
+	if got := owasp.XSS.Action.ValueString(); got != "deny" {
+		t.Errorf("expected owasp xss action deny, got %s", got)
+	}
+	if owasp.SQLI != nil {
+		t.Errorf("expected unreported owasp sqli to be null, got %+v", owasp.SQLI)
+	}
+	if got := owasp.ParanoiaLevel.ValueInt64(); got != 2 {
+		t.Errorf("expected owasp paranoia_level 2, got %d", got)
+	}
+	if got := owasp.InboundAnomalyThreshold.ValueInt64(); got != firewallCRSDefaultInboundAnomaly {
+		t.Errorf("expected the default owasp inbound_anomaly_threshold to be read, got %s", owasp.InboundAnomalyThreshold)
+	}
+	if got := model.ManagedRulesets.BotProtection.Action.ValueString(); got != "challenge" {
+		t.Errorf("expected bot_protection action challenge, got %s", got)
+	}
+	if model.ManagedRulesets.AiBots != nil {
+		t.Errorf("expected unreported ai_bots to be null, got %+v", model.ManagedRulesets.AiBots)
+	}
+
+	rule := model.Rules[0]
+	if !rule.Description.IsNull() {
+		t.Errorf("expected empty description to be null, got %s", rule.Description)
+	}
+	if !rule.Active.ValueBool() {
+		t.Error("expected rule to be active")
+	}
+	if !rule.Action.ActionDuration.IsNull() {
+		t.Errorf("expected empty action_duration to be null, got %s", rule.Action.ActionDuration)
+	}
+	header, path := rule.ConditionGroup[0].Conditions[0], rule.ConditionGroup[0].Conditions[1]
+	if header.Key != types.StringValue("user-agent") || header.Value != types.StringValue("curl") {
+		t.Errorf("expected header condition to keep key and value, got %s %s", header.Key, header.Value)
+	}
+	if !path.Key.IsNull() || len(path.Values.Elements()) != 2 {
+		t.Errorf("expected path condition with a null key and two values, got %s %s", path.Key, path.Values)
+	}
+	if !model.IPRules[0].Notes.IsNull() {
+		t.Errorf("expected empty notes to be null, got %s", model.IPRules[0].Notes)
+	}
+
+	// The model must fit the schema, or Read would fail to set state.
+	resp := &datasource.SchemaResponse{}
+	newFirewallConfigDataSource().Schema(ctx, datasource.SchemaRequest{}, resp)
+	state := tfsdk.State{Schema: resp.Schema}
+	if diags := state.Set(ctx, model); diags.HasError() {
+		t.Fatalf("unexpected error setting state: %v", diags)
+	}
+}
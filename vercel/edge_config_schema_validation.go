@@ -0,0 +1,183 @@
+package vercel
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/santhosh-tekuri/jsonschema/v6"
+	"github.com/santhosh-tekuri/jsonschema/v6/kind"
+	"golang.org/x/text/language"
+	"golang.org/x/text/message"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var edgeConfigSchemaPrinter = message.NewPrinter(language.English)
+
+// edgeConfigSchemaViolation is a part of an item value that does not match the
+// schema of its Edge Config.
+type edgeConfigSchemaViolation struct {
+	// Key is the item the violation is in, or empty if it is about the Edge
+	// Config as a whole, such as a missing required item.
+	Key string
+	// Pointer is the JSON pointer to the failing value within the item.
+	Pointer string
+	Message string
+}
+
+func (v edgeConfigSchemaViolation) String() string {
+	if v.Key == "" {
+		return fmt.Sprintf("The Edge Config does not match its schema: %s.", v.Message)
+	}
+	if v.Pointer == "" {
+		return fmt.Sprintf("The value of item %q does not match the Edge Config schema: %s.", v.Key, v.Message)
+	}
+	return fmt.Sprintf("The value of item %q does not match the Edge Config schema at %q: %s.", v.Key, v.Pointer, v.Message)
+}
+
+func compileEdgeConfigSchema(definition []byte) (*jsonschema.Schema, error) {
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(definition))
+	if err != nil {
+		return nil, err
+	}
+	const url = "edge-config-schema.json"
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(url, doc); err != nil {
+		return nil, err
+	}
+	return compiler.Compile(url)
+}
+
+// edgeConfigSchemaViolations validates items against the schema of an Edge
+// Config. When partial is set, items holds only some of the items, so
+// violations about the Edge Config as a whole, such as missing required items,
+// are not reported.
+func edgeConfigSchemaViolations(schema *jsonschema.Schema, items map[string]json.RawMessage, partial bool) ([]edgeConfigSchemaViolation, error) {
+	content, err := json.Marshal(items)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := jsonschema.UnmarshalJSON(bytes.NewReader(content))
+	if err != nil {
+		return nil, err
+	}
+
+	err = schema.Validate(doc)
+	if err == nil {
+		return nil, nil
+	}
+	validationErr, ok := err.(*jsonschema.ValidationError)
+	if !ok {
+		return nil, err
+	}
+
+	var violations []edgeConfigSchemaViolation
+	var walk func(e *jsonschema.ValidationError)
+	walk = func(e *jsonschema.ValidationError) {
+		if len(e.Causes) > 0 {
+			for _, cause := range e.Causes {
+				walk(cause)
+			}
+			return
+		}
+		if len(e.InstanceLocation) > 0 {
+			violations = append(violations, edgeConfigSchemaViolation{
+				Key:     e.InstanceLocation[0],
+				Pointer: jsonPointer(e.InstanceLocation[1:]),
+				Message: e.ErrorKind.LocalizedString(edgeConfigSchemaPrinter),
+			})
+			return
+		}
+		// Items that are not allowed at all are reported against the Edge
+		// Config, but belong to the item itself.
+		if additional, ok := e.ErrorKind.(*kind.AdditionalProperties); ok {
+			for _, key := range additional.Properties {
+				violations = append(violations, edgeConfigSchemaViolation{
+					Key:     key,
+					Message: "the Edge Config schema does not allow this item",
+				})
+			}
+			return
+		}
+		if !partial {
+			violations = append(violations, edgeConfigSchemaViolation{
+				Message: e.ErrorKind.LocalizedString(edgeConfigSchemaPrinter),
+			})
+		}
+	}
+	walk(validationErr)
+
+	slices.SortFunc(violations, func(a, b edgeConfigSchemaViolation) int {
+		return cmp.Or(cmp.Compare(a.Key, b.Key), cmp.Compare(a.Pointer, b.Pointer), cmp.Compare(a.Message, b.Message))
+	})
+	return slices.Compact(violations), nil
+}
+
+func jsonPointer(tokens []string) string {
+	var sb strings.Builder
+	for _, token := range tokens {
+		sb.WriteByte('/')
+		sb.WriteString(strings.NewReplacer("~", "~0", "/", "~1").Replace(token))
+	}
+	return sb.String()
+}
+
+// loadEdgeConfigSchema returns the schema to validate the items of an Edge
+// Config against during plan. A definition set in configuration is used if
+// there is one, otherwise the schema stored on the Edge Config is fetched once
+// per run. It returns nil when there is nothing to validate against yet.
+func loadEdgeConfigSchema(ctx context.Context, c *client.Client, edgeConfigID, teamID types.String, definition types.String) (*jsonschema.Schema, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if definition.IsUnknown() {
+		return nil, diags
+	}
+
+	var raw []byte
+	if !definition.IsNull() {
+		raw = []byte(definition.ValueString())
+	} else {
+		if c == nil || edgeConfigID.IsUnknown() || edgeConfigID.IsNull() {
+			return nil, diags
+		}
+		// An unknown team falls back to the default team of the provider. The
+		// schema is cached, as every item in the Edge Config is planned
+		// against it.
+		out, err := c.GetEdgeConfigSchemaCached(ctx, edgeConfigID.ValueString(), teamID.ValueString())
+		if client.NotFound(err) {
+			return nil, diags
+		}
+		if err != nil {
+			diags.AddWarning(
+				"Could not validate Edge Config items",
+				fmt.Sprintf("Could not read the schema of Edge Config %s, so items were not validated against it during plan: %s", edgeConfigID.ValueString(), err),
+			)
+			return nil, diags
+		}
+		if out.Definition == nil {
+			return nil, diags
+		}
+		if raw, err = json.Marshal(out.Definition); err != nil {
+			diags.AddError("Error reading Edge Config Schema", err.Error())
+			return nil, diags
+		}
+	}
+
+	schema, err := compileEdgeConfigSchema(raw)
+	if err != nil {
+		diags.AddAttributeWarning(
+			path.Root("schema_definition"),
+			"Could not validate Edge Config items",
+			fmt.Sprintf("The Edge Config schema could not be compiled, so items were not validated against it during plan: %s", err),
+		)
+		return nil, diags
+	}
+	return schema, diags
+}
@@ -0,0 +1,106 @@
+package vercel
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+const testEdgeConfigSchema = `{
+	"type": "object",
+	"properties": {
+		"flags": {
+			"type": "object",
+			"properties": {
+				"rollout": {"type": "number", "maximum": 100},
+				"a/b": {"type": "array", "items": {"type": "string"}}
+			}
+		},
+		"limit": {"type": "integer", "minimum": 1}
+	},
+	"required": ["flags"],
+	"additionalProperties": false
+}`
+
+func TestEdgeConfigSchemaViolations(t *testing.T) {
+	schema, err := compileEdgeConfigSchema([]byte(testEdgeConfigSchema))
+	if err != nil {
+		t.Fatalf("compileEdgeConfigSchema() error = %s", err)
+	}
+
+	tests := []struct {
+		name     string
+		items    map[string]json.RawMessage
+		partial  bool
+		keys     []string
+		pointers []string
+	}{
+		{
+			name: "valid",
+			items: map[string]json.RawMessage{
+				"flags": json.RawMessage(`{"rollout": 50, "a/b": ["x"]}`),
+				"limit": json.RawMessage(`12345678901234567890`),
+			},
+		},
+		{
+			name: "nested values",
+			items: map[string]json.RawMessage{
+				"flags": json.RawMessage(`{"rollout": 150, "a/b": ["x", 1]}`),
+			},
+			keys:     []string{"flags", "flags"},
+			pointers: []string{"/a~1b/1", "/rollout"},
+		},
+		{
+			name: "item not allowed",
+			items: map[string]json.RawMessage{
+				"flags":   json.RawMessage(`{}`),
+				"unknown": json.RawMessage(`true`),
+			},
+			keys:     []string{"unknown"},
+			pointers: []string{""},
+		},
+		{
+			name: "missing required item",
+			items: map[string]json.RawMessage{
+				"limit": json.RawMessage(`0`),
+			},
+			keys:     []string{"", "limit"},
+			pointers: []string{"", ""},
+		},
+		{
+			name: "missing required item when partial",
+			items: map[string]json.RawMessage{
+				"limit": json.RawMessage(`0`),
+			},
+			partial:  true,
+			keys:     []string{"limit"},
+			pointers: []string{""},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			violations, err := edgeConfigSchemaViolations(schema, tt.items, tt.partial)
+			if err != nil {
+				t.Fatalf("edgeConfigSchemaViolations() error = %s", err)
+			}
+			if len(violations) != len(tt.keys) {
+				t.Fatalf("edgeConfigSchemaViolations() = %+v, want %d violations", violations, len(tt.keys))
+			}
+			for i, v := range violations {
+				if v.Key != tt.keys[i] || v.Pointer != tt.pointers[i] {
+					t.Errorf("violation %d is at %q %q, want %q %q", i, v.Key, v.Pointer, tt.keys[i], tt.pointers[i])
+				}
+				if v.Message == "" {
+					t.Errorf("violation %d has no message", i)
+				}
+			}
+		})
+	}
+}
+
+func TestEdgeConfigSchemaViolationString(t *testing.T) {
+	v := edgeConfigSchemaViolation{Key: "flags", Pointer: "/rollout", Message: "must be <= 100 but found 150"}
+	want := `The value of item "flags" does not match the Edge Config schema at "/rollout": must be <= 100 but found 150.`
+	if got := v.String(); got != want {
+		t.Errorf("String() = %q, want %q", got, want)
+	}
+}
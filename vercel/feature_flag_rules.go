@@ -0,0 +1,1078 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+// featureFlagEnvironmentNames are the environments every flag is configured for.
+var featureFlagEnvironmentNames = []string{"production", "preview", "development"}
+
+// The kinds of right hand side that a condition comparator expects.
+const (
+	featureFlagOperandNone = iota
+	featureFlagOperandString
+	featureFlagOperandDate
+	featureFlagOperandNumber
+	featureFlagOperandList
+	featureFlagOperandRegex
+)
+
+var featureFlagConditionOperators = map[string]int{
+	"ex":             featureFlagOperandNone,
+	"!ex":            featureFlagOperandNone,
+	"eq":             featureFlagOperandString,
+	"!eq":            featureFlagOperandString,
+	"contains":       featureFlagOperandString,
+	"!contains":      featureFlagOperandString,
+	"startsWith":     featureFlagOperandString,
+	"!startsWith":    featureFlagOperandString,
+	"endsWith":       featureFlagOperandString,
+	"!endsWith":      featureFlagOperandString,
+	"before":         featureFlagOperandDate,
+	"after":          featureFlagOperandDate,
+	"gt":             featureFlagOperandNumber,
+	"gte":            featureFlagOperandNumber,
+	"lt":             featureFlagOperandNumber,
+	"lte":            featureFlagOperandNumber,
+	"oneOf":          featureFlagOperandList,
+	"!oneOf":         featureFlagOperandList,
+	"containsAllOf":  featureFlagOperandList,
+	"containsAnyOf":  featureFlagOperandList,
+	"containsNoneOf": featureFlagOperandList,
+	"regex":          featureFlagOperandRegex,
+	"!regex":         featureFlagOperandRegex,
+}
+
+func featureFlagConditionOperatorNames() []string {
+	names := make([]string, 0, len(featureFlagConditionOperators))
+	for name := range featureFlagConditionOperators {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+type featureFlagConfigEnvironmentModel struct {
+	Enabled           types.Bool   `tfsdk:"enabled"`
+	DefaultVariantID  types.String `tfsdk:"default_variant_id"`
+	DefaultSplit      types.Object `tfsdk:"default_split"`
+	DisabledVariantID types.String `tfsdk:"disabled_variant_id"`
+	ReuseEnvironment  types.String `tfsdk:"reuse_environment"`
+	Rules             types.List   `tfsdk:"rules"`
+	Targets           types.Set    `tfsdk:"targets"`
+}
+
+type featureFlagRuleModel struct {
+	Conditions types.List   `tfsdk:"conditions"`
+	VariantID  types.String `tfsdk:"variant_id"`
+	Split      types.Object `tfsdk:"split"`
+}
+
+type featureFlagConditionModel struct {
+	Entity     types.String `tfsdk:"entity"`
+	Attribute  types.String `tfsdk:"attribute"`
+	SegmentIDs types.Set    `tfsdk:"segment_ids"`
+	Operator   types.String `tfsdk:"operator"`
+	Value      types.String `tfsdk:"value"`
+	Values     types.Set    `tfsdk:"values"`
+}
+
+type featureFlagSplitModel struct {
+	Entity            types.String `tfsdk:"entity"`
+	Attribute         types.String `tfsdk:"attribute"`
+	Weights           types.Map    `tfsdk:"weights"`
+	FallbackVariantID types.String `tfsdk:"fallback_variant_id"`
+}
+
+type featureFlagTargetModel struct {
+	VariantID types.String `tfsdk:"variant_id"`
+	Entity    types.String `tfsdk:"entity"`
+	Attribute types.String `tfsdk:"attribute"`
+	Values    types.Set    `tfsdk:"values"`
+}
+
+var featureFlagSplitAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"entity":              types.StringType,
+		"attribute":           types.StringType,
+		"weights":             types.MapType{ElemType: types.Float64Type},
+		"fallback_variant_id": types.StringType,
+	},
+}
+
+var featureFlagConditionAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"entity":      types.StringType,
+		"attribute":   types.StringType,
+		"segment_ids": types.SetType{ElemType: types.StringType},
+		"operator":    types.StringType,
+		"value":       types.StringType,
+		"values":      types.SetType{ElemType: types.StringType},
+	},
+}
+
+var featureFlagRuleAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"conditions": types.ListType{ElemType: featureFlagConditionAttrType},
+		"variant_id": types.StringType,
+		"split":      featureFlagSplitAttrType,
+	},
+}
+
+var featureFlagTargetAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"variant_id": types.StringType,
+		"entity":     types.StringType,
+		"attribute":  types.StringType,
+		"values":     types.SetType{ElemType: types.StringType},
+	},
+}
+
+var featureFlagConfigEnvironmentAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"enabled":             types.BoolType,
+		"default_variant_id":  types.StringType,
+		"default_split":       featureFlagSplitAttrType,
+		"disabled_variant_id": types.StringType,
+		"reuse_environment":   types.StringType,
+		"rules":               types.ListType{ElemType: featureFlagRuleAttrType},
+		"targets":             types.SetType{ElemType: featureFlagTargetAttrType},
+	},
+}
+
+func featureFlagSplitSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Optional:    true,
+		Description: description,
+		Attributes: map[string]schema.Attribute{
+			"entity": schema.StringAttribute{
+				Required:    true,
+				Description: "The entity type whose attribute decides the bucket, for example `user`.",
+			},
+			"attribute": schema.StringAttribute{
+				Required:    true,
+				Description: "The entity attribute that is hashed to pick a variant, for example `id`. The same value always lands on the same variant.",
+			},
+			"weights": schema.MapAttribute{
+				Required:    true,
+				ElementType: types.Float64Type,
+				Description: "The relative weight of each variant, keyed by variant ID. For example `{ control = 90, treatment = 10 }` serves `treatment` to 10% of entities.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+			},
+			"fallback_variant_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The variant served when the entity or attribute is missing from the evaluation context.",
+			},
+		},
+	}
+}
+
+func featureFlagRulesSchema() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Optional:    true,
+		Description: "Targeting rules, evaluated in order. The first rule whose conditions all match decides the outcome; when no rule matches, the default outcome is served.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"conditions": schema.ListNestedAttribute{
+					Required:    true,
+					Description: "The conditions that must all match for this rule to apply.",
+					Validators: []validator.List{
+						listvalidator.SizeAtLeast(1),
+					},
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"entity": schema.StringAttribute{
+								Optional:    true,
+								Description: "The entity type to compare, for example `user`. Required unless `segment_ids` is set.",
+							},
+							"attribute": schema.StringAttribute{
+								Optional:    true,
+								Description: "The entity attribute to compare, for example `email`. Required unless `segment_ids` is set.",
+							},
+							"segment_ids": schema.SetAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "Match entities by segment membership instead of by attribute, typically `vercel_feature_flag_segment.example.id`. Requires `operator` to be `oneOf` or `!oneOf`.",
+								Validators: []validator.Set{
+									setvalidator.SizeAtLeast(1),
+								},
+							},
+							"operator": schema.StringAttribute{
+								Required:    true,
+								Description: "How the attribute is compared. `ex` and `!ex` take no value; `oneOf`, `!oneOf`, `containsAllOf`, `containsAnyOf` and `containsNoneOf` take `values`; `gt`, `gte`, `lt` and `lte` take a numeric `value`; `before` and `after` take an RFC 3339 timestamp `value`; every other operator takes a string `value`.",
+								Validators: []validator.String{
+									stringvalidator.OneOf(featureFlagConditionOperatorNames()...),
+								},
+							},
+							"value": schema.StringAttribute{
+								Optional:    true,
+								Description: "The value to compare against, for operators that take a single value.",
+							},
+							"values": schema.SetAttribute{
+								Optional:    true,
+								ElementType: types.StringType,
+								Description: "The values to compare against, for operators that take a list.",
+								Validators: []validator.Set{
+									setvalidator.SizeAtLeast(1),
+								},
+							},
+						},
+					},
+				},
+				"variant_id": schema.StringAttribute{
+					Optional:    true,
+					Description: "The variant served when this rule matches. Exactly one of `variant_id` or `split` must be set.",
+				},
+				"split": featureFlagSplitSchema("A weighted split of variants served when this rule matches, for percentage rollouts. Exactly one of `variant_id` or `split` must be set."),
+			},
+		},
+	}
+}
+
+func featureFlagTargetsSchema() schema.SetNestedAttribute {
+	return schema.SetNestedAttribute{
+		Optional:    true,
+		Description: "Individual entities that are always served a specific variant. Targets are checked before any rule.",
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"variant_id": schema.StringAttribute{
+					Required:    true,
+					Description: "The variant served to the targeted entities.",
+				},
+				"entity": schema.StringAttribute{
+					Required:    true,
+					Description: "The entity type to target, for example `user`.",
+				},
+				"attribute": schema.StringAttribute{
+					Required:    true,
+					Description: "The entity attribute to match, for example `id`.",
+				},
+				"values": schema.SetAttribute{
+					Required:    true,
+					ElementType: types.StringType,
+					Description: "The exact attribute values that are served this variant.",
+					Validators: []validator.Set{
+						setvalidator.SizeAtLeast(1),
+					},
+				},
+			},
+		},
+	}
+}
+
+// featureFlagConfigEnvironments returns the environments of a config keyed by name.
+func featureFlagConfigEnvironments(model featureFlagConfigModel) map[string]featureFlagConfigEnvironmentModel {
+	return map[string]featureFlagConfigEnvironmentModel{
+		"production":  model.Production,
+		"preview":     model.Preview,
+		"development": model.Development,
+	}
+}
+
+// featureFlagConfigEnvironmentsToClient converts the configured environments
+// into their API representation, reporting any invalid combination against the
+// attribute path that caused it. Unknown values are skipped, so this can also
+// be used to validate configuration at plan time.
+func featureFlagConfigEnvironmentsToClient(ctx context.Context, environments map[string]featureFlagConfigEnvironmentModel) (map[string]client.FeatureFlagEnvironment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	out := make(map[string]client.FeatureFlagEnvironment, len(environments))
+	for _, name := range featureFlagEnvironmentNames {
+		env, ok := environments[name]
+		if !ok {
+			continue
+		}
+		converted, d := featureFlagConfigEnvironmentToClient(ctx, path.Root(name), env)
+		diags.Append(d...)
+		out[name] = converted
+	}
+
+	for _, name := range featureFlagEnvironmentNames {
+		env, ok := environments[name]
+		if !ok || env.ReuseEnvironment.IsNull() || env.ReuseEnvironment.IsUnknown() {
+			continue
+		}
+		reused := env.ReuseEnvironment.ValueString()
+		attrPath := path.Root(name).AtName("reuse_environment")
+		if reused == name {
+			diags.AddAttributeError(attrPath, "Invalid Feature Flag config", fmt.Sprintf("%s cannot reuse its own configuration.", name))
+			continue
+		}
+		target, ok := environments[reused]
+		if !ok {
+			continue
+		}
+		if !target.ReuseEnvironment.IsNull() {
+			diags.AddAttributeError(
+				attrPath,
+				"Invalid Feature Flag config",
+				fmt.Sprintf("%s reuses %s, which itself reuses another environment. Point %s at an environment with its own configuration.", name, reused, name),
+			)
+			continue
+		}
+
+		// The API still expects a fallthrough for linked environments, so send
+		// the one that is actually served.
+		linked := out[name]
+		linked.Fallthrough = out[reused].Fallthrough
+		out[name] = linked
+	}
+
+	return out, diags
+}
+
+func featureFlagConfigEnvironmentToClient(ctx context.Context, envPath path.Path, env featureFlagConfigEnvironmentModel) (client.FeatureFlagEnvironment, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	revision := 0
+	out := client.FeatureFlagEnvironment{
+		Active:   env.Enabled.ValueBool(),
+		Revision: &revision,
+		PausedOutcome: client.FeatureFlagOutcome{
+			Type:      "variant",
+			VariantID: env.DisabledVariantID.ValueString(),
+		},
+		Rules: []json.RawMessage{},
+		Reuse: &client.FeatureFlagReuse{
+			Active:      false,
+			Environment: "",
+		},
+	}
+
+	if !env.ReuseEnvironment.IsNull() {
+		for attribute, value := range map[string]attr.Value{
+			"default_variant_id": env.DefaultVariantID,
+			"default_split":      env.DefaultSplit,
+			"rules":              env.Rules,
+			"targets":            env.Targets,
+		} {
+			if !value.IsNull() {
+				diags.AddAttributeError(
+					envPath.AtName(attribute),
+					"Invalid Feature Flag config",
+					fmt.Sprintf("%s cannot be set together with reuse_environment, because the reused environment decides which variant is served.", attribute),
+				)
+			}
+		}
+		out.Reuse = &client.FeatureFlagReuse{
+			Active:      true,
+			Environment: env.ReuseEnvironment.ValueString(),
+		}
+		return out, diags
+	}
+
+	switch {
+	case !env.DefaultVariantID.IsNull() && !env.DefaultSplit.IsNull():
+		diags.AddAttributeError(
+			envPath.AtName("default_split"),
+			"Invalid Feature Flag config",
+			"Only one of default_variant_id or default_split can be set.",
+		)
+	case !env.DefaultSplit.IsNull():
+		outcome, d := featureFlagSplitToClient(ctx, envPath.AtName("default_split"), env.DefaultSplit)
+		diags.Append(d...)
+		out.Fallthrough = outcome
+	case !env.DefaultVariantID.IsNull():
+		out.Fallthrough = client.FeatureFlagOutcome{
+			Type:      "variant",
+			VariantID: env.DefaultVariantID.ValueString(),
+		}
+	default:
+		diags.AddAttributeError(
+			envPath.AtName("default_variant_id"),
+			"Invalid Feature Flag config",
+			"One of default_variant_id, default_split or reuse_environment must be set.",
+		)
+	}
+
+	if !env.Rules.IsNull() && !env.Rules.IsUnknown() {
+		var rules []featureFlagRuleModel
+		diags.Append(env.Rules.ElementsAs(ctx, &rules, false)...)
+		if diags.HasError() {
+			return out, diags
+		}
+		for i, rule := range rules {
+			converted, d := featureFlagRuleToClient(ctx, envPath.AtName("rules").AtListIndex(i), rule)
+			diags.Append(d...)
+			converted.ID = fmt.Sprintf("rule-%d", i+1)
+			raw, err := json.Marshal(converted)
+			if err != nil {
+				diags.AddAttributeError(envPath.AtName("rules").AtListIndex(i), "Invalid Feature Flag rule", err.Error())
+				continue
+			}
+			out.Rules = append(out.Rules, raw)
+		}
+	}
+
+	if !env.Targets.IsNull() && !env.Targets.IsUnknown() {
+		targets, d := featureFlagTargetsToClient(ctx, envPath.AtName("targets"), env.Targets)
+		diags.Append(d...)
+		out.Targets = targets
+	}
+
+	return out, diags
+}
+
+func featureFlagRuleToClient(ctx context.Context, rulePath path.Path, rule featureFlagRuleModel) (client.FeatureFlagRule, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := client.FeatureFlagRule{
+		Conditions: []client.FeatureFlagSegmentCondition{},
+	}
+
+	switch {
+	case rule.VariantID.IsNull() == rule.Split.IsNull():
+		diags.AddAttributeError(rulePath, "Invalid Feature Flag rule", "Exactly one of variant_id or split must be set.")
+	case !rule.Split.IsNull():
+		outcome, d := featureFlagSplitToClient(ctx, rulePath.AtName("split"), rule.Split)
+		diags.Append(d...)
+		out.Outcome = outcome
+	default:
+		out.Outcome = client.FeatureFlagOutcome{
+			Type:      "variant",
+			VariantID: rule.VariantID.ValueString(),
+		}
+	}
+
+	if rule.Conditions.IsNull() || rule.Conditions.IsUnknown() {
+		return out, diags
+	}
+	var conditions []featureFlagConditionModel
+	diags.Append(rule.Conditions.ElementsAs(ctx, &conditions, false)...)
+	if diags.HasError() {
+		return out, diags
+	}
+	for i, condition := range conditions {
+		converted, d := featureFlagConditionToClient(ctx, rulePath.AtName("conditions").AtListIndex(i), condition)
+		diags.Append(d...)
+		out.Conditions = append(out.Conditions, converted)
+	}
+
+	return out, diags
+}
+
+func featureFlagConditionToClient(ctx context.Context, conditionPath path.Path, condition featureFlagConditionModel) (client.FeatureFlagSegmentCondition, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	operator := condition.Operator.ValueString()
+	out := client.FeatureFlagSegmentCondition{CMP: operator}
+	invalid := func(attribute, message string) {
+		diags.AddAttributeError(conditionPath.AtName(attribute), "Invalid Feature Flag condition", message)
+	}
+
+	if !condition.SegmentIDs.IsNull() {
+		if !condition.Entity.IsNull() || !condition.Attribute.IsNull() {
+			invalid("segment_ids", "entity and attribute cannot be set together with segment_ids.")
+		}
+		if !condition.Value.IsNull() || !condition.Values.IsNull() {
+			invalid("segment_ids", "value and values cannot be set together with segment_ids.")
+		}
+		if !condition.Operator.IsUnknown() && operator != "oneOf" && operator != "!oneOf" {
+			invalid("operator", fmt.Sprintf("Segment conditions only support the oneOf and !oneOf operators, got %q.", operator))
+		}
+		out.LHS = client.FeatureFlagSegmentConditionLHS{Type: "segment"}
+		segmentIDs, d := featureFlagStringSet(ctx, condition.SegmentIDs)
+		diags.Append(d...)
+		out.RHS = featureFlagListRHS(segmentIDs)
+		return out, diags
+	}
+
+	if condition.Entity.IsNull() {
+		invalid("entity", "entity is required unless segment_ids is set.")
+	}
+	if condition.Attribute.IsNull() {
+		invalid("attribute", "attribute is required unless segment_ids is set.")
+	}
+	out.LHS = client.FeatureFlagSegmentConditionLHS{
+		Type:      "entity",
+		Kind:      condition.Entity.ValueString(),
+		Attribute: condition.Attribute.ValueString(),
+	}
+
+	if condition.Operator.IsUnknown() {
+		return out, diags
+	}
+	operand, ok := featureFlagConditionOperators[operator]
+	if !ok {
+		// Rejected by the schema validator.
+		return out, diags
+	}
+
+	if operand == featureFlagOperandList {
+		if !condition.Value.IsNull() {
+			invalid("value", fmt.Sprintf("The %s operator compares against a list; use values instead of value.", operator))
+		}
+		if condition.Values.IsNull() {
+			invalid("values", fmt.Sprintf("The %s operator requires values.", operator))
+			return out, diags
+		}
+		values, d := featureFlagStringSet(ctx, condition.Values)
+		diags.Append(d...)
+		out.RHS = featureFlagListRHS(values)
+		return out, diags
+	}
+
+	if !condition.Values.IsNull() {
+		invalid("values", fmt.Sprintf("The %s operator does not take a list of values.", operator))
+	}
+	if operand == featureFlagOperandNone {
+		if !condition.Value.IsNull() {
+			invalid("value", fmt.Sprintf("The %s operator does not take a value.", operator))
+		}
+		return out, diags
+	}
+	if condition.Value.IsNull() {
+		invalid("value", fmt.Sprintf("The %s operator requires a value.", operator))
+		return out, diags
+	}
+	if condition.Value.IsUnknown() {
+		return out, diags
+	}
+
+	value := condition.Value.ValueString()
+	switch operand {
+	case featureFlagOperandNumber:
+		number, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			invalid("value", fmt.Sprintf("The %s operator compares numbers, but %q is not a number.", operator, value))
+			return out, diags
+		}
+		out.RHS = number
+	case featureFlagOperandDate:
+		if _, err := time.Parse(time.RFC3339, value); err != nil {
+			invalid("value", fmt.Sprintf("The %s operator compares timestamps, but %q is not an RFC 3339 timestamp.", operator, value))
+			return out, diags
+		}
+		out.RHS = value
+	case featureFlagOperandRegex:
+		out.RHS = client.FeatureFlagRegexRHS{
+			Type:    "regex",
+			Pattern: value,
+		}
+	default:
+		out.RHS = value
+	}
+
+	return out, diags
+}
+
+func featureFlagSplitToClient(ctx context.Context, splitPath path.Path, split types.Object) (client.FeatureFlagOutcome, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	out := client.FeatureFlagOutcome{Type: "split"}
+	if split.IsUnknown() {
+		return out, diags
+	}
+
+	var model featureFlagSplitModel
+	diags.Append(split.As(ctx, &model, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return out, diags
+	}
+	out.Base = &client.FeatureFlagSegmentConditionLHS{
+		Type:      "entity",
+		Kind:      model.Entity.ValueString(),
+		Attribute: model.Attribute.ValueString(),
+	}
+	out.DefaultVariantID = model.FallbackVariantID.ValueString()
+
+	if model.Weights.IsUnknown() {
+		return out, diags
+	}
+	var weights map[string]types.Float64
+	diags.Append(model.Weights.ElementsAs(ctx, &weights, false)...)
+	if diags.HasError() {
+		return out, diags
+	}
+
+	out.Weights = make(map[string]float64, len(weights))
+	total, known := 0.0, true
+	for variantID, weight := range weights {
+		if weight.IsUnknown() {
+			known = false
+			continue
+		}
+		if weight.ValueFloat64() < 0 {
+			diags.AddAttributeError(
+				splitPath.AtName("weights").AtMapKey(variantID),
+				"Invalid Feature Flag split",
+				fmt.Sprintf("The weight for %q must not be negative.", variantID),
+			)
+		}
+		out.Weights[variantID] = weight.ValueFloat64()
+		total += weight.ValueFloat64()
+	}
+	if known && len(weights) > 0 && total <= 0 {
+		diags.AddAttributeError(
+			splitPath.AtName("weights"),
+			"Invalid Feature Flag split",
+			"At least one variant must have a weight greater than zero.",
+		)
+	}
+
+	return out, diags
+}
+
+func featureFlagTargetsToClient(ctx context.Context, targetsPath path.Path, set types.Set) (map[string]map[string]map[string][]client.FeatureFlagSegmentValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var targets []featureFlagTargetModel
+	diags.Append(set.ElementsAs(ctx, &targets, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	out := map[string]map[string]map[string][]client.FeatureFlagSegmentValue{}
+	targetedBy := map[string]string{}
+	for _, target := range targets {
+		if target.VariantID.IsUnknown() || target.Entity.IsUnknown() || target.Attribute.IsUnknown() || target.Values.IsUnknown() {
+			continue
+		}
+		variantID, entity, attribute := target.VariantID.ValueString(), target.Entity.ValueString(), target.Attribute.ValueString()
+		values, d := featureFlagStringSet(ctx, target.Values)
+		diags.Append(d...)
+
+		if out[variantID] == nil {
+			out[variantID] = map[string]map[string][]client.FeatureFlagSegmentValue{}
+		}
+		if out[variantID][entity] == nil {
+			out[variantID][entity] = map[string][]client.FeatureFlagSegmentValue{}
+		}
+		for _, value := range values {
+			key := entity + "\x00" + attribute + "\x00" + value
+			if other, ok := targetedBy[key]; ok && other != variantID {
+				diags.AddAttributeError(
+					targetsPath,
+					"Invalid Feature Flag targets",
+					fmt.Sprintf("%s.%s %q is targeted at both %q and %q. Each entity can only be targeted at one variant.", entity, attribute, value, other, variantID),
+				)
+				continue
+			}
+			if _, ok := targetedBy[key]; ok {
+				continue
+			}
+			targetedBy[key] = variantID
+			out[variantID][entity][attribute] = append(out[variantID][entity][attribute], client.FeatureFlagSegmentValue{Value: value})
+		}
+	}
+
+	return out, diags
+}
+
+func featureFlagListRHS(values []string) client.FeatureFlagListRHS {
+	items := make([]client.FeatureFlagSegmentValue, 0, len(values))
+	for _, value := range values {
+		items = append(items, client.FeatureFlagSegmentValue{Value: value})
+	}
+	return client.FeatureFlagListRHS{
+		Type:  "list",
+		Items: items,
+	}
+}
+
+// featureFlagStringSet returns the known elements of a set of strings in a
+// stable order.
+func featureFlagStringSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	if set.IsNull() || set.IsUnknown() {
+		return nil, nil
+	}
+	var values []types.String
+	diags := set.ElementsAs(ctx, &values, false)
+	out := make([]string, 0, len(values))
+	for _, value := range values {
+		if !value.IsUnknown() {
+			out = append(out, value.ValueString())
+		}
+	}
+	sort.Strings(out)
+	return out, diags
+}
+
+// featureFlagEnvironmentVariantErrors lists every variant an environment
+// references that is not defined on the flag.
+func featureFlagEnvironmentVariantErrors(name string, env client.FeatureFlagEnvironment, variantIDs map[string]struct{}) []string {
+	var problems []string
+	check := func(location, variantID string) {
+		if variantID == "" {
+			return
+		}
+		if _, ok := variantIDs[variantID]; !ok {
+			problems = append(problems, fmt.Sprintf("%s.%s references %q, but no variant with that ID exists.", name, location, variantID))
+		}
+	}
+	checkOutcome := func(location string, outcome client.FeatureFlagOutcome) {
+		if outcome.Type == "variant" {
+			check(location+"variant_id", outcome.VariantID)
+			return
+		}
+		weights := make([]string, 0, len(outcome.Weights))
+		for variantID := range outcome.Weights {
+			weights = append(weights, variantID)
+		}
+		sort.Strings(weights)
+		for _, variantID := range weights {
+			check(location+"weights", variantID)
+		}
+		check(location+"fallback_variant_id", outcome.DefaultVariantID)
+	}
+
+	check("disabled_variant_id", env.PausedOutcome.VariantID)
+	if env.Reuse != nil && env.Reuse.Active {
+		return problems
+	}
+	if env.Fallthrough.Type == "split" {
+		checkOutcome("default_split.", env.Fallthrough)
+	} else {
+		check("default_variant_id", env.Fallthrough.VariantID)
+	}
+	for i, raw := range env.Rules {
+		var rule client.FeatureFlagRule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			continue
+		}
+		if rule.Outcome.Type == "split" {
+			checkOutcome(fmt.Sprintf("rules[%d].split.", i), rule.Outcome)
+		} else {
+			checkOutcome(fmt.Sprintf("rules[%d].", i), rule.Outcome)
+		}
+	}
+	targets := make([]string, 0, len(env.Targets))
+	for variantID := range env.Targets {
+		targets = append(targets, variantID)
+	}
+	sort.Strings(targets)
+	for _, variantID := range targets {
+		check("targets", variantID)
+	}
+
+	return problems
+}
+
+// featureFlagEnvironmentSegmentIDs lists the segments referenced by an
+// environment's rules.
+func featureFlagEnvironmentSegmentIDs(env client.FeatureFlagEnvironment) []string {
+	seen := map[string]struct{}{}
+	var out []string
+	for _, raw := range env.Rules {
+		var rule client.FeatureFlagRule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			continue
+		}
+		for _, condition := range rule.Conditions {
+			if condition.LHS.Type != "segment" {
+				continue
+			}
+			segmentIDs, err := featureFlagListRHSValues(condition.RHS)
+			if err != nil {
+				continue
+			}
+			for _, segmentID := range segmentIDs {
+				if _, ok := seen[segmentID]; ok || segmentID == "" {
+					continue
+				}
+				seen[segmentID] = struct{}{}
+				out = append(out, segmentID)
+			}
+		}
+	}
+	return out
+}
+
+func featureFlagConfigEnvironmentFromClient(ctx context.Context, name string, env client.FeatureFlagEnvironment, prior featureFlagConfigEnvironmentModel) (featureFlagConfigEnvironmentModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	model := featureFlagConfigEnvironmentModel{
+		Enabled:           types.BoolValue(env.Active),
+		DefaultVariantID:  types.StringNull(),
+		DefaultSplit:      types.ObjectNull(featureFlagSplitAttrType.AttrTypes),
+		DisabledVariantID: types.StringValue(env.PausedOutcome.VariantID),
+		ReuseEnvironment:  types.StringNull(),
+		Rules:             types.ListNull(featureFlagRuleAttrType),
+		Targets:           types.SetNull(featureFlagTargetAttrType),
+	}
+	if env.PausedOutcome.Type != "variant" {
+		diags.AddError(
+			"Unsupported Feature Flag config",
+			fmt.Sprintf("%s uses a non-variant paused outcome, which this resource does not model.", name),
+		)
+		return model, diags
+	}
+
+	if env.Reuse != nil && env.Reuse.Active {
+		model.ReuseEnvironment = types.StringValue(env.Reuse.Environment)
+		return model, diags
+	}
+
+	switch env.Fallthrough.Type {
+	case "variant":
+		model.DefaultVariantID = types.StringValue(env.Fallthrough.VariantID)
+	case "split":
+		split, d := featureFlagSplitFromClient(ctx, env.Fallthrough)
+		diags.Append(d...)
+		model.DefaultSplit = split
+	default:
+		diags.AddError(
+			"Unsupported Feature Flag config",
+			fmt.Sprintf("%s uses a %q fallthrough outcome, which this resource does not model.", name, env.Fallthrough.Type),
+		)
+		return model, diags
+	}
+
+	var priorRules []featureFlagRuleModel
+	if !prior.Rules.IsNull() && !prior.Rules.IsUnknown() {
+		diags.Append(prior.Rules.ElementsAs(ctx, &priorRules, false)...)
+	}
+	rules := make([]attr.Value, 0, len(env.Rules))
+	for i, raw := range env.Rules {
+		var rule client.FeatureFlagRule
+		if err := json.Unmarshal(raw, &rule); err != nil {
+			diags.AddError(
+				"Unsupported Feature Flag config",
+				fmt.Sprintf("%s rule %d could not be decoded: %s", name, i+1, err),
+			)
+			return model, diags
+		}
+		var priorRule featureFlagRuleModel
+		if i < len(priorRules) {
+			priorRule = priorRules[i]
+		}
+		value, err := featureFlagRuleFromClient(ctx, rule, priorRule)
+		if err != nil {
+			diags.AddError(
+				"Unsupported Feature Flag config",
+				fmt.Sprintf("%s rule %d cannot be represented by this resource: %s", name, i+1, err),
+			)
+			return model, diags
+		}
+		rules = append(rules, value)
+	}
+	if len(rules) > 0 || (!prior.Rules.IsNull() && !prior.Rules.IsUnknown()) {
+		list, d := types.ListValue(featureFlagRuleAttrType, rules)
+		diags.Append(d...)
+		model.Rules = list
+	}
+
+	var targets []attr.Value
+	for variantID, entities := range env.Targets {
+		for entity, attributes := range entities {
+			for attribute, values := range attributes {
+				if len(values) == 0 {
+					continue
+				}
+				elements := make([]attr.Value, 0, len(values))
+				for _, value := range values {
+					elements = append(elements, types.StringValue(value.Value))
+				}
+				target, d := types.ObjectValue(featureFlagTargetAttrType.AttrTypes, map[string]attr.Value{
+					"variant_id": types.StringValue(variantID),
+					"entity":     types.StringValue(entity),
+					"attribute":  types.StringValue(attribute),
+					"values":     types.SetValueMust(types.StringType, elements),
+				})
+				diags.Append(d...)
+				targets = append(targets, target)
+			}
+		}
+	}
+	if len(targets) > 0 || (!prior.Targets.IsNull() && !prior.Targets.IsUnknown()) {
+		set, d := types.SetValue(featureFlagTargetAttrType, targets)
+		diags.Append(d...)
+		model.Targets = set
+	}
+
+	return model, diags
+}
+
+func featureFlagRuleFromClient(ctx context.Context, rule client.FeatureFlagRule, prior featureFlagRuleModel) (attr.Value, error) {
+	var priorConditions []featureFlagConditionModel
+	if !prior.Conditions.IsNull() && !prior.Conditions.IsUnknown() {
+		prior.Conditions.ElementsAs(ctx, &priorConditions, false)
+	}
+
+	conditions := make([]attr.Value, 0, len(rule.Conditions))
+	for i, condition := range rule.Conditions {
+		var priorCondition featureFlagConditionModel
+		if i < len(priorConditions) {
+			priorCondition = priorConditions[i]
+		}
+		value, err := featureFlagConditionFromClient(condition, priorCondition)
+		if err != nil {
+			return nil, err
+		}
+		conditions = append(conditions, value)
+	}
+
+	variantID := types.StringNull()
+	split := types.ObjectNull(featureFlagSplitAttrType.AttrTypes)
+	switch rule.Outcome.Type {
+	case "variant":
+		variantID = types.StringValue(rule.Outcome.VariantID)
+	case "split":
+		value, diags := featureFlagSplitFromClient(ctx, rule.Outcome)
+		if diags.HasError() {
+			return nil, fmt.Errorf("could not convert split outcome")
+		}
+		split = value
+	default:
+		return nil, fmt.Errorf("unsupported outcome type %q", rule.Outcome.Type)
+	}
+
+	value, diags := types.ObjectValue(featureFlagRuleAttrType.AttrTypes, map[string]attr.Value{
+		"conditions": types.ListValueMust(featureFlagConditionAttrType, conditions),
+		"variant_id": variantID,
+		"split":      split,
+	})
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not convert rule")
+	}
+	return value, nil
+}
+
+func featureFlagConditionFromClient(condition client.FeatureFlagSegmentCondition, prior featureFlagConditionModel) (attr.Value, error) {
+	attributes := map[string]attr.Value{
+		"entity":      types.StringNull(),
+		"attribute":   types.StringNull(),
+		"segment_ids": types.SetNull(types.StringType),
+		"operator":    types.StringValue(condition.CMP),
+		"value":       types.StringNull(),
+		"values":      types.SetNull(types.StringType),
+	}
+
+	operand, ok := featureFlagConditionOperators[condition.CMP]
+	if !ok {
+		return nil, fmt.Errorf("unsupported operator %q", condition.CMP)
+	}
+
+	switch condition.LHS.Type {
+	case "segment":
+		items, err := featureFlagListRHSFromClient(condition.RHS)
+		if err != nil {
+			return nil, err
+		}
+		attributes["segment_ids"] = items
+	case "entity":
+		attributes["entity"] = types.StringValue(condition.LHS.Kind)
+		attributes["attribute"] = types.StringValue(condition.LHS.Attribute)
+		switch operand {
+		case featureFlagOperandNone:
+		case featureFlagOperandList:
+			items, err := featureFlagListRHSFromClient(condition.RHS)
+			if err != nil {
+				return nil, err
+			}
+			attributes["values"] = items
+		case featureFlagOperandRegex:
+			raw, err := json.Marshal(condition.RHS)
+			if err != nil {
+				return nil, err
+			}
+			var rhs client.FeatureFlagRegexRHS
+			if err := json.Unmarshal(raw, &rhs); err != nil || rhs.Type != "regex" {
+				return nil, fmt.Errorf("unsupported value for the %s operator", condition.CMP)
+			}
+			attributes["value"] = types.StringValue(rhs.Pattern)
+		default:
+			switch rhs := condition.RHS.(type) {
+			case string:
+				attributes["value"] = types.StringValue(rhs)
+			case float64:
+				// Keep the configured spelling of the number, such as "1.50".
+				if priorNumber, err := strconv.ParseFloat(prior.Value.ValueString(), 64); err == nil && priorNumber == rhs {
+					attributes["value"] = prior.Value
+				} else {
+					attributes["value"] = types.StringValue(strconv.FormatFloat(rhs, 'f', -1, 64))
+				}
+			case bool:
+				attributes["value"] = types.StringValue(strconv.FormatBool(rhs))
+			default:
+				return nil, fmt.Errorf("unsupported value %v for the %s operator", condition.RHS, condition.CMP)
+			}
+		}
+	default:
+		return nil, fmt.Errorf("unsupported condition type %q", condition.LHS.Type)
+	}
+
+	value, diags := types.ObjectValue(featureFlagConditionAttrType.AttrTypes, attributes)
+	if diags.HasError() {
+		return nil, fmt.Errorf("could not convert condition")
+	}
+	return value, nil
+}
+
+func featureFlagListRHSFromClient(rhs any) (types.Set, error) {
+	values, err := featureFlagListRHSValues(rhs)
+	if err != nil {
+		return types.SetNull(types.StringType), err
+	}
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements), nil
+}
+
+// featureFlagListRHSValues decodes the items of a list right hand side, which
+// may be a client.FeatureFlagListRHS or its generic JSON decoding.
+func featureFlagListRHSValues(rhs any) ([]string, error) {
+	raw, err := json.Marshal(rhs)
+	if err != nil {
+		return nil, err
+	}
+	var list struct {
+		Type  string `json:"type"`
+		Items []struct {
+			Value any `json:"value"`
+		} `json:"items"`
+	}
+	if err := json.Unmarshal(raw, &list); err != nil || list.Type != "list" {
+		return nil, fmt.Errorf("expected a list of values, got %s", raw)
+	}
+
+	values := make([]string, 0, len(list.Items))
+	for _, item := range list.Items {
+		switch value := item.Value.(type) {
+		case string:
+			values = append(values, value)
+		case float64:
+			values = append(values, strconv.FormatFloat(value, 'f', -1, 64))
+		default:
+			values = append(values, fmt.Sprint(value))
+		}
+	}
+	return values, nil
+}
+
+func featureFlagSplitFromClient(ctx context.Context, outcome client.FeatureFlagOutcome) (types.Object, diag.Diagnostics) {
+	base := client.FeatureFlagSegmentConditionLHS{}
+	if outcome.Base != nil {
+		base = *outcome.Base
+	}
+	weights, diags := types.MapValueFrom(ctx, types.Float64Type, outcome.Weights)
+	if diags.HasError() {
+		return types.ObjectNull(featureFlagSplitAttrType.AttrTypes), diags
+	}
+	return types.ObjectValueFrom(ctx, featureFlagSplitAttrType.AttrTypes, featureFlagSplitModel{
+		Entity:            types.StringValue(base.Kind),
+		Attribute:         types.StringValue(base.Attribute),
+		Weights:           weights,
+		FallbackVariantID: types.StringValue(outcome.DefaultVariantID),
+	})
+}
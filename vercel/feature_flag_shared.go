@@ -1,12 +1,18 @@
 package vercel
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"math/big"
 	"regexp"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	"github.com/vercel/terraform-provider-vercel/v5/client"
@@ -14,12 +20,6 @@ import (
 
 var featureFlagKeyRegex = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,512}$`)
 
-type featureFlagEnvironmentModel struct {
-	Enabled           types.Bool   `tfsdk:"enabled"`
-	DefaultVariantID  types.String `tfsdk:"default_variant_id"`
-	DisabledVariantID types.String `tfsdk:"disabled_variant_id"`
-}
-
 type featureFlagVariantModel struct {
 	ID          types.String `tfsdk:"id"`
 	Label       types.String `tfsdk:"label"`
@@ -30,17 +30,17 @@ type featureFlagVariantModel struct {
 }
 
 type featureFlagModel struct {
-	ID          types.String                `tfsdk:"id"`
-	ProjectID   types.String                `tfsdk:"project_id"`
-	TeamID      types.String                `tfsdk:"team_id"`
-	Key         types.String                `tfsdk:"key"`
-	Description types.String                `tfsdk:"description"`
-	Kind        types.String                `tfsdk:"kind"`
-	Archived    types.Bool                  `tfsdk:"archived"`
-	Variant     []featureFlagVariantModel   `tfsdk:"variant"`
-	Production  featureFlagEnvironmentModel `tfsdk:"production"`
-	Preview     featureFlagEnvironmentModel `tfsdk:"preview"`
-	Development featureFlagEnvironmentModel `tfsdk:"development"`
+	ID          types.String                      `tfsdk:"id"`
+	ProjectID   types.String                      `tfsdk:"project_id"`
+	TeamID      types.String                      `tfsdk:"team_id"`
+	Key         types.String                      `tfsdk:"key"`
+	Description types.String                      `tfsdk:"description"`
+	Kind        types.String                      `tfsdk:"kind"`
+	Archived    types.Bool                        `tfsdk:"archived"`
+	Variant     []featureFlagVariantModel         `tfsdk:"variant"`
+	Production  featureFlagConfigEnvironmentModel `tfsdk:"production"`
+	Preview     featureFlagConfigEnvironmentModel `tfsdk:"preview"`
+	Development featureFlagConfigEnvironmentModel `tfsdk:"development"`
 }
 
 func featureFlagVariantsToClient(kind string, variants []featureFlagVariantModel) ([]client.FeatureFlagVariant, map[string]struct{}, diag.Diagnostics) {
@@ -92,47 +92,6 @@ func featureFlagVariantIDsFromClient(variants []client.FeatureFlagVariant) map[s
 	return out
 }
 
-func featureFlagEnvironmentsFromModel(environments map[string]featureFlagEnvironmentModel, variantIDs map[string]struct{}) (map[string]client.FeatureFlagEnvironment, diag.Diagnostics) {
-	var diags diag.Diagnostics
-
-	out := make(map[string]client.FeatureFlagEnvironment, len(environments))
-	for name, env := range environments {
-		revision := 0
-		if _, ok := variantIDs[env.DefaultVariantID.ValueString()]; !ok {
-			diags.AddError(
-				"Unknown Feature Flag default variant",
-				fmt.Sprintf("%s.default_variant_id references %q, but no variant with that ID exists.", name, env.DefaultVariantID.ValueString()),
-			)
-		}
-		if _, ok := variantIDs[env.DisabledVariantID.ValueString()]; !ok {
-			diags.AddError(
-				"Unknown Feature Flag disabled variant",
-				fmt.Sprintf("%s.disabled_variant_id references %q, but no variant with that ID exists.", name, env.DisabledVariantID.ValueString()),
-			)
-		}
-
-		out[name] = client.FeatureFlagEnvironment{
-			Active:   env.Enabled.ValueBool(),
-			Revision: &revision,
-			PausedOutcome: client.FeatureFlagOutcome{
-				Type:      "variant",
-				VariantID: env.DisabledVariantID.ValueString(),
-			},
-			Fallthrough: client.FeatureFlagOutcome{
-				Type:      "variant",
-				VariantID: env.DefaultVariantID.ValueString(),
-			},
-			Rules: []json.RawMessage{},
-			Reuse: &client.FeatureFlagReuse{
-				Active:      false,
-				Environment: "",
-			},
-		}
-	}
-
-	return out, diags
-}
-
 func featureFlagBootstrapVariantID(kind string, variants []client.FeatureFlagVariant) (string, error) {
 	switch kind {
 	case "boolean":
@@ -182,7 +141,7 @@ func featureFlagBootstrapEnvironments(defaultVariantID string) map[string]client
 	return out
 }
 
-func featureFlagFromClient(out client.FeatureFlag, ref featureFlagModel) (featureFlagModel, diag.Diagnostics) {
+func featureFlagFromClient(ctx context.Context, out client.FeatureFlag, ref featureFlagModel) (featureFlagModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	model := featureFlagModel{
@@ -214,25 +173,21 @@ func featureFlagFromClient(out client.FeatureFlag, ref featureFlagModel) (featur
 	}
 	model.Variant = variants
 
-	production, err := featureFlagEnvironmentFromClient("production", out.Environments["production"])
-	if err != nil {
-		diags.AddError("Unsupported Feature Flag environment", err.Error())
-		return model, diags
+	// Environments are read in full, the same way as vercel_feature_flag_config,
+	// so that rules, targets and splits are never summarised away.
+	environments := map[string]*featureFlagConfigEnvironmentModel{
+		"production":  &model.Production,
+		"preview":     &model.Preview,
+		"development": &model.Development,
 	}
-	preview, err := featureFlagEnvironmentFromClient("preview", out.Environments["preview"])
-	if err != nil {
-		diags.AddError("Unsupported Feature Flag environment", err.Error())
-		return model, diags
+	for _, name := range featureFlagEnvironmentNames {
+		var d diag.Diagnostics
+		*environments[name], d = featureFlagConfigEnvironmentFromClient(ctx, name, out.Environments[name], featureFlagConfigEnvironmentModel{})
+		diags.Append(d...)
 	}
-	development, err := featureFlagEnvironmentFromClient("development", out.Environments["development"])
-	if err != nil {
-		diags.AddError("Unsupported Feature Flag environment", err.Error())
+	if diags.HasError() {
 		return model, diags
 	}
-
-	model.Production = production
-	model.Preview = preview
-	model.Development = development
 	if model.TeamID.IsNull() {
 		model.TeamID = ref.TeamID
 	}
@@ -321,38 +276,6 @@ func featureFlagVariantFromClient(kind string, variant client.FeatureFlagVariant
 	return model, nil
 }
 
-func featureFlagEnvironmentFromClient(name string, env client.FeatureFlagEnvironment) (featureFlagEnvironmentModel, error) {
-	if err := featureFlagEnvironmentShapeError(name, env); err != nil {
-		return featureFlagEnvironmentModel{}, err
-	}
-
-	return featureFlagEnvironmentModel{
-		Enabled:           types.BoolValue(env.Active),
-		DefaultVariantID:  types.StringValue(env.Fallthrough.VariantID),
-		DisabledVariantID: types.StringValue(env.PausedOutcome.VariantID),
-	}, nil
-}
-
-func featureFlagEnvironmentShapeError(name string, env client.FeatureFlagEnvironment) error {
-	if env.Reuse != nil && env.Reuse.Active {
-		return fmt.Errorf("%s uses a linked environment, which this resource does not model yet", name)
-	}
-	if len(env.Rules) > 0 {
-		return fmt.Errorf("%s defines rules, which this resource does not model yet", name)
-	}
-	if len(env.Targets) > 0 {
-		return fmt.Errorf("%s defines target overrides, which this resource does not model yet", name)
-	}
-	if env.Fallthrough.Type != "variant" {
-		return fmt.Errorf("%s uses a non-variant fallthrough outcome, which this resource does not model yet", name)
-	}
-	if env.PausedOutcome.Type != "variant" {
-		return fmt.Errorf("%s uses a non-variant paused outcome, which this resource does not model yet", name)
-	}
-
-	return nil
-}
-
 func featureFlagOptionalStringValue(value string, prior types.String) types.String {
 	if value == "" {
 		if !prior.IsNull() {
@@ -362,3 +285,22 @@ func featureFlagOptionalStringValue(value string, prior types.String) types.Stri
 	}
 	return types.StringValue(value)
 }
+
+func featureFlagChangeMessageSchema() schema.StringAttribute {
+	return schema.StringAttribute{
+		Optional:    true,
+		WriteOnly:   true,
+		Description: "A message recorded in the flag's revision history for each change Terraform makes, such as a CI run ID or commit SHA. The message is not stored in state, so changing it alone does not cause an update.",
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+		},
+	}
+}
+
+// featureFlagChangeMessage reads the write-only change_message attribute,
+// which is only ever present in the configuration.
+func featureFlagChangeMessage(ctx context.Context, config tfsdk.Config) (string, diag.Diagnostics) {
+	var message types.String
+	diags := config.GetAttribute(ctx, path.Root("change_message"), &message)
+	return message.ValueString(), diags
+}
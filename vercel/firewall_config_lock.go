@@ -0,0 +1,10 @@
+package vercel
+
+// firewallConfigLocks serialises changes to a project's firewall config, so
+// that resources sharing a project do not race when they read the config to
+// find the rules they created or to compute a rule's position.
+var firewallConfigLocks = newKeyedMutex()
+
+func firewallConfigLockKey(teamID, projectID string) string {
+	return teamID + "/" + projectID
+}
@@ -0,0 +1,138 @@
+package vercel
+
+import (
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+// The OWASP core rule set defaults, which the API reports when no value has
+// been configured.
+const (
+	firewallCRSDefaultParanoiaLevel   = 1
+	firewallCRSDefaultInboundAnomaly  = 5
+	firewallCRSDefaultOutboundAnomaly = 4
+)
+
+var firewallCRSRuleIDPattern = regexp.MustCompile(`^9[0-9]{5}$`)
+
+// firewallCRSRuleGroups maps the three digit prefix of a core rule set rule ID
+// to the group the rule belongs to. Rules outside these files, such as the
+// protocol enforcement rules, are not part of any configurable group.
+var firewallCRSRuleGroups = map[string]string{
+	"913": "sd",
+	"922": "ma",
+	"930": "lfi",
+	"931": "rfi",
+	"932": "rce",
+	"933": "php",
+	"934": "gen",
+	"941": "xss",
+	"942": "sqli",
+	"943": "sf",
+	"944": "java",
+}
+
+type CRSRuleOverride struct {
+	Active types.Bool   `tfsdk:"active"`
+	Action types.String `tfsdk:"action"`
+}
+
+// toClient builds the owasp managed ruleset, including its tuning and any
+// per-rule overrides.
+func (r *CRSRule) toClient() client.ManagedRule {
+	rule := client.ManagedRule{
+		Active:        true,
+		ParanoiaLevel: r.ParanoiaLevel.ValueInt64Pointer(),
+	}
+	if !r.InboundAnomalyThreshold.IsNull() || !r.OutboundAnomalyThreshold.IsNull() {
+		rule.AnomalyThresholds = &client.AnomalyThresholds{
+			Inbound:  r.InboundAnomalyThreshold.ValueInt64Pointer(),
+			Outbound: r.OutboundAnomalyThreshold.ValueInt64Pointer(),
+		}
+	}
+	if len(r.RuleOverrides) > 0 {
+		rule.RuleOverrides = make(map[string]client.ManagedRuleOverride, len(r.RuleOverrides))
+		for id, override := range r.RuleOverrides {
+			rule.RuleOverrides[id] = client.ManagedRuleOverride{
+				Active: override.Active.ValueBoolPointer(),
+				Action: override.Action.ValueString(),
+			}
+		}
+	}
+	return rule
+}
+
+// setOWASPSettings reads the tuning and overrides of the owasp managed ruleset
+// into r. Settings left unset in ref stay null while the API reports the
+// default, so that omitting them does not show a diff.
+func (r *CRSRule) setOWASPSettings(rule client.ManagedRule, ref *CRSRule) {
+	if ref == nil {
+		ref = &CRSRule{}
+	}
+	var inbound, outbound *int64
+	if rule.AnomalyThresholds != nil {
+		inbound, outbound = rule.AnomalyThresholds.Inbound, rule.AnomalyThresholds.Outbound
+	}
+	r.ParanoiaLevel = fromCRSSetting(rule.ParanoiaLevel, ref.ParanoiaLevel, firewallCRSDefaultParanoiaLevel)
+	r.InboundAnomalyThreshold = fromCRSSetting(inbound, ref.InboundAnomalyThreshold, firewallCRSDefaultInboundAnomaly)
+	r.OutboundAnomalyThreshold = fromCRSSetting(outbound, ref.OutboundAnomalyThreshold, firewallCRSDefaultOutboundAnomaly)
+
+	r.RuleOverrides = nil
+	if len(rule.RuleOverrides) == 0 {
+		return
+	}
+	r.RuleOverrides = make(map[string]CRSRuleOverride, len(rule.RuleOverrides))
+	for id, override := range rule.RuleOverrides {
+		o := CRSRuleOverride{
+			Active: types.BoolPointerValue(override.Active),
+			Action: types.StringValue(override.Action),
+		}
+		if override.Action == "" {
+			o.Action = types.StringNull()
+		}
+		r.RuleOverrides[id] = o
+	}
+}
+
+func fromCRSSetting(value *int64, ref types.Int64, def int64) types.Int64 {
+	if value == nil || (ref.IsNull() && *value == def) {
+		return types.Int64Null()
+	}
+	return types.Int64Value(*value)
+}
+
+// validateCRSRuleOverrides checks each override targets a core rule set rule,
+// and warns about overrides that cannot take effect because their group is
+// turned off.
+func validateCRSRuleOverrides(owaspPath path.Path, owasp *CRSRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	groups := owasp.ToMap()
+	for id := range owasp.RuleOverrides {
+		overridePath := owaspPath.AtName("rule_overrides").AtMapKey(id)
+		if !firewallCRSRuleIDPattern.MatchString(id) {
+			diags.AddAttributeError(
+				overridePath,
+				"Invalid OWASP rule override",
+				fmt.Sprintf("%q is not an OWASP core rule set rule ID. Rule IDs are six digit numbers starting with 9, such as \"942100\".", id),
+			)
+			continue
+		}
+		group, ok := firewallCRSRuleGroups[id[:3]]
+		if !ok {
+			continue
+		}
+		if config := groups[group]; config != nil && !config.Active.IsNull() && !config.Active.IsUnknown() && !config.Active.ValueBool() {
+			diags.AddAttributeWarning(
+				overridePath,
+				"OWASP rule override has no effect",
+				fmt.Sprintf("Rule %s is part of the %q group, which is not active, so the override will not change how requests are handled.", id, group),
+			)
+		}
+	}
+	return diags
+}
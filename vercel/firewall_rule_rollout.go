@@ -0,0 +1,182 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// A staged rule is deployed with the `log` action until it is promoted, so
+// that its matches can be reviewed before it starts blocking traffic. The
+// configured action is the intended action; effective_action is what is
+// actually deployed.
+
+var firewallRuleStagedRolloutAttrTypes = map[string]attr.Type{
+	"review_period": types.StringType,
+	"promote":       types.BoolType,
+}
+
+type firewallRuleStagedRollout struct {
+	ReviewPeriod types.String `tfsdk:"review_period"`
+	Promote      types.Bool   `tfsdk:"promote"`
+}
+
+func firewallRuleStagedRolloutAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Deploy the rule with the `log` action first and promote it to the configured action later. The rule is promoted once `review_period` has passed since it was staged, or as soon as `promote` is `true`. Promotion happens on the next apply, and the plan shows it as a change to `effective_action`.",
+		Optional:    true,
+		Attributes: map[string]schema.Attribute{
+			"review_period": schema.StringAttribute{
+				Description: "How long the rule is logged before it is promoted, such as `72h`. Without a review period, the rule is only promoted by `promote`.",
+				Optional:    true,
+				Validators: []validator.String{
+					validateDuration(),
+				},
+			},
+			"promote": schema.BoolAttribute{
+				Description: "Promote the rule to the configured action now, regardless of the review period.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func firewallRuleEffectiveActionAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "The action that is deployed. This is `log` while a staged rule waits for promotion, and the configured action otherwise.",
+		Computed:    true,
+	}
+}
+
+func firewallRuleStagedAtAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		Description: "When the rule was staged, as an RFC 3339 timestamp. The review period is counted from this time.",
+		Computed:    true,
+	}
+}
+
+// firewallRuleEffectiveAction works out the action to deploy for a rule at
+// the given time. It returns an unknown value when the inputs are unknown.
+func firewallRuleEffectiveAction(ctx context.Context, rule FirewallRule, stagedAt types.String, now time.Time) (types.String, diag.Diagnostics) {
+	if rule.Action.Action.IsUnknown() || rule.StagedRollout.IsUnknown() {
+		return types.StringUnknown(), nil
+	}
+	intended := rule.Action.Action.ValueString()
+	if rule.StagedRollout.IsNull() || intended == "log" {
+		return types.StringValue(intended), nil
+	}
+
+	var rollout firewallRuleStagedRollout
+	diags := rule.StagedRollout.As(ctx, &rollout, basetypes.ObjectAsOptions{})
+	if diags.HasError() {
+		return types.StringUnknown(), diags
+	}
+	if rollout.Promote.IsUnknown() || rollout.ReviewPeriod.IsUnknown() {
+		return types.StringUnknown(), diags
+	}
+	if rollout.Promote.ValueBool() {
+		return types.StringValue(intended), diags
+	}
+	if rollout.ReviewPeriod.IsNull() || stagedAt.IsNull() || stagedAt.IsUnknown() {
+		return types.StringValue("log"), diags
+	}
+
+	period, err := time.ParseDuration(rollout.ReviewPeriod.ValueString())
+	if err != nil {
+		diags.AddError("Invalid review period", err.Error())
+		return types.StringUnknown(), diags
+	}
+	staged, err := time.Parse(time.RFC3339, stagedAt.ValueString())
+	if err != nil {
+		diags.AddError("Invalid staged_at timestamp", err.Error())
+		return types.StringUnknown(), diags
+	}
+	if !now.Before(staged.Add(period)) {
+		return types.StringValue(intended), diags
+	}
+	return types.StringValue("log"), diags
+}
+
+// planFirewallRuleRollout fills in staged_at and effective_action for a
+// planned rule, based on the rule in state if there is one. It returns a
+// warning to show in the plan when the rule is about to be promoted.
+func planFirewallRuleRollout(ctx context.Context, rule *FirewallRule, prior *FirewallRule, now time.Time) (string, diag.Diagnostics) {
+	switch {
+	case rule.StagedRollout.IsNull():
+		rule.StagedAt = types.StringNull()
+	case prior != nil && !prior.StagedAt.IsNull() && !prior.StagedAt.IsUnknown():
+		rule.StagedAt = prior.StagedAt
+	default:
+		rule.StagedAt = types.StringValue(now.UTC().Format(time.RFC3339))
+	}
+
+	effective, diags := firewallRuleEffectiveAction(ctx, *rule, rule.StagedAt, now)
+	// Once promoted, a rule stays promoted for as long as it remains staged,
+	// even if the review period is later extended or promote is unset.
+	if firewallRulePromoted(prior) && !rule.StagedRollout.IsNull() && !rule.Action.Action.IsUnknown() {
+		effective = rule.Action.Action
+	}
+	rule.EffectiveAction = effective
+	if diags.HasError() || prior == nil || effective.IsUnknown() {
+		return "", diags
+	}
+	if prior.EffectiveAction.ValueString() == "log" && effective.ValueString() != "log" {
+		return fmt.Sprintf("Firewall rule %q will be promoted from log to %s.", rule.Name.ValueString(), effective.ValueString()), diags
+	}
+	return "", diags
+}
+
+// firewallRuleStagedAsLog reports whether a rule read from the API with the
+// given action is a staged rule that has not been promoted yet. Such a rule
+// keeps its intended action, so that it does not drift while it waits.
+func firewallRuleStagedAsLog(deployedAction string, ref FirewallRule) bool {
+	return !ref.StagedRollout.IsNull() &&
+		deployedAction == "log" &&
+		!ref.Action.Action.IsNull() &&
+		ref.Action.Action.ValueString() != "log"
+}
+
+func firewallRulePromoted(rule *FirewallRule) bool {
+	return rule != nil &&
+		!rule.StagedRollout.IsNull() &&
+		!rule.EffectiveAction.IsNull() &&
+		!rule.EffectiveAction.IsUnknown() &&
+		rule.EffectiveAction.ValueString() != "log"
+}
+
+// stagedAsLog reports whether the rule should be deployed with the log action
+// instead of its configured action.
+func (r *FirewallRule) stagedAsLog() bool {
+	if r.StagedRollout.IsNull() || r.Action.Action.ValueString() == "log" {
+		return false
+	}
+	effective := r.EffectiveAction
+	if effective.IsNull() || effective.IsUnknown() {
+		effective, _ = firewallRuleEffectiveAction(context.Background(), *r, r.StagedAt, time.Now())
+	}
+	return effective.ValueString() == "log"
+}
+
+// setFirewallRuleRolloutPlan plans the rollout of a rule and writes the
+// computed values to the plan under rulePath.
+func setFirewallRuleRolloutPlan(ctx context.Context, plan *tfsdk.Plan, rulePath path.Path, rule *FirewallRule, prior *FirewallRule, now time.Time) diag.Diagnostics {
+	warning, diags := planFirewallRuleRollout(ctx, rule, prior, now)
+	if diags.HasError() {
+		return diags
+	}
+	diags.Append(plan.SetAttribute(ctx, rulePath.AtName("effective_action"), rule.EffectiveAction)...)
+	diags.Append(plan.SetAttribute(ctx, rulePath.AtName("staged_at"), rule.StagedAt)...)
+	if warning != "" {
+		diags.AddAttributeWarning(rulePath.AtName("staged_rollout"), "Firewall rule promotion", warning)
+	}
+	return diags
+}
@@ -0,0 +1,202 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"regexp"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// The widest rate limit bounds the API accepts. Plans with lower limits are
+// still rejected by the API when the config is applied.
+const (
+	firewallRateLimitMinWindow = 10
+	firewallRateLimitMaxWindow = 3600
+	firewallRateLimitMinLimit  = 1
+	firewallRateLimitMaxLimit  = 10_000_000
+)
+
+var firewallRateLimitAlgorithms = []string{"fixed_window", "token_bucket"}
+
+// firewallConditionKeyTypes are the condition types that match a named part
+// of the request, and so need a key.
+var firewallConditionKeyTypes = map[string]string{
+	"header": "header",
+	"cookie": "cookie",
+	"query":  "query parameter",
+}
+
+// validateFirewallRule checks the parts of a rule that the schema cannot: that
+// conditions make sense for their type and operator, and that the action has
+// the settings it needs. Diagnostics are reported against the attribute at
+// fault, under rulePath.
+func validateFirewallRule(ctx context.Context, rulePath path.Path, rule FirewallRule) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for i, group := range rule.ConditionGroup {
+		for j, condition := range group.Conditions {
+			conditionPath := rulePath.AtName("condition_group").AtListIndex(i).AtName("conditions").AtListIndex(j)
+			diags.Append(validateFirewallCondition(conditionPath, condition)...)
+		}
+	}
+	diags.Append(validateFirewallAction(ctx, rulePath.AtName("action"), rule.Action)...)
+	return diags
+}
+
+func validateFirewallCondition(conditionPath path.Path, condition Condition) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if condition.Type.IsUnknown() || condition.Op.IsUnknown() {
+		return diags
+	}
+	conditionType, op := condition.Type.ValueString(), condition.Op.ValueString()
+
+	if name, ok := firewallConditionKeyTypes[conditionType]; ok && condition.Key.IsNull() {
+		diags.AddAttributeError(
+			conditionPath.AtName("key"),
+			"Missing firewall condition key",
+			fmt.Sprintf("Conditions of type %q must set `key` to the name of the %s to match.", conditionType, name),
+		)
+	}
+
+	switch {
+	case isListOp(op):
+		if condition.Values.IsNull() {
+			diags.AddAttributeError(
+				conditionPath.AtName("values"),
+				"Missing firewall condition values",
+				fmt.Sprintf("The %q operator matches against a list, so `values` must be set instead of `value`.", op),
+			)
+		}
+	case !condition.Values.IsNull():
+		diags.AddAttributeError(
+			conditionPath.AtName("values"),
+			"Invalid firewall condition values",
+			fmt.Sprintf("`values` can only be used with the `inc` and `ninc` operators. Use `value` with the %q operator.", op),
+		)
+	case !isExistenceOp(op) && condition.Value.IsNull():
+		diags.AddAttributeError(
+			conditionPath.AtName("value"),
+			"Missing firewall condition value",
+			fmt.Sprintf("The %q operator requires `value` to be set.", op),
+		)
+	}
+
+	// The firewall accepts syntax, such as lookarounds and backreferences, that
+	// Go's RE2 engine does not, so an expression RE2 rejects is only a warning.
+	if op == "re" && !condition.Value.IsNull() && !condition.Value.IsUnknown() {
+		if _, err := regexp.Compile(condition.Value.ValueString()); err != nil {
+			diags.AddAttributeWarning(
+				conditionPath.AtName("value"),
+				"Possibly invalid firewall condition regular expression",
+				fmt.Sprintf("The value of a condition using the \"re\" operator could not be parsed as an RE2 regular expression, so it may be rejected when the rule is applied: %s", err),
+			)
+		}
+	}
+
+	if conditionType == "ip_address" && (op == "eq" || op == "neq" || isListOp(op)) {
+		diags.Append(validateFirewallIPString(conditionPath.AtName("value"), condition.Value)...)
+		if !condition.Values.IsNull() && !condition.Values.IsUnknown() {
+			for k, element := range condition.Values.Elements() {
+				if value, ok := element.(types.String); ok {
+					diags.Append(validateFirewallIPString(conditionPath.AtName("values").AtListIndex(k), value)...)
+				}
+			}
+		}
+	}
+	return diags
+}
+
+// validateFirewallIPString checks that a value is an IP address or a CIDR
+// range. Null and unknown values are left to other checks.
+func validateFirewallIPString(valuePath path.Path, value types.String) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if value.IsNull() || value.IsUnknown() {
+		return diags
+	}
+	if net.ParseIP(value.ValueString()) != nil {
+		return diags
+	}
+	if _, _, err := net.ParseCIDR(value.ValueString()); err != nil {
+		diags.AddAttributeError(
+			valuePath,
+			"Invalid IP address",
+			fmt.Sprintf("%q is not a valid IP address or CIDR range, such as 192.0.2.1 or 192.0.2.0/24.", value.ValueString()),
+		)
+	}
+	return diags
+}
+
+func validateFirewallAction(ctx context.Context, actionPath path.Path, action Mitigate) diag.Diagnostics {
+	var diags diag.Diagnostics
+	if action.Action.IsUnknown() {
+		return diags
+	}
+
+	switch action.Action.ValueString() {
+	case "rate_limit":
+		if action.RateLimit.IsNull() {
+			diags.AddAttributeError(
+				actionPath.AtName("rate_limit"),
+				"Missing firewall rate limit",
+				"Rules with the `rate_limit` action must set `rate_limit`.",
+			)
+		}
+	case "redirect":
+		if action.Redirect.IsNull() {
+			diags.AddAttributeError(
+				actionPath.AtName("redirect"),
+				"Missing firewall redirect",
+				"Rules with the `redirect` action must set `redirect`.",
+			)
+		}
+	}
+
+	if action.RateLimit.IsNull() || action.RateLimit.IsUnknown() {
+		return diags
+	}
+	var rateLimit RateLimit
+	diags.Append(action.RateLimit.As(ctx, &rateLimit, basetypes.ObjectAsOptions{})...)
+	if diags.HasError() {
+		return diags
+	}
+	rateLimitPath := actionPath.AtName("rate_limit")
+
+	if !rateLimit.Algo.IsNull() && !rateLimit.Algo.IsUnknown() && !slices.Contains(firewallRateLimitAlgorithms, rateLimit.Algo.ValueString()) {
+		diags.AddAttributeError(
+			rateLimitPath.AtName("algo"),
+			"Invalid firewall rate limit algorithm",
+			fmt.Sprintf("The rate limit algorithm must be one of %q, got %q.", firewallRateLimitAlgorithms, rateLimit.Algo.ValueString()),
+		)
+	}
+	if !rateLimit.Window.IsNull() && !rateLimit.Window.IsUnknown() {
+		if window := rateLimit.Window.ValueInt64(); window < firewallRateLimitMinWindow || window > firewallRateLimitMaxWindow {
+			diags.AddAttributeError(
+				rateLimitPath.AtName("window"),
+				"Invalid firewall rate limit window",
+				fmt.Sprintf("The rate limit window must be between %d and %d seconds, got %d.", firewallRateLimitMinWindow, firewallRateLimitMaxWindow, window),
+			)
+		}
+	}
+	if !rateLimit.Limit.IsNull() && !rateLimit.Limit.IsUnknown() {
+		if limit := rateLimit.Limit.ValueInt64(); limit < firewallRateLimitMinLimit || limit > firewallRateLimitMaxLimit {
+			diags.AddAttributeError(
+				rateLimitPath.AtName("limit"),
+				"Invalid firewall rate limit",
+				fmt.Sprintf("The rate limit must be between %d and %d requests, got %d.", firewallRateLimitMinLimit, firewallRateLimitMaxLimit, limit),
+			)
+		}
+	}
+	if !rateLimit.Keys.IsNull() && !rateLimit.Keys.IsUnknown() && len(rateLimit.Keys.Elements()) == 0 {
+		diags.AddAttributeError(
+			rateLimitPath.AtName("keys"),
+			"Missing firewall rate limit keys",
+			"The rate limit must set at least one key to bucket clients by, such as \"ip\".",
+		)
+	}
+	return diags
+}
@@ -0,0 +1,513 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var _ function.Function = &evaluateFeatureFlagFunction{}
+
+func newEvaluateFeatureFlagFunction() function.Function {
+	return &evaluateFeatureFlagFunction{}
+}
+
+type evaluateFeatureFlagFunction struct{}
+
+var featureFlagEvaluationResultAttrTypes = map[string]attr.Type{
+	"variant_id": types.StringType,
+	"reason":     types.StringType,
+	"rule_index": types.Int64Type,
+}
+
+// featureFlagEvaluationConfig is the subset of a vercel_feature_flag_config
+// that the function reads.
+type featureFlagEvaluationConfig struct {
+	Production  featureFlagConfigEnvironmentModel `tfsdk:"production"`
+	Preview     featureFlagConfigEnvironmentModel `tfsdk:"preview"`
+	Development featureFlagConfigEnvironmentModel `tfsdk:"development"`
+}
+
+// featureFlagEvaluationSegmentIgnoredAttributes are the attributes of a
+// vercel_feature_flag_segment that do not affect evaluation, so that segment
+// resources can be passed to the function as they are.
+var featureFlagEvaluationSegmentIgnoredAttributes = []string{"project_id", "team_id", "slug", "name", "description", "hint"}
+
+func (f *evaluateFeatureFlagFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "evaluate_feature_flag"
+}
+
+func (f *evaluateFeatureFlagFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Evaluate a feature flag config for a set of entities without calling the API.",
+		MarkdownDescription: `
+Resolves which variant a ` + "`vercel_feature_flag_config`" + ` serves to a set of entities, without calling the Vercel API. Use it in ` + "`check`" + ` blocks or tests to assert that a rollout behaves as intended before it is applied, for example that a user in a segment gets a specific variant.
+
+A paused environment serves its ` + "`disabled_variant_id`" + `. Otherwise, individually targeted entities are checked first, then the rules in order, and finally the default outcome.
+
+The result has the resolved ` + "`variant_id`" + `, the ` + "`reason`" + ` it was chosen (` + "`paused`, `target_match`, `rule_match` or `fallthrough`" + `), and the zero-based ` + "`rule_index`" + ` of the rule that matched, which is null when no rule decided the outcome.
+
+Segments are objects with an ` + "`id`" + `, optional ` + "`include`" + ` and ` + "`exclude`" + ` lists, and optional ` + "`rules`" + `. Each segment rule has ` + "`conditions`" + `, in the same form as the rules of ` + "`vercel_feature_flag_config`" + `, and an optional ` + "`split`" + ` of ` + "`entity`" + `, ` + "`attribute`" + ` and ` + "`percentage`" + ` to include only a percentage of the matching entities. Any other segment attribute is rejected rather than ignored.
+
+~> Which bucket of a weighted split or segment percentage an entity falls in is decided by Vercel, and cannot be reproduced offline. The function returns an error when such a bucket decides the result, unless the outcome is the same for every bucket, such as a split with a single weighted variant or a percentage of 0 or 100. The flag's seed is not used.
+`,
+		Parameters: []function.Parameter{
+			function.ObjectParameter{
+				Name:           "config",
+				Description:    "The feature flag config to evaluate, typically a `vercel_feature_flag_config` resource.",
+				AttributeTypes: map[string]attr.Type{"production": featureFlagConfigEnvironmentAttrType, "preview": featureFlagConfigEnvironmentAttrType, "development": featureFlagConfigEnvironmentAttrType},
+			},
+			function.StringParameter{
+				Name:        "environment",
+				Description: "The environment to evaluate: `production`, `preview` or `development`.",
+			},
+			function.DynamicParameter{
+				Name:        "entities",
+				Description: "The evaluation context, as an object of entity types to their attributes. For example `{ user = { id = \"user_1\", email = \"alice@example.com\" } }`.",
+			},
+			function.DynamicParameter{
+				Name:        "segments",
+				Description: "The segments referenced by the config's rules, typically `vercel_feature_flag_segment` resources.",
+			},
+		},
+		Return: function.ObjectReturn{
+			AttributeTypes: featureFlagEvaluationResultAttrTypes,
+		},
+	}
+}
+
+func (f *evaluateFeatureFlagFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var config featureFlagEvaluationConfig
+	var environment string
+	var entities types.Dynamic
+	var segments types.Dynamic
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &config, &environment, &entities, &segments))
+	if resp.Error != nil {
+		return
+	}
+
+	if !slices.Contains(featureFlagEnvironmentNames, environment) {
+		resp.Error = function.NewArgumentFuncError(1, fmt.Sprintf("Invalid environment %q, must be one of %s.", environment, strings.Join(featureFlagEnvironmentNames, ", ")))
+		return
+	}
+
+	environments, diags := featureFlagConfigEnvironmentsToClient(ctx, map[string]featureFlagConfigEnvironmentModel{
+		"production":  config.Production,
+		"preview":     config.Preview,
+		"development": config.Development,
+	})
+	if diags.HasError() {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid feature flag config: "+featureFlagDiagnosticsSummary(diags))
+		return
+	}
+
+	evaluationContext, err := featureFlagEvaluationEntities(entities)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(2, "Invalid entities: "+err.Error())
+		return
+	}
+
+	clientSegments, err := featureFlagEvaluationSegments(ctx, segments)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(3, "Invalid segments: "+err.Error())
+		return
+	}
+
+	evaluation, err := client.EvaluateFeatureFlag(client.FeatureFlagEvaluationRequest{
+		Environments: environments,
+		Environment:  environment,
+		Entities:     evaluationContext,
+		Segments:     clientSegments,
+	})
+	if err != nil {
+		resp.Error = function.NewFuncError("Could not evaluate feature flag: " + err.Error())
+		return
+	}
+
+	ruleIndex := types.Int64Null()
+	if evaluation.RuleIndex >= 0 {
+		ruleIndex = types.Int64Value(int64(evaluation.RuleIndex))
+	}
+	result, diags := types.ObjectValue(featureFlagEvaluationResultAttrTypes, map[string]attr.Value{
+		"variant_id": types.StringValue(evaluation.VariantID),
+		"reason":     types.StringValue(evaluation.Reason),
+		"rule_index": ruleIndex,
+	})
+	resp.Error = function.FuncErrorFromDiags(ctx, diags)
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = resp.Result.Set(ctx, result)
+}
+
+// featureFlagDiagnosticsSummary flattens validation errors into a single
+// function error message.
+func featureFlagDiagnosticsSummary(diags diag.Diagnostics) string {
+	var messages []string
+	for _, d := range diags.Errors() {
+		message := d.Detail()
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok && !withPath.Path().Equal(path.Empty()) {
+			message = withPath.Path().String() + ": " + message
+		}
+		messages = append(messages, message)
+	}
+	return strings.Join(messages, " ")
+}
+
+// featureFlagEvaluationEntities converts the entities argument into the
+// entity kind to attributes map used by the evaluator.
+func featureFlagEvaluationEntities(entities types.Dynamic) (map[string]map[string]any, error) {
+	value, err := featureFlagEvaluationValue(entities.UnderlyingValue())
+	if err != nil {
+		return nil, err
+	}
+	kinds, ok := value.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("expected an object of entity types to their attributes")
+	}
+
+	out := make(map[string]map[string]any, len(kinds))
+	for kind, attributes := range kinds {
+		attributeMap, ok := attributes.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected %s to be an object of attributes", kind)
+		}
+		out[kind] = attributeMap
+	}
+	return out, nil
+}
+
+func featureFlagEvaluationValue(value attr.Value) (any, error) {
+	if value == nil || value.IsNull() {
+		return nil, nil
+	}
+	switch v := value.(type) {
+	case types.String:
+		return v.ValueString(), nil
+	case types.Bool:
+		return v.ValueBool(), nil
+	case types.Number:
+		f, _ := v.ValueBigFloat().Float64()
+		return f, nil
+	case types.Int64:
+		return float64(v.ValueInt64()), nil
+	case types.Float64:
+		return v.ValueFloat64(), nil
+	case types.Dynamic:
+		return featureFlagEvaluationValue(v.UnderlyingValue())
+	case types.Object:
+		return featureFlagEvaluationMap(v.Attributes())
+	case types.Map:
+		return featureFlagEvaluationMap(v.Elements())
+	case types.List:
+		return featureFlagEvaluationList(v.Elements())
+	case types.Tuple:
+		return featureFlagEvaluationList(v.Elements())
+	case types.Set:
+		return featureFlagEvaluationList(v.Elements())
+	default:
+		return nil, fmt.Errorf("unsupported value %s", value)
+	}
+}
+
+func featureFlagEvaluationMap(elements map[string]attr.Value) (map[string]any, error) {
+	out := make(map[string]any, len(elements))
+	for key, element := range elements {
+		value, err := featureFlagEvaluationValue(element)
+		if err != nil {
+			return nil, err
+		}
+		out[key] = value
+	}
+	return out, nil
+}
+
+func featureFlagEvaluationList(elements []attr.Value) ([]any, error) {
+	out := make([]any, 0, len(elements))
+	for _, element := range elements {
+		value, err := featureFlagEvaluationValue(element)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, value)
+	}
+	return out, nil
+}
+
+// featureFlagEvaluationSegments converts the segments argument into the
+// segments used by the evaluator.
+func featureFlagEvaluationSegments(ctx context.Context, segments types.Dynamic) ([]client.FeatureFlagSegment, error) {
+	value, err := featureFlagEvaluationValue(segments.UnderlyingValue())
+	if err != nil {
+		return nil, err
+	}
+	if value == nil {
+		return nil, nil
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a list of segments")
+	}
+
+	out := make([]client.FeatureFlagSegment, 0, len(list))
+	for i, element := range list {
+		segment, err := featureFlagEvaluationSegment(ctx, element)
+		if err != nil {
+			return nil, fmt.Errorf("segment %d: %w", i, err)
+		}
+		out = append(out, segment)
+	}
+	return out, nil
+}
+
+func featureFlagEvaluationSegment(ctx context.Context, value any) (client.FeatureFlagSegment, error) {
+	attributes, ok := value.(map[string]any)
+	if !ok {
+		return client.FeatureFlagSegment{}, fmt.Errorf("expected an object")
+	}
+	if err := featureFlagEvaluationAttributes(attributes, []string{"id", "include", "exclude", "rules"}, featureFlagEvaluationSegmentIgnoredAttributes); err != nil {
+		return client.FeatureFlagSegment{}, err
+	}
+
+	id, ok := attributes["id"].(string)
+	if !ok || id == "" {
+		return client.FeatureFlagSegment{}, fmt.Errorf("id is required")
+	}
+	segment := client.FeatureFlagSegment{ID: id}
+
+	var err error
+	if segment.Data.Include, err = featureFlagEvaluationMatches(attributes["include"]); err != nil {
+		return segment, fmt.Errorf("include: %w", err)
+	}
+	if segment.Data.Exclude, err = featureFlagEvaluationMatches(attributes["exclude"]); err != nil {
+		return segment, fmt.Errorf("exclude: %w", err)
+	}
+
+	rules, err := featureFlagEvaluationObjects(attributes["rules"])
+	if err != nil {
+		return segment, fmt.Errorf("rules: %w", err)
+	}
+	for i, rule := range rules {
+		converted, err := featureFlagEvaluationSegmentRule(ctx, rule)
+		if err != nil {
+			return segment, fmt.Errorf("rule %d: %w", i, err)
+		}
+		segment.Data.Rules = append(segment.Data.Rules, converted)
+	}
+	return segment, nil
+}
+
+// featureFlagEvaluationSegmentRule converts a segment rule. A rule without a
+// split includes every entity that matches its conditions.
+func featureFlagEvaluationSegmentRule(ctx context.Context, rule map[string]any) (client.FeatureFlagSegmentRule, error) {
+	out := client.FeatureFlagSegmentRule{Outcome: client.FeatureFlagSegmentOutcome{Type: "all"}}
+	if err := featureFlagEvaluationAttributes(rule, []string{"conditions", "split"}, nil); err != nil {
+		return out, err
+	}
+
+	conditions, err := featureFlagEvaluationObjects(rule["conditions"])
+	if err != nil {
+		return out, fmt.Errorf("conditions: %w", err)
+	}
+	if len(conditions) == 0 {
+		return out, fmt.Errorf("at least one condition is required")
+	}
+	for i, condition := range conditions {
+		model, err := featureFlagEvaluationConditionModel(condition)
+		if err != nil {
+			return out, fmt.Errorf("condition %d: %w", i, err)
+		}
+		converted, diags := featureFlagConditionToClient(ctx, path.Root("conditions").AtListIndex(i), model)
+		if diags.HasError() {
+			return out, fmt.Errorf("%s", featureFlagDiagnosticsSummary(diags))
+		}
+		out.Conditions = append(out.Conditions, converted)
+	}
+
+	if rule["split"] == nil {
+		return out, nil
+	}
+	split, ok := rule["split"].(map[string]any)
+	if !ok {
+		return out, fmt.Errorf("split: expected an object")
+	}
+	if err := featureFlagEvaluationAttributes(split, []string{"entity", "attribute", "percentage"}, nil); err != nil {
+		return out, fmt.Errorf("split: %w", err)
+	}
+	entity, _ := split["entity"].(string)
+	attribute, _ := split["attribute"].(string)
+	percentage, ok := split["percentage"].(float64)
+	if entity == "" || attribute == "" || !ok {
+		return out, fmt.Errorf("split: entity, attribute and percentage are required")
+	}
+	if percentage < 0 || percentage > 100 {
+		return out, fmt.Errorf("split: percentage must be between 0 and 100, got %g", percentage)
+	}
+	passPromille := percentage * 10
+	out.Outcome = client.FeatureFlagSegmentOutcome{
+		Type:         "split",
+		Base:         &client.FeatureFlagSegmentConditionLHS{Type: "entity", Kind: entity, Attribute: attribute},
+		PassPromille: &passPromille,
+	}
+	return out, nil
+}
+
+// featureFlagEvaluationConditionModel reads a condition into the model used by
+// vercel_feature_flag_config, so that it is validated in the same way.
+func featureFlagEvaluationConditionModel(condition map[string]any) (featureFlagConditionModel, error) {
+	if err := featureFlagEvaluationAttributes(condition, []string{"entity", "attribute", "segment_ids", "operator", "value", "values"}, nil); err != nil {
+		return featureFlagConditionModel{}, err
+	}
+	operator, ok := condition["operator"].(string)
+	if !ok {
+		return featureFlagConditionModel{}, fmt.Errorf("operator is required")
+	}
+	if _, ok := featureFlagConditionOperators[operator]; !ok {
+		return featureFlagConditionModel{}, fmt.Errorf("invalid operator %q, must be one of %s", operator, strings.Join(featureFlagConditionOperatorNames(), ", "))
+	}
+
+	model := featureFlagConditionModel{Operator: types.StringValue(operator)}
+	model.Entity = featureFlagEvaluationStringValue(condition["entity"])
+	model.Attribute = featureFlagEvaluationStringValue(condition["attribute"])
+	model.Value = featureFlagEvaluationStringValue(condition["value"])
+	var err error
+	if model.SegmentIDs, err = featureFlagEvaluationStringSet(condition["segment_ids"]); err != nil {
+		return model, fmt.Errorf("segment_ids: %w", err)
+	}
+	if model.Values, err = featureFlagEvaluationStringSet(condition["values"]); err != nil {
+		return model, fmt.Errorf("values: %w", err)
+	}
+	return model, nil
+}
+
+func featureFlagEvaluationMatches(value any) (map[string]map[string][]client.FeatureFlagSegmentValue, error) {
+	matches, err := featureFlagEvaluationObjects(value)
+	if err != nil || len(matches) == 0 {
+		return nil, err
+	}
+
+	out := map[string]map[string][]client.FeatureFlagSegmentValue{}
+	for _, match := range matches {
+		if err := featureFlagEvaluationAttributes(match, []string{"entity", "attribute", "values"}, nil); err != nil {
+			return nil, err
+		}
+		entity, _ := match["entity"].(string)
+		attribute, _ := match["attribute"].(string)
+		if entity == "" || attribute == "" {
+			return nil, fmt.Errorf("entity and attribute are required")
+		}
+		values, err := featureFlagEvaluationStrings(match["values"])
+		if err != nil {
+			return nil, fmt.Errorf("values: %w", err)
+		}
+		if out[entity] == nil {
+			out[entity] = map[string][]client.FeatureFlagSegmentValue{}
+		}
+		for _, v := range values {
+			out[entity][attribute] = append(out[entity][attribute], client.FeatureFlagSegmentValue{Value: v})
+		}
+	}
+	return out, nil
+}
+
+// featureFlagEvaluationAttributes returns an error for any attribute that is
+// neither supported nor ignored, so that features the evaluator does not model
+// are reported instead of silently changing the result.
+func featureFlagEvaluationAttributes(attributes map[string]any, supported, ignored []string) error {
+	var unsupported []string
+	for name, value := range attributes {
+		if value == nil || slices.Contains(supported, name) || slices.Contains(ignored, name) {
+			continue
+		}
+		unsupported = append(unsupported, name)
+	}
+	if len(unsupported) == 0 {
+		return nil
+	}
+	sort.Strings(unsupported)
+	return fmt.Errorf("unsupported attributes %s, expected %s", strings.Join(unsupported, ", "), strings.Join(supported, ", "))
+}
+
+func featureFlagEvaluationObjects(value any) ([]map[string]any, error) {
+	if value == nil {
+		return nil, nil
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a list of objects")
+	}
+	out := make([]map[string]any, 0, len(list))
+	for _, element := range list {
+		object, ok := element.(map[string]any)
+		if !ok {
+			return nil, fmt.Errorf("expected a list of objects")
+		}
+		out = append(out, object)
+	}
+	return out, nil
+}
+
+func featureFlagEvaluationStrings(value any) ([]string, error) {
+	if value == nil {
+		return nil, nil
+	}
+	list, ok := value.([]any)
+	if !ok {
+		return nil, fmt.Errorf("expected a list of values")
+	}
+	out := make([]string, 0, len(list))
+	for _, element := range list {
+		if element == nil {
+			return nil, fmt.Errorf("values must not be null")
+		}
+		out = append(out, featureFlagEvaluationStringValue(element).ValueString())
+	}
+	return out, nil
+}
+
+func featureFlagEvaluationStringSet(value any) (types.Set, error) {
+	if value == nil {
+		return types.SetNull(types.StringType), nil
+	}
+	values, err := featureFlagEvaluationStrings(value)
+	if err != nil {
+		return types.SetNull(types.StringType), err
+	}
+	elements := make([]attr.Value, 0, len(values))
+	for _, v := range values {
+		elements = append(elements, types.StringValue(v))
+	}
+	set, diags := types.SetValue(types.StringType, elements)
+	if diags.HasError() {
+		return set, fmt.Errorf("%s", featureFlagDiagnosticsSummary(diags))
+	}
+	return set, nil
+}
+
+// featureFlagEvaluationStringValue accepts numbers and booleans as well as
+// strings, as HCL does for string attributes.
+func featureFlagEvaluationStringValue(value any) types.String {
+	switch v := value.(type) {
+	case nil:
+		return types.StringNull()
+	case string:
+		return types.StringValue(v)
+	case float64:
+		return types.StringValue(strconv.FormatFloat(v, 'f', -1, 64))
+	case bool:
+		return types.StringValue(strconv.FormatBool(v))
+	default:
+		return types.StringValue(fmt.Sprint(v))
+	}
+}
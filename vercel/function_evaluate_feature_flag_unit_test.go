@@ -0,0 +1,288 @@
+package vercel
+
+import (
+	"context"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var featureFlagSegmentMatchAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"entity":    types.StringType,
+		"attribute": types.StringType,
+		"values":    types.SetType{ElemType: types.StringType},
+	},
+}
+
+// testFeatureFlagBetaSegment has the shape of a vercel_feature_flag_segment
+// resource, including the attributes that do not affect evaluation.
+func testFeatureFlagBetaSegment(t *testing.T) types.Object {
+	t.Helper()
+	return typesObjectValueMust(t, map[string]attr.Type{
+		"id":          types.StringType,
+		"project_id":  types.StringType,
+		"team_id":     types.StringType,
+		"slug":        types.StringType,
+		"name":        types.StringType,
+		"description": types.StringType,
+		"hint":        types.StringType,
+		"include":     types.SetType{ElemType: featureFlagSegmentMatchAttrType},
+		"exclude":     types.SetType{ElemType: featureFlagSegmentMatchAttrType},
+	}, map[string]attr.Value{
+		"id":          types.StringValue("seg_beta"),
+		"project_id":  types.StringValue("prj_123"),
+		"team_id":     types.StringValue("team_123"),
+		"slug":        types.StringValue("beta"),
+		"name":        types.StringValue("Beta"),
+		"description": types.StringNull(),
+		"hint":        types.StringNull(),
+		"include": types.SetValueMust(featureFlagSegmentMatchAttrType, []attr.Value{
+			typesObjectValueMust(t, featureFlagSegmentMatchAttrType.AttrTypes, map[string]attr.Value{
+				"entity":    types.StringValue("user"),
+				"attribute": types.StringValue("email"),
+				"values":    testFeatureFlagStringSet("alice@example.com"),
+			}),
+		}),
+		"exclude": types.SetNull(featureFlagSegmentMatchAttrType),
+	})
+}
+
+func runEvaluateFeatureFlag(t *testing.T, config featureFlagEvaluationConfig, environment string, entities map[string]map[string]attr.Value) function.RunResponse {
+	t.Helper()
+	beta := testFeatureFlagBetaSegment(t)
+	return runEvaluateFeatureFlagWithSegments(t, config, environment, entities, types.TupleValueMust([]attr.Type{beta.Type(context.Background())}, []attr.Value{beta}))
+}
+
+func runEvaluateFeatureFlagWithSegments(t *testing.T, config featureFlagEvaluationConfig, environment string, entities map[string]map[string]attr.Value, segments attr.Value) function.RunResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	configValue, diags := types.ObjectValueFrom(ctx, map[string]attr.Type{
+		"production":  featureFlagConfigEnvironmentAttrType,
+		"preview":     featureFlagConfigEnvironmentAttrType,
+		"development": featureFlagConfigEnvironmentAttrType,
+	}, config)
+	if diags.HasError() {
+		t.Fatalf("config diags = %v", diags)
+	}
+
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ObjectUnknown(featureFlagEvaluationResultAttrTypes)),
+	}
+	(&evaluateFeatureFlagFunction{}).Run(ctx, function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			configValue,
+			types.StringValue(environment),
+			types.DynamicValue(testFeatureFlagEntities(t, entities)),
+			types.DynamicValue(segments),
+		}),
+	}, &resp)
+	return resp
+}
+
+func testFeatureFlagEntities(t *testing.T, entities map[string]map[string]attr.Value) types.Object {
+	t.Helper()
+	ctx := context.Background()
+	kinds := map[string]attr.Type{}
+	kindValues := map[string]attr.Value{}
+	for kind, attributes := range entities {
+		attributeTypes := map[string]attr.Type{}
+		for name, value := range attributes {
+			attributeTypes[name] = value.Type(ctx)
+		}
+		object := typesObjectValueMust(t, attributeTypes, attributes)
+		kinds[kind] = object.Type(ctx)
+		kindValues[kind] = object
+	}
+	return typesObjectValueMust(t, kinds, kindValues)
+}
+
+func typesObjectValueMust(t *testing.T, attributeTypes map[string]attr.Type, attributes map[string]attr.Value) types.Object {
+	t.Helper()
+	object, diags := types.ObjectValue(attributeTypes, attributes)
+	if diags.HasError() {
+		t.Fatalf("object diags = %v", diags)
+	}
+	return object
+}
+
+func TestEvaluateFeatureFlagFunction(t *testing.T) {
+	paused := testFeatureFlagEnvironment()
+	paused.Enabled = types.BoolValue(false)
+	config := featureFlagEvaluationConfig{
+		Production:  testFeatureFlagRolloutEnvironment(t),
+		Preview:     testFeatureFlagEnvironment(),
+		Development: paused,
+	}
+
+	tests := map[string]struct {
+		environment string
+		entities    map[string]map[string]attr.Value
+		variantID   string
+		reason      string
+		ruleIndex   types.Int64
+	}{
+		"segment rule": {
+			environment: "production",
+			entities:    map[string]map[string]attr.Value{"user": {"id": types.StringValue("alice"), "email": types.StringValue("alice@example.com")}},
+			variantID:   "treatment",
+			reason:      "rule_match",
+			ruleIndex:   types.Int64Value(0),
+		},
+		"target": {
+			environment: "production",
+			entities:    map[string]map[string]attr.Value{"user": {"id": types.StringValue("user_1")}},
+			variantID:   "treatment",
+			reason:      "target_match",
+			ruleIndex:   types.Int64Null(),
+		},
+		"fallthrough": {
+			environment: "preview",
+			entities:    map[string]map[string]attr.Value{"user": {"id": types.StringValue("bob"), "age": types.NumberNull()}},
+			variantID:   "control",
+			reason:      "fallthrough",
+			ruleIndex:   types.Int64Null(),
+		},
+		"paused": {
+			environment: "development",
+			entities:    map[string]map[string]attr.Value{"user": {"id": types.StringValue("bob")}},
+			variantID:   "control",
+			reason:      "paused",
+			ruleIndex:   types.Int64Null(),
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			resp := runEvaluateFeatureFlag(t, config, tt.environment, tt.entities)
+			if resp.Error != nil {
+				t.Fatalf("Run() error = %v", resp.Error)
+			}
+			result, ok := resp.Result.Value().(types.Object)
+			if !ok {
+				t.Fatalf("result = %T, want types.Object", resp.Result.Value())
+			}
+			attributes := result.Attributes()
+			if got := attributes["variant_id"].(types.String).ValueString(); got != tt.variantID {
+				t.Errorf("variant_id = %q, want %q", got, tt.variantID)
+			}
+			if got := attributes["reason"].(types.String).ValueString(); got != tt.reason {
+				t.Errorf("reason = %q, want %q", got, tt.reason)
+			}
+			if got := attributes["rule_index"]; !got.Equal(tt.ruleIndex) {
+				t.Errorf("rule_index = %s, want %s", got, tt.ruleIndex)
+			}
+		})
+	}
+}
+
+func TestEvaluateFeatureFlagFunctionInvalid(t *testing.T) {
+	invalid := testFeatureFlagEnvironment()
+	invalid.DefaultVariantID = types.StringNull()
+	config := featureFlagEvaluationConfig{
+		Production:  invalid,
+		Preview:     testFeatureFlagEnvironment(),
+		Development: testFeatureFlagEnvironment(),
+	}
+
+	resp := runEvaluateFeatureFlag(t, config, "production", map[string]map[string]attr.Value{"user": {"id": types.StringValue("bob")}})
+	if resp.Error == nil {
+		t.Fatal("Run() succeeded, want error for an invalid config")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 0 {
+		t.Errorf("error argument = %v, want the config argument", resp.Error.FunctionArgument)
+	}
+
+	resp = runEvaluateFeatureFlag(t, featureFlagEvaluationConfig{
+		Production:  testFeatureFlagEnvironment(),
+		Preview:     testFeatureFlagEnvironment(),
+		Development: testFeatureFlagEnvironment(),
+	}, "staging", map[string]map[string]attr.Value{"user": {"id": types.StringValue("bob")}})
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 1 {
+		t.Errorf("Run() error = %v, want an error for the environment argument", resp.Error)
+	}
+}
+
+// testFeatureFlagObject builds an object value the way HCL does, inferring the
+// attribute types from the values.
+func testFeatureFlagObject(t *testing.T, attributes map[string]attr.Value) types.Object {
+	t.Helper()
+	attributeTypes := map[string]attr.Type{}
+	for name, value := range attributes {
+		attributeTypes[name] = value.Type(context.Background())
+	}
+	return typesObjectValueMust(t, attributeTypes, attributes)
+}
+
+func testFeatureFlagTuple(values ...attr.Value) types.Tuple {
+	elementTypes := make([]attr.Type, 0, len(values))
+	for _, value := range values {
+		elementTypes = append(elementTypes, value.Type(context.Background()))
+	}
+	return types.TupleValueMust(elementTypes, values)
+}
+
+func TestEvaluateFeatureFlagFunctionSegmentRules(t *testing.T) {
+	config := featureFlagEvaluationConfig{
+		Production:  testFeatureFlagRolloutEnvironment(t),
+		Preview:     testFeatureFlagEnvironment(),
+		Development: testFeatureFlagEnvironment(),
+	}
+	entities := map[string]map[string]attr.Value{"user": {"id": types.StringValue("bob"), "country": types.StringValue("NL")}}
+	segment := func(rule map[string]attr.Value, extra map[string]attr.Value) types.Tuple {
+		rule["conditions"] = testFeatureFlagTuple(testFeatureFlagObject(t, map[string]attr.Value{
+			"entity":    types.StringValue("user"),
+			"attribute": types.StringValue("country"),
+			"operator":  types.StringValue("oneOf"),
+			"values":    testFeatureFlagTuple(types.StringValue("NL"), types.StringValue("DE")),
+		}))
+		attributes := map[string]attr.Value{
+			"id":    types.StringValue("seg_beta"),
+			"rules": testFeatureFlagTuple(testFeatureFlagObject(t, rule)),
+		}
+		for name, value := range extra {
+			attributes[name] = value
+		}
+		return testFeatureFlagTuple(testFeatureFlagObject(t, attributes))
+	}
+	split := func(percentage float64) map[string]attr.Value {
+		return map[string]attr.Value{"split": testFeatureFlagObject(t, map[string]attr.Value{
+			"entity":     types.StringValue("user"),
+			"attribute":  types.StringValue("id"),
+			"percentage": types.NumberValue(big.NewFloat(percentage)),
+		})}
+	}
+
+	for name, rule := range map[string]map[string]attr.Value{
+		"rule":            {},
+		"full percentage": split(100),
+	} {
+		t.Run(name, func(t *testing.T) {
+			resp := runEvaluateFeatureFlagWithSegments(t, config, "production", entities, segment(rule, nil))
+			if resp.Error != nil {
+				t.Fatalf("Run() error = %v", resp.Error)
+			}
+			if got := resp.Result.Value().(types.Object).Attributes()["variant_id"].(types.String).ValueString(); got != "treatment" {
+				t.Errorf("variant_id = %q, want treatment", got)
+			}
+		})
+	}
+
+	resp := runEvaluateFeatureFlagWithSegments(t, config, "production", entities, segment(split(50), nil))
+	if resp.Error == nil || !strings.Contains(resp.Error.Text, client.ErrFeatureFlagBucketed.Error()) {
+		t.Errorf("Run() error = %v, want a bucketing error for a partial percentage", resp.Error)
+	}
+
+	resp = runEvaluateFeatureFlagWithSegments(t, config, "production", entities, segment(map[string]attr.Value{}, map[string]attr.Value{
+		"percentage_rollout": types.NumberValue(big.NewFloat(10)),
+	}))
+	if resp.Error == nil || resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 3 {
+		t.Errorf("Run() error = %v, want an error for the segments argument", resp.Error)
+	}
+}
@@ -0,0 +1,72 @@
+package vercel
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var _ function.Function = &parseDNSZonefileFunction{}
+
+func newParseDNSZonefileFunction() function.Function {
+	return &parseDNSZonefileFunction{}
+}
+
+type parseDNSZonefileFunction struct{}
+
+func (f *parseDNSZonefileFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_dns_zonefile"
+}
+
+func (f *parseDNSZonefileFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Parse an RFC 1035 zone file into DNS records.",
+		MarkdownDescription: `
+Parses an RFC 1035 (BIND) zone file, such as one exported from Route53 or Cloudflare, into a list of DNS records.
+
+Each record has the same attributes as a ` + "`vercel_dns_record`" + `: ` + "`name`, `type`, `value`, `ttl`, `mx_priority`, `comment`, `srv` and `https`" + `. ` + "`A`, `AAAA`, `ALIAS`, `CAA`, `CNAME`, `HTTPS`, `MX`, `NS`, `SRV` and `TXT`" + ` records are supported. ` + "`SOA`" + ` records are skipped, and any other record type is an error.
+`,
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:        "domain",
+				Description: "The domain the zone file is for. Record names are made relative to this domain.",
+			},
+			function.StringParameter{
+				Name:        "zone_file",
+				Description: "The contents of the zone file.",
+			},
+		},
+		Return: function.ListReturn{
+			ElementType: dnsZoneRecordAttrType,
+		},
+	}
+}
+
+func (f *parseDNSZonefileFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var domain, zoneFile string
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &domain, &zoneFile))
+	if resp.Error != nil {
+		return
+	}
+
+	requests, err := client.ParseZoneFile(domain, strings.NewReader(zoneFile))
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(1, "Invalid zone file: "+err.Error())
+		return
+	}
+
+	records := make([]DNSZoneRecord, 0, len(requests))
+	for _, request := range requests {
+		records = append(records, dnsZoneRecordFromRequest(request))
+	}
+	result, diags := types.ListValueFrom(ctx, dnsZoneRecordAttrType, records)
+	resp.Error = function.ConcatFuncErrors(resp.Error, function.FuncErrorFromDiags(ctx, diags))
+	if resp.Error != nil {
+		return
+	}
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, result))
+}
@@ -0,0 +1,77 @@
+package vercel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+func runParseDNSZonefile(t *testing.T, domain, zoneFile string) function.RunResponse {
+	t.Helper()
+	resp := function.RunResponse{
+		Result: function.NewResultData(types.ListUnknown(dnsZoneRecordAttrType)),
+	}
+	(&parseDNSZonefileFunction{}).Run(context.Background(), function.RunRequest{
+		Arguments: function.NewArgumentsData([]attr.Value{
+			types.StringValue(domain),
+			types.StringValue(zoneFile),
+		}),
+	}, &resp)
+	return resp
+}
+
+func TestParseDNSZonefileFunction(t *testing.T) {
+	resp := runParseDNSZonefile(t, "example.com", `
+$ORIGIN example.com.
+@         300 IN A     76.76.21.21 ; apex
+@         300 IN MX    10 mail.example.com.
+_sip._tcp 300 IN SRV   10 5 5060 sip.example.com.
+svc       300 IN HTTPS 1 . alpn=h2
+`)
+	if resp.Error != nil {
+		t.Fatalf("Run() error = %v", resp.Error)
+	}
+
+	var records []DNSZoneRecord
+	list, ok := resp.Result.Value().(types.List)
+	if !ok {
+		t.Fatalf("result = %T, want types.List", resp.Result.Value())
+	}
+	if diags := list.ElementsAs(context.Background(), &records, false); diags.HasError() {
+		t.Fatalf("ElementsAs() diags = %v", diags)
+	}
+	if len(records) != 4 {
+		t.Fatalf("records = %+v, want 4", records)
+	}
+
+	if a := records[0]; a.Type.ValueString() != "A" || a.Name.ValueString() != "" || a.TTL.ValueInt64() != 300 || a.Comment.ValueString() != "apex" {
+		t.Errorf("A record = %+v", a)
+	}
+	if mx := records[1]; mx.MXPriority.ValueInt64() != 10 || mx.Value.ValueString() != "mail.example.com" {
+		t.Errorf("MX record = %+v", mx)
+	}
+	var srv SRV
+	records[2].SRV.As(context.Background(), &srv, basetypes.ObjectAsOptions{})
+	if !records[2].Value.IsNull() || srv.Port.ValueInt64() != 5060 || srv.Target.ValueString() != "sip.example.com" {
+		t.Errorf("SRV record = %+v, srv = %+v", records[2], srv)
+	}
+	var https HTTPSRecord
+	records[3].HTTPS.As(context.Background(), &https, basetypes.ObjectAsOptions{})
+	if https.Priority.ValueInt64() != 1 || https.Target.ValueString() != "." || https.Params.ValueString() != "alpn=h2" {
+		t.Errorf("HTTPS record = %+v", https)
+	}
+}
+
+func TestParseDNSZonefileFunctionInvalid(t *testing.T) {
+	resp := runParseDNSZonefile(t, "example.com", "www 60 IN SSHFP 1 1 abcdef")
+	if resp.Error == nil {
+		t.Fatal("Run() succeeded, want error for unsupported record type")
+	}
+	if resp.Error.FunctionArgument == nil || *resp.Error.FunctionArgument != 1 {
+		t.Errorf("error argument = %v, want the zone_file argument", resp.Error.FunctionArgument)
+	}
+}
@@ -20,15 +20,20 @@ func TestImportStateInvalidIDReturnsBeforeClientCall(t *testing.T) {
 		{name: "attack challenge mode", run: func(resp *resource.ImportStateResponse) { (&attackChallengeModeResource{}).ImportState(ctx, req, resp) }},
 		{name: "audit log drain", run: func(resp *resource.ImportStateResponse) { (&auditLogDrainResource{}).ImportState(ctx, req, resp) }},
 		{name: "custom environment", run: func(resp *resource.ImportStateResponse) { (&customEnvironmentResource{}).ImportState(ctx, req, resp) }},
+		{name: "deploy hook", run: func(resp *resource.ImportStateResponse) { (&deployHookResource{}).ImportState(ctx, req, resp) }},
 		{name: "deployment protection exception", run: func(resp *resource.ImportStateResponse) {
 			(&deploymentProtectionExceptionResource{}).ImportState(ctx, req, resp)
 		}},
 		{name: "dns record", run: func(resp *resource.ImportStateResponse) { (&dnsRecordResource{}).ImportState(ctx, req, resp) }},
+		{name: "dns zone", run: func(resp *resource.ImportStateResponse) { (&dnsZoneResource{}).ImportState(ctx, req, resp) }},
+		{name: "domain", run: func(resp *resource.ImportStateResponse) { (&domainResource{}).ImportState(ctx, req, resp) }},
 		{name: "edge config", run: func(resp *resource.ImportStateResponse) { (&edgeConfigResource{}).ImportState(ctx, req, resp) }},
 		{name: "edge config item", run: func(resp *resource.ImportStateResponse) { (&edgeConfigItemResource{}).ImportState(ctx, req, resp) }},
 		{name: "edge config schema", run: func(resp *resource.ImportStateResponse) { (&edgeConfigSchemaResource{}).ImportState(ctx, req, resp) }},
 		{name: "edge config token", run: func(resp *resource.ImportStateResponse) { (&edgeConfigTokenResource{}).ImportState(ctx, req, resp) }},
 		{name: "firewall config", run: func(resp *resource.ImportStateResponse) { (&firewallConfigResource{}).ImportState(ctx, req, resp) }},
+		{name: "firewall ip rule", run: func(resp *resource.ImportStateResponse) { (&firewallIPRuleResource{}).ImportState(ctx, req, resp) }},
+		{name: "firewall rule", run: func(resp *resource.ImportStateResponse) { (&firewallRuleResource{}).ImportState(ctx, req, resp) }},
 		{name: "log drain", run: func(resp *resource.ImportStateResponse) { (&logDrainResource{}).ImportState(ctx, req, resp) }},
 		{name: "microfrontend group", run: func(resp *resource.ImportStateResponse) { (&microfrontendGroupResource{}).ImportState(ctx, req, resp) }},
 		{name: "microfrontend group membership", run: func(resp *resource.ImportStateResponse) {
@@ -0,0 +1,104 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &dnsRecordResource{}
+	_ list.ListResourceWithConfigure = &dnsRecordResource{}
+)
+
+func newDNSRecordListResource() list.ListResource {
+	return &dnsRecordResource{}
+}
+
+// ListResourceConfigSchema returns the schema for a vercel_dns_record list block.
+func (r *dnsRecordResource) ListResourceConfigSchema(_ context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the DNS Records that exist beneath a domain.",
+		Attributes: map[string]schema.Attribute{
+			"domain": schema.StringAttribute{
+				Required:    true,
+				Description: "The domain name, or zone, to list DNS Records for.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the team the domain belongs to. Required when a default team has not been set in the provider.",
+			},
+		},
+	}
+}
+
+type dnsRecordListConfig struct {
+	Domain types.String `tfsdk:"domain"`
+	TeamID types.String `tfsdk:"team_id"`
+}
+
+// List enumerates every DNS Record beneath a domain.
+func (r *dnsRecordResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config dnsRecordListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	records, err := r.client.ListDNSRecords(ctx, config.Domain.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error listing DNS Records",
+			fmt.Sprintf("Could not list DNS Records for domain %s, unexpected error: %s", config.Domain.ValueString(), err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, record := range records {
+			result := req.NewListResult(ctx)
+			result.DisplayName = fmt.Sprintf("%s %s", record.RecordType, dnsRecordFQDN(record.Name, record.Domain))
+			result.Diagnostics.Append(result.Identity.Set(ctx, TeamResourceIdentity{
+				TeamID: toTeamID(record.TeamID),
+				ID:     types.StringValue(record.ID),
+			})...)
+			if req.IncludeResource {
+				// The list endpoint formats some record values differently, so read each
+				// record individually to produce the same state an import would.
+				out, err := r.client.GetDNSRecord(ctx, record.ID, config.TeamID.ValueString())
+				if err != nil {
+					result.Diagnostics.AddError(
+						"Error reading DNS Record",
+						fmt.Sprintf("Could not get DNS Record %s, unexpected error: %s", record.ID, err),
+					)
+				} else {
+					state, err := convertResponseToDNSRecord(out, types.String{}, types.ObjectNull(srvAttrType.AttrTypes), types.ObjectNull(httpsAttrType.AttrTypes))
+					if err != nil {
+						result.Diagnostics.AddError(
+							"Error processing DNS Record response",
+							fmt.Sprintf("Could not process DNS Record API response %s, unexpected error: %s", record.ID, err),
+						)
+					} else {
+						result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+					}
+				}
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// dnsRecordFQDN returns the fully qualified name of a record beneath a domain.
+func dnsRecordFQDN(name, domain string) string {
+	if name == "" {
+		return domain
+	}
+	return name + "." + domain
+}
@@ -0,0 +1,71 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &edgeConfigResource{}
+	_ list.ListResourceWithConfigure = &edgeConfigResource{}
+)
+
+func newEdgeConfigListResource() list.ListResource {
+	return &edgeConfigResource{}
+}
+
+// ListResourceConfigSchema returns the schema for a vercel_edge_config list block.
+func (r *edgeConfigResource) ListResourceConfigSchema(_ context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the Edge Configs that exist within a team.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the team to list Edge Configs for. Required when a default team has not been set in the provider.",
+			},
+		},
+	}
+}
+
+type edgeConfigListConfig struct {
+	TeamID types.String `tfsdk:"team_id"`
+}
+
+// List enumerates every Edge Config within a team.
+func (r *edgeConfigResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config edgeConfigListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	out, err := r.client.ListEdgeConfigs(ctx, config.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error listing Edge Configs",
+			fmt.Sprintf("Could not list Edge Configs for team %s, unexpected error: %s", config.TeamID.ValueString(), err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, ec := range out {
+			result := req.NewListResult(ctx)
+			edgeConfig := responseToEdgeConfig(ec)
+			result.DisplayName = ec.Slug
+			result.Diagnostics.Append(result.Identity.Set(ctx, edgeConfig.identity())...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, edgeConfig)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
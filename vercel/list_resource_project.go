@@ -0,0 +1,97 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &projectResource{}
+	_ list.ListResourceWithConfigure = &projectResource{}
+)
+
+func newProjectListResource() list.ListResource {
+	return &projectResource{}
+}
+
+// ListResourceConfigSchema returns the schema for a vercel_project list block.
+func (r *projectResource) ListResourceConfigSchema(_ context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the projects that exist within a team.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the team to list projects for. Required when a default team has not been set in the provider.",
+			},
+		},
+	}
+}
+
+type projectListConfig struct {
+	TeamID types.String `tfsdk:"team_id"`
+}
+
+// List enumerates every project within a team.
+func (r *projectResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config projectListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	projects, err := r.client.ListProjects(ctx, config.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error listing projects",
+			fmt.Sprintf("Could not list projects for team %s, unexpected error: %s", config.TeamID.ValueString(), err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, project := range projects {
+			result := req.NewListResult(ctx)
+			result.DisplayName = project.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, TeamResourceIdentity{
+				TeamID: toTeamID(project.TeamID),
+				ID:     types.StringValue(project.ID),
+			})...)
+			if req.IncludeResource {
+				state, err := r.readImportedProject(ctx, project.ID, config.TeamID.ValueString())
+				if err != nil {
+					result.Diagnostics.AddError(
+						"Error reading project",
+						fmt.Sprintf("Could not read project %s, unexpected error: %s", project.ID, err),
+					)
+				} else {
+					result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+				}
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
+
+// readImportedProject reads a project in full, along with its environment variables,
+// producing the same state as an import would.
+func (r *projectResource) readImportedProject(ctx context.Context, projectID, teamID string) (Project, error) {
+	out, err := r.client.GetProject(ctx, projectID, teamID)
+	if err != nil {
+		return Project{}, err
+	}
+
+	environmentVariables, err := r.client.GetEnvironmentVariables(ctx, out.ID, out.TeamID)
+	if err != nil {
+		return Project{}, fmt.Errorf("unable to read project environment variables: %w", err)
+	}
+
+	return convertResponseToProject(ctx, out, nullProject, environmentVariables)
+}
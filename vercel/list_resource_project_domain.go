@@ -0,0 +1,91 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &projectDomainResource{}
+	_ list.ListResourceWithConfigure = &projectDomainResource{}
+)
+
+func newProjectDomainListResource() list.ListResource {
+	return &projectDomainResource{}
+}
+
+// ListResourceConfigSchema returns the schema for a vercel_project_domain list block.
+func (r *projectDomainResource) ListResourceConfigSchema(_ context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the domains associated with a project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project to list domains for.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the team the project belongs to. Required when a default team has not been set in the provider.",
+			},
+		},
+	}
+}
+
+type projectDomainListConfig struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+}
+
+// List enumerates every domain associated with a project.
+func (r *projectDomainResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config projectDomainListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	domains, err := r.client.ListProjectDomains(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error listing project domains",
+			fmt.Sprintf("Could not list domains for project %s, unexpected error: %s", config.ProjectID.ValueString(), err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, domain := range domains {
+			result := req.NewListResult(ctx)
+			result.DisplayName = domain.Name
+			result.Diagnostics.Append(result.Identity.Set(ctx, ProjectResourceIdentity{
+				TeamID:    toTeamID(domain.TeamID),
+				ProjectID: types.StringValue(domain.ProjectID),
+				ID:        types.StringValue(domain.Name),
+			})...)
+			if req.IncludeResource {
+				domainConfig, err := r.client.GetDomainConfig(ctx, domain.Name, domain.ProjectID, config.TeamID.ValueString())
+				if err != nil {
+					result.Diagnostics.AddError(
+						"Error reading project domain configuration",
+						fmt.Sprintf("Could not get DNS configuration for domain %s and project %s, unexpected error: %s",
+							domain.Name,
+							domain.ProjectID,
+							err,
+						),
+					)
+				} else {
+					result.Diagnostics.Append(result.Resource.Set(ctx, convertResponseToProjectDomain(domain, domainConfig))...)
+				}
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,76 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &projectEnvironmentVariableResource{}
+	_ list.ListResourceWithConfigure = &projectEnvironmentVariableResource{}
+)
+
+func newProjectEnvironmentVariableListResource() list.ListResource {
+	return &projectEnvironmentVariableResource{}
+}
+
+// ListResourceConfigSchema returns the schema for a vercel_project_environment_variable list block.
+func (r *projectEnvironmentVariableResource) ListResourceConfigSchema(_ context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the environment variables that exist within a project.",
+		Attributes: map[string]schema.Attribute{
+			"project_id": schema.StringAttribute{
+				Required:    true,
+				Description: "The ID of the project to list environment variables for.",
+			},
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the team the project belongs to. Required when a default team has not been set in the provider.",
+			},
+		},
+	}
+}
+
+type projectEnvironmentVariableListConfig struct {
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+}
+
+// List enumerates every environment variable within a project.
+func (r *projectEnvironmentVariableResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config projectEnvironmentVariableListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	envs, err := r.client.GetEnvironmentVariables(ctx, config.ProjectID.ValueString(), config.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error listing project environment variables",
+			fmt.Sprintf("Could not list environment variables for project %s, unexpected error: %s", config.ProjectID.ValueString(), err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, env := range envs {
+			result := req.NewListResult(ctx)
+			state := convertImportedProjectEnvironmentVariable(env, config.ProjectID.ValueString())
+			result.DisplayName = env.Key
+			result.Diagnostics.Append(result.Identity.Set(ctx, state.identity())...)
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, state)...)
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,82 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ list.ListResource              = &sharedEnvironmentVariableResource{}
+	_ list.ListResourceWithConfigure = &sharedEnvironmentVariableResource{}
+)
+
+func newSharedEnvironmentVariableListResource() list.ListResource {
+	return &sharedEnvironmentVariableResource{}
+}
+
+// ListResourceConfigSchema returns the schema for a vercel_shared_environment_variable list block.
+func (r *sharedEnvironmentVariableResource) ListResourceConfigSchema(_ context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Lists the shared environment variables that exist within a team.",
+		Attributes: map[string]schema.Attribute{
+			"team_id": schema.StringAttribute{
+				Optional:    true,
+				Description: "The ID of the team to list shared environment variables for. Required when a default team has not been set in the provider.",
+			},
+		},
+	}
+}
+
+type sharedEnvironmentVariableListConfig struct {
+	TeamID types.String `tfsdk:"team_id"`
+}
+
+// List enumerates every shared environment variable within a team.
+func (r *sharedEnvironmentVariableResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var config sharedEnvironmentVariableListConfig
+	diags := req.Config.Get(ctx, &config)
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	envs, err := r.client.ListSharedEnvironmentVariables(ctx, config.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error listing shared environment variables",
+			fmt.Sprintf("Could not list shared environment variables for team %s, unexpected error: %s", config.TeamID.ValueString(), err),
+		)
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for _, env := range envs {
+			result := req.NewListResult(ctx)
+			result.DisplayName = env.Key
+			result.Diagnostics.Append(result.Identity.Set(ctx, TeamResourceIdentity{
+				TeamID: toTeamID(env.TeamID),
+				ID:     types.StringValue(env.ID),
+			})...)
+			if req.IncludeResource {
+				// Values are only returned decrypted when reading a single variable.
+				out, err := r.client.GetSharedEnvironmentVariable(ctx, config.TeamID.ValueString(), env.ID)
+				if err != nil {
+					result.Diagnostics.AddError(
+						"Error reading shared environment variable",
+						fmt.Sprintf("Could not get shared environment variable %s, unexpected error: %s", env.ID, err),
+					)
+				} else {
+					result.Diagnostics.Append(result.Resource.Set(ctx, convertImportedSharedEnvironmentVariable(out))...)
+				}
+			}
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
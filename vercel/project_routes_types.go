@@ -72,16 +72,19 @@ type ProjectRoutesModel struct {
 }
 
 type ProjectRouteResourceModel struct {
-	ID          types.String           `tfsdk:"id"`
-	ProjectID   types.String           `tfsdk:"project_id"`
-	TeamID      types.String           `tfsdk:"team_id"`
-	Name        types.String           `tfsdk:"name"`
-	Description types.String           `tfsdk:"description"`
-	Enabled     types.Bool             `tfsdk:"enabled"`
-	SrcSyntax   types.String           `tfsdk:"src_syntax"`
-	RouteType   types.String           `tfsdk:"route_type"`
-	Position    ProjectRoutePosition   `tfsdk:"position"`
-	Route       ProjectRouteDefinition `tfsdk:"route"`
+	ID           types.String           `tfsdk:"id"`
+	ProjectID    types.String           `tfsdk:"project_id"`
+	TeamID       types.String           `tfsdk:"team_id"`
+	Name         types.String           `tfsdk:"name"`
+	Description  types.String           `tfsdk:"description"`
+	Enabled      types.Bool             `tfsdk:"enabled"`
+	SrcSyntax    types.String           `tfsdk:"src_syntax"`
+	RouteType    types.String           `tfsdk:"route_type"`
+	Position     ProjectRoutePosition   `tfsdk:"position"`
+	Route        ProjectRouteDefinition `tfsdk:"route"`
+	Promote      types.Bool             `tfsdk:"promote"`
+	VersionID    types.String           `tfsdk:"version_id"`
+	PreviewAlias types.String           `tfsdk:"preview_alias"`
 }
 
 type ProjectRoute struct {
@@ -198,19 +201,45 @@ func (m ProjectRouteResourceModel) projectRoute() ProjectRoute {
 	}
 }
 
-func projectRouteResourceModelFromRoute(projectID, teamID string, route ProjectRoute, position ProjectRoutePosition) ProjectRouteResourceModel {
+// promote reports whether changes are promoted to production. State written
+// before promote existed has it unset, which means they are.
+func (m ProjectRouteResourceModel) promote() bool {
+	return m.Promote.IsNull() || m.Promote.IsUnknown() || m.Promote.ValueBool()
+}
+
+// stagedVersionID is the draft the resource staged with promote = false, or ""
+// if its changes were promoted.
+func (m ProjectRouteResourceModel) stagedVersionID() string {
+	if m.promote() {
+		return ""
+	}
+	return m.VersionID.ValueString()
+}
+
+func projectRouteResourceModelFromRoute(projectID, teamID string, route ProjectRoute, position ProjectRoutePosition, version client.ProjectRouteVersion, promote bool) ProjectRouteResourceModel {
 	return ProjectRouteResourceModel{
-		ID:          route.ID,
-		ProjectID:   types.StringValue(projectID),
-		TeamID:      toTeamID(teamID),
-		Name:        route.Name,
-		Description: route.Description,
-		Enabled:     route.Enabled,
-		SrcSyntax:   route.SrcSyntax,
-		RouteType:   route.RouteType,
-		Position:    position,
-		Route:       route.Route,
+		ID:           route.ID,
+		ProjectID:    types.StringValue(projectID),
+		TeamID:       toTeamID(teamID),
+		Name:         route.Name,
+		Description:  route.Description,
+		Enabled:      route.Enabled,
+		SrcSyntax:    route.SrcSyntax,
+		RouteType:    route.RouteType,
+		Position:     position,
+		Route:        route.Route,
+		Promote:      types.BoolValue(promote),
+		VersionID:    projectRouteVersionID(version),
+		PreviewAlias: stringValueOrNull(version.Alias),
+	}
+}
+
+func projectRouteVersionID(version client.ProjectRouteVersion) types.String {
+	if version.ID == "" {
+		return types.StringNull()
 	}
+
+	return types.StringValue(version.ID)
 }
 
 func (p ProjectRoutePosition) isNull() bool {
@@ -382,26 +411,46 @@ func projectRouteTransformsToClient(ctx context.Context, transformList types.Lis
 }
 
 func readLiveProjectRoutingRules(ctx context.Context, vercelClient *client.Client, projectID, teamID string) (client.ProjectRoutingRulesResponse, error) {
+	return readProjectRoutingRules(ctx, vercelClient, projectID, teamID, false)
+}
+
+// readProjectRoutingRules reads the live routing rules. When staged is true and
+// the project has an unpublished staged version, that version is read instead.
+func readProjectRoutingRules(ctx context.Context, vercelClient *client.Client, projectID, teamID string, staged bool) (client.ProjectRoutingRulesResponse, error) {
 	versions, err := vercelClient.GetProjectRouteVersions(ctx, projectID, teamID)
 	if err != nil {
 		return client.ProjectRoutingRulesResponse{}, err
 	}
 
-	liveVersionID := ""
+	return vercelClient.GetProjectRoutingRules(ctx, projectID, teamID, findProjectRouteVersion(versions, staged).ID)
+}
+
+func findProjectRouteVersion(versions []client.ProjectRouteVersion, staged bool) client.ProjectRouteVersion {
+	if staged {
+		for _, version := range versions {
+			if version.IsStaging {
+				return version
+			}
+		}
+	}
+
 	for _, version := range versions {
 		if version.IsLive {
-			liveVersionID = version.ID
-			break
+			return version
 		}
 	}
 
-	return vercelClient.GetProjectRoutingRules(ctx, projectID, teamID, liveVersionID)
+	return client.ProjectRouteVersion{}
 }
 
 func convertResponseToProjectRoutes(ctx context.Context, response client.ProjectRoutingRulesResponse, projectID, teamID string, preferredRules []ProjectRoute) (ProjectRoutesModel, diag.Diagnostics) {
+	// Rules that have just been created have no ID in the plan yet, so they
+	// are matched by name instead.
 	preferredByID := map[string]ProjectRoute{}
+	preferredByName := map[string]ProjectRoute{}
 	for _, rule := range preferredRules {
 		if rule.ID.IsNull() || rule.ID.IsUnknown() || rule.ID.ValueString() == "" {
+			preferredByName[rule.Name.ValueString()] = rule
 			continue
 		}
 		preferredByID[rule.ID.ValueString()] = rule
@@ -409,7 +458,11 @@ func convertResponseToProjectRoutes(ctx context.Context, response client.Project
 
 	rules := make([]ProjectRoute, 0, len(response.Routes))
 	for _, apiRule := range response.Routes {
-		rule, diags := projectRouteFromAPI(ctx, apiRule, preferredByID[apiRule.ID])
+		preferred, ok := preferredByID[apiRule.ID]
+		if !ok {
+			preferred = preferredByName[apiRule.Name]
+		}
+		rule, diags := projectRouteFromAPI(ctx, apiRule, preferred)
 		if diags.HasError() {
 			return ProjectRoutesModel{}, diags
 		}
@@ -434,8 +487,10 @@ func readProjectRoutes(ctx context.Context, vercelClient *client.Client, project
 	return result, diags, nil
 }
 
-func readProjectRoute(ctx context.Context, vercelClient *client.Client, routeID, projectID, teamID string, preferredRoute ProjectRoute, preferredPosition ProjectRoutePosition) (ProjectRouteResourceModel, diag.Diagnostics, error) {
-	response, err := readLiveProjectRoutingRules(ctx, vercelClient, projectID, teamID)
+// readProjectRoute reads a rule from the live version, or from the staged
+// version when changes are not promoted.
+func readProjectRoute(ctx context.Context, vercelClient *client.Client, routeID, projectID, teamID string, preferredRoute ProjectRoute, preferredPosition ProjectRoutePosition, promote bool) (ProjectRouteResourceModel, diag.Diagnostics, error) {
+	response, err := readProjectRoutingRules(ctx, vercelClient, projectID, teamID, !promote)
 	if err != nil {
 		return ProjectRouteResourceModel{}, nil, err
 	}
@@ -450,7 +505,7 @@ func readProjectRoute(ctx context.Context, vercelClient *client.Client, routeID,
 			return ProjectRouteResourceModel{}, diags, nil
 		}
 
-		return projectRouteResourceModelFromRoute(projectID, vercelClient.TeamID(teamID), route, preferredPosition), nil, nil
+		return projectRouteResourceModelFromRoute(projectID, vercelClient.TeamID(teamID), route, preferredPosition, response.Version, promote), nil, nil
 	}
 
 	return ProjectRouteResourceModel{}, nil, client.APIError{
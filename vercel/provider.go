@@ -7,6 +7,8 @@ import (
 	"regexp"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -15,6 +17,12 @@ import (
 	"github.com/vercel/terraform-provider-vercel/v5/client"
 )
 
+var (
+	_ provider.Provider                  = &vercelProvider{}
+	_ provider.ProviderWithFunctions     = &vercelProvider{}
+	_ provider.ProviderWithListResources = &vercelProvider{}
+)
+
 type vercelProvider struct{}
 
 // New instantiates a new instance of a vercel terraform provider.
@@ -58,20 +66,32 @@ func (p *vercelProvider) Resources(_ context.Context) []func() resource.Resource
 		newAttackChallengeModeResource,
 		newAuditLogDrainResource,
 		newBulkRedirectsResource,
+		newBulkRedirectsPromotionResource,
+		newBlobDirectoryResource,
+		newBlobObjectCopyResource,
 		newBlobObjectResource,
 		newBlobProjectConnectionResource,
 		newBlobStoreResource,
 		newCustomCertificateResource,
 		newCustomEnvironmentResource,
+		newDeployHookResource,
 		newDeploymentProtectionExceptionResource,
 		newDeploymentResource,
 		newDNSRecordResource,
+		newDNSZoneResource,
+		newDomainResource,
 		newEdgeConfigItemResource,
+		newEdgeConfigItemsResource,
+		newEdgeConfigProjectConnectionResource,
 		newEdgeConfigResource,
+		newEdgeConfigRestoreResource,
 		newEdgeConfigSchemaResource,
 		newEdgeConfigTokenResource,
 		newFirewallBypassResource,
 		newFirewallConfigResource,
+		newFirewallIPRuleResource,
+		newFirewallRuleResource,
+		newFirewallRulesetResource,
 		newFeatureFlagDefinitionResource,
 		newFeatureFlagSDKKeyResource,
 		newFeatureFlagSegmentResource,
@@ -91,6 +111,8 @@ func (p *vercelProvider) Resources(_ context.Context) []func() resource.Resource
 		newProjectMembersResource,
 		newProjectProtectionBypassResource,
 		newProjectRouteResource,
+		newProjectRoutesResource,
+		newProjectRoutesPromotionResource,
 		newProjectResource,
 		newSharedEnvironmentVariableProjectLinkResource,
 		newSharedEnvironmentVariableResource,
@@ -113,13 +135,17 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 		newAttackChallengeModeDataSource,
 		newBulkRedirectsDataSource,
 		newBlobObjectDataSource,
+		newBlobObjectsDataSource,
 		newBlobProjectConnectionsDataSource,
 		newBlobStoreDataSource,
 		newBlobStoreSecretsDataSource,
 		newBlobStoresDataSource,
 		newCustomEnvironmentDataSource,
 		newDeploymentDataSource,
+		newDNSZonefileDataSource,
 		newDomainConfigDataSource,
+		newDomainsDataSource,
+		newEdgeConfigBackupsDataSource,
 		newEdgeConfigDataSource,
 		newEdgeConfigItemDataSource,
 		newEdgeConfigSchemaDataSource,
@@ -127,8 +153,13 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 		newEndpointVerificationDataSource,
 		newFileDataSource,
 		newFeatureFlagDataSource,
+		newFeatureFlagRevisionsDataSource,
 		newFeatureFlagSDKKeyDataSource,
 		newFeatureFlagSegmentDataSource,
+		newFeatureFlagSegmentsDataSource,
+		newFeatureFlagsDataSource,
+		newFirewallBypassesDataSource,
+		newFirewallConfigDataSource,
 		newLogDrainDataSource,
 		newNetworkDataSource,
 		newPrebuiltProjectDataSource,
@@ -149,6 +180,24 @@ func (p *vercelProvider) DataSources(_ context.Context) []func() datasource.Data
 	}
 }
 
+func (p *vercelProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		newEvaluateFeatureFlagFunction,
+		newParseDNSZonefileFunction,
+	}
+}
+
+func (p *vercelProvider) ListResources(_ context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		newDNSRecordListResource,
+		newEdgeConfigListResource,
+		newProjectDomainListResource,
+		newProjectEnvironmentVariableListResource,
+		newProjectListResource,
+		newSharedEnvironmentVariableListResource,
+	}
+}
+
 type providerData struct {
 	APIToken types.String `tfsdk:"api_token"`
 	Team     types.String `tfsdk:"team"`
@@ -222,4 +271,5 @@ func (p *vercelProvider) Configure(ctx context.Context, req provider.ConfigureRe
 
 	resp.DataSourceData = vercelClient
 	resp.ResourceData = vercelClient
+	resp.ListResourceData = vercelClient
 }
@@ -0,0 +1,632 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	gitignore "github.com/sabhiram/go-gitignore"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+	"github.com/vercel/terraform-provider-vercel/v5/file"
+)
+
+var (
+	_ resource.Resource               = &blobDirectoryResource{}
+	_ resource.ResourceWithConfigure  = &blobDirectoryResource{}
+	_ resource.ResourceWithModifyPlan = &blobDirectoryResource{}
+)
+
+// blobDirectoryUploadConcurrency is the number of files uploaded at once.
+const blobDirectoryUploadConcurrency = 8
+
+func newBlobDirectoryResource() resource.Resource {
+	return &blobDirectoryResource{}
+}
+
+type blobDirectoryResource struct {
+	client *client.Client
+}
+
+func (r *blobDirectoryResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blob_directory"
+}
+
+func (r *blobDirectoryResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *blobDirectoryResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Syncs a local directory into a Vercel Blob store.
+
+Every file in the directory is uploaded under a pathname prefix in the store. Files are compared by their SHA-256, so only files that have changed are uploaded again.
+
+Files are skipped if they match a ` + "`.vercelignore`" + ` file in the directory, the default ignores used for deployments, or ` + "`ignore_patterns`" + `.
+
+~> Only objects uploaded by this resource are managed. Objects under the prefix that were uploaded in other ways are left alone.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The unique identifier for this directory. Format: `store_id/prefix`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"store_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the Blob store the directory should be synced into.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team that owns the Blob store. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"source": schema.StringAttribute{
+				Required:    true,
+				Description: "The local directory to sync. Note that the path is relative to the root of the terraform files.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"prefix": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString(""),
+				Description:   "The pathname prefix to upload files under, such as `assets/`. A file at `css/site.css` in the directory is uploaded to `assets/css/site.css`. Changing the prefix replaces the directory.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(blobDirectoryPrefixRe, "must not start with '/', and must end with '/' when set"),
+				},
+			},
+			"ignore_patterns": schema.ListAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Additional patterns for files to skip, using the same syntax as a `.gitignore` file.",
+			},
+			"delete_removed": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether objects are deleted from the store once their file is removed from the directory. Only objects recorded in the prior state are deleted, so objects under the prefix that this resource never uploaded are left alone. When `false`, they are left in the store and are no longer managed. Defaults to `true`.",
+			},
+			"content_types": schema.MapAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Content types to use for files by extension, such as `{ \".wasm\" = \"application/wasm\" }`. Files with other extensions get a content type inferred from their extension.",
+			},
+			"cache_control_max_age": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(defaultBlobObjectCacheControlMaxAge),
+				Description: "The cache max-age, in seconds, for files that do not match any `cache_control` rule.",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"cache_control": schema.ListNestedAttribute{
+				Optional:    true,
+				Description: "Cache max-age rules by file pattern. The first rule whose pattern matches a file applies to it.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"pattern": schema.StringAttribute{
+							Required:    true,
+							Description: "The files the rule applies to, relative to the directory, using the same syntax as a `.gitignore` file. For example `*.html` or `assets/**`.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"max_age": schema.Int64Attribute{
+							Required:    true,
+							Description: "The cache max-age, in seconds, for matching files.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(60),
+							},
+						},
+					},
+				},
+			},
+			"objects": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The uploaded objects, keyed by pathname.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"url": schema.StringAttribute{
+							Computed:    true,
+							Description: "The canonical URL for the Blob object.",
+						},
+						"etag": schema.StringAttribute{
+							Computed:    true,
+							Description: "The current ETag for the Blob object.",
+						},
+						"source_sha256": schema.StringAttribute{
+							Computed:    true,
+							Description: "The SHA-256 of the local file the object was uploaded from.",
+						},
+						"content_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The content type stored on the object.",
+						},
+						"cache_control_max_age": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The cache max-age, in seconds, stored on the object.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var blobDirectoryPrefixRe = regexp.MustCompile(`^$|^[^/].*/$`)
+
+type BlobDirectoryResourceModel struct {
+	ID                 types.String                    `tfsdk:"id"`
+	StoreID            types.String                    `tfsdk:"store_id"`
+	TeamID             types.String                    `tfsdk:"team_id"`
+	Source             types.String                    `tfsdk:"source"`
+	Prefix             types.String                    `tfsdk:"prefix"`
+	IgnorePatterns     types.List                      `tfsdk:"ignore_patterns"`
+	DeleteRemoved      types.Bool                      `tfsdk:"delete_removed"`
+	ContentTypes       types.Map                       `tfsdk:"content_types"`
+	CacheControlMaxAge types.Int64                     `tfsdk:"cache_control_max_age"`
+	CacheControl       []BlobDirectoryCacheControlRule `tfsdk:"cache_control"`
+	Objects            types.Map                       `tfsdk:"objects"`
+}
+
+type BlobDirectoryCacheControlRule struct {
+	Pattern types.String `tfsdk:"pattern"`
+	MaxAge  types.Int64  `tfsdk:"max_age"`
+}
+
+type BlobDirectoryObject struct {
+	URL                types.String `tfsdk:"url"`
+	ETag               types.String `tfsdk:"etag"`
+	SourceSHA256       types.String `tfsdk:"source_sha256"`
+	ContentType        types.String `tfsdk:"content_type"`
+	CacheControlMaxAge types.Int64  `tfsdk:"cache_control_max_age"`
+}
+
+var blobDirectoryObjectType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"url":                   types.StringType,
+		"etag":                  types.StringType,
+		"source_sha256":         types.StringType,
+		"content_type":          types.StringType,
+		"cache_control_max_age": types.Int64Type,
+	},
+}
+
+// uploaded reports whether the object has been uploaded, rather than being
+// planned for upload.
+func (o BlobDirectoryObject) uploaded() bool {
+	return !o.URL.IsUnknown() && !o.URL.IsNull()
+}
+
+// blobDirectoryFile is a local file to be synced, and the object it maps to.
+type blobDirectoryFile struct {
+	Path     string
+	Pathname string
+	Object   BlobDirectoryObject
+}
+
+func (m BlobDirectoryResourceModel) objects(ctx context.Context) (map[string]BlobDirectoryObject, diag.Diagnostics) {
+	objects := map[string]BlobDirectoryObject{}
+	if m.Objects.IsNull() || m.Objects.IsUnknown() {
+		return objects, nil
+	}
+	diags := m.Objects.ElementsAs(ctx, &objects, false)
+	return objects, diags
+}
+
+// localFiles walks the source directory and works out the object each file
+// should be uploaded as. Objects are unknown until uploaded, unless prior
+// already holds an upload of the same content with the same settings.
+func (m BlobDirectoryResourceModel) localFiles(ctx context.Context, prior map[string]BlobDirectoryObject) ([]blobDirectoryFile, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	source := m.Source.ValueString()
+
+	ignores, err := file.GetIgnores(source)
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "Error reading .vercelignore file", fmt.Sprintf("Could not read file, unexpected error: %s", err))
+		return nil, diags
+	}
+	var extraIgnores []string
+	diags.Append(m.IgnorePatterns.ElementsAs(ctx, &extraIgnores, false)...)
+	contentTypes := map[string]string{}
+	diags.Append(m.ContentTypes.ElementsAs(ctx, &contentTypes, false)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	// The ignore file itself is never part of the synced content.
+	ignores = append(ignores, ".vercelignore")
+	paths, err := file.GetPaths(source, append(ignores, extraIgnores...))
+	if err != nil {
+		diags.AddAttributeError(path.Root("source"), "Error reading directory", fmt.Sprintf("Could not read files for directory %s, unexpected error: %s", source, err))
+		return nil, diags
+	}
+
+	cacheRules := make([]*gitignore.GitIgnore, len(m.CacheControl))
+	for i, rule := range m.CacheControl {
+		cacheRules[i] = gitignore.CompileIgnoreLines(rule.Pattern.ValueString())
+	}
+
+	files := make([]blobDirectoryFile, 0, len(paths))
+	for _, p := range paths {
+		rel, err := filepath.Rel(source, p)
+		if err != nil {
+			diags.AddAttributeError(path.Root("source"), "Error reading directory", fmt.Sprintf("Could not find path of %s relative to %s, unexpected error: %s", p, source, err))
+			return nil, diags
+		}
+		rel = filepath.ToSlash(rel)
+		pathname := m.Prefix.ValueString() + rel
+		if err := validateManagedBlobObjectPathname(pathname); err != nil {
+			diags.AddAttributeError(path.Root("source"), "Invalid Blob object pathname", fmt.Sprintf("File %s cannot be uploaded: %s", rel, err))
+			continue
+		}
+
+		_, sourceSHA256, _, err := hashBlobObjectSource(p)
+		if err != nil {
+			diags.AddAttributeError(path.Root("source"), "Error reading file", fmt.Sprintf("Could not read file %s, unexpected error: %s", p, err))
+			return nil, diags
+		}
+
+		contentType, ok := contentTypes[strings.ToLower(filepath.Ext(rel))]
+		if !ok {
+			contentType = inferBlobObjectContentType(rel)
+		}
+		maxAge := m.CacheControlMaxAge.ValueInt64()
+		for i, rule := range cacheRules {
+			if rule.MatchesPath(rel) {
+				maxAge = m.CacheControl[i].MaxAge.ValueInt64()
+				break
+			}
+		}
+
+		object := BlobDirectoryObject{
+			URL:                types.StringUnknown(),
+			ETag:               types.StringUnknown(),
+			SourceSHA256:       types.StringValue(sourceSHA256),
+			ContentType:        types.StringValue(contentType),
+			CacheControlMaxAge: types.Int64Value(maxAge),
+		}
+		if existing, ok := prior[pathname]; ok && existing.uploaded() &&
+			existing.SourceSHA256 == object.SourceSHA256 &&
+			existing.ContentType == object.ContentType &&
+			existing.CacheControlMaxAge == object.CacheControlMaxAge {
+			object = existing
+		}
+		files = append(files, blobDirectoryFile{Path: p, Pathname: pathname, Object: object})
+	}
+	return files, diags
+}
+
+func blobDirectoryObjectsValue(ctx context.Context, objects map[string]BlobDirectoryObject) (types.Map, diag.Diagnostics) {
+	return types.MapValueFrom(ctx, blobDirectoryObjectType, objects)
+}
+
+func (r *blobDirectoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan BlobDirectoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if plan.Source.IsUnknown() || plan.Prefix.IsUnknown() || plan.IgnorePatterns.IsUnknown() ||
+		plan.ContentTypes.IsUnknown() || plan.CacheControlMaxAge.IsUnknown() {
+		return
+	}
+	for _, rule := range plan.CacheControl {
+		if rule.Pattern.IsUnknown() || rule.MaxAge.IsUnknown() {
+			return
+		}
+	}
+
+	prior := map[string]BlobDirectoryObject{}
+	if !req.State.Raw.IsNull() {
+		var state BlobDirectoryResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		prior, diags = state.objects(ctx)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	files, diags := plan.localFiles(ctx, prior)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objects := make(map[string]BlobDirectoryObject, len(files))
+	for _, f := range files {
+		objects[f.Pathname] = f.Object
+	}
+	value, diags := blobDirectoryObjectsValue(ctx, objects)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("objects"), value)...)
+}
+
+// sync uploads every file that has not been uploaded with its current
+// content and settings, and deletes the objects of removed files. It returns
+// the objects that are in the store afterwards, even when it fails part way,
+// so that a later apply only retries what is left.
+func (r *blobDirectoryResource) sync(ctx context.Context, plan BlobDirectoryResourceModel, prior map[string]BlobDirectoryObject) (map[string]BlobDirectoryObject, diag.Diagnostics) {
+	files, diags := plan.localFiles(ctx, prior)
+	if diags.HasError() {
+		return prior, diags
+	}
+
+	result := map[string]BlobDirectoryObject{}
+	var pending []blobDirectoryFile
+	for _, f := range files {
+		if f.Object.uploaded() {
+			result[f.Pathname] = f.Object
+			continue
+		}
+		pending = append(pending, f)
+		// Until it is uploaded again, the store holds the previous upload.
+		if existing, ok := prior[f.Pathname]; ok {
+			result[f.Pathname] = existing
+		}
+	}
+
+	var mu sync.Mutex
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, blobDirectoryUploadConcurrency)
+	for _, f := range pending {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(f blobDirectoryFile) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			object, err := uploadBlobObject(ctx, r.client, BlobObjectResourceModel{
+				CacheControlMaxAge: f.Object.CacheControlMaxAge,
+				ContentType:        f.Object.ContentType,
+				Pathname:           types.StringValue(f.Pathname),
+				Source:             types.StringValue(f.Path),
+				SourceSHA256:       f.Object.SourceSHA256,
+				StoreID:            plan.StoreID,
+				TeamID:             plan.TeamID,
+			})
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				diags.AddError(
+					"Error uploading Blob object",
+					fmt.Sprintf("Could not upload %s to Blob object %s, unexpected error: %s", f.Path, f.Pathname, err),
+				)
+				return
+			}
+			f.Object.URL = types.StringValue(object.URL)
+			f.Object.ETag = types.StringValue(object.ETag)
+			result[f.Pathname] = f.Object
+		}(f)
+	}
+	wg.Wait()
+
+	local := make(map[string]bool, len(files))
+	for _, f := range files {
+		local[f.Pathname] = true
+	}
+	var removed []string
+	for pathname := range prior {
+		if !local[pathname] {
+			removed = append(removed, pathname)
+		}
+	}
+	slices.Sort(removed)
+	if len(removed) > 0 && plan.DeleteRemoved.ValueBool() {
+		err := r.client.DeleteBlobObjects(ctx, plan.StoreID.ValueString(), removed, plan.TeamID.ValueString())
+		if err != nil && !client.NotFound(err) {
+			diags.AddError(
+				"Error deleting Blob objects",
+				fmt.Sprintf("Could not delete Blob objects of removed files, unexpected error: %s", err),
+			)
+			// The objects may still be in the store, so keep managing them.
+			for _, pathname := range removed {
+				result[pathname] = prior[pathname]
+			}
+		}
+	}
+
+	tflog.Info(ctx, "synced blob directory", map[string]any{
+		"store_id": plan.StoreID.ValueString(),
+		"prefix":   plan.Prefix.ValueString(),
+		"uploaded": len(pending),
+		"removed":  len(removed),
+	})
+	return result, diags
+}
+
+func (r *blobDirectoryResource) apply(ctx context.Context, plan BlobDirectoryResourceModel, prior map[string]BlobDirectoryObject) (BlobDirectoryResourceModel, diag.Diagnostics) {
+	objects, diags := r.sync(ctx, plan, prior)
+	value, d := blobDirectoryObjectsValue(ctx, objects)
+	diags.Append(d...)
+
+	plan.ID = types.StringValue(blobObjectID(plan.StoreID.ValueString(), plan.Prefix.ValueString()))
+	plan.TeamID = toTeamID(r.client.TeamID(plan.TeamID.ValueString()))
+	plan.Objects = value
+	return plan, diags
+}
+
+func (r *blobDirectoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BlobDirectoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan, nil)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "created blob directory", map[string]any{
+		"blob_directory_id": result.ID.ValueString(),
+		"store_id":          result.StoreID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read lists the objects under the prefix to check the managed objects are
+// still in the store. Objects that have been deleted are dropped from state,
+// so they are uploaded again.
+func (r *blobDirectoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BlobDirectoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objects, diags := state.objects(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := r.client.ListBlobObjects(ctx, client.ListBlobObjectsRequest{
+		Mode:    "expanded",
+		Prefix:  state.Prefix.ValueString(),
+		StoreID: state.StoreID.ValueString(),
+		TeamID:  state.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Blob directory",
+			fmt.Sprintf("Could not list Blob objects under %q, unexpected error: %s", state.Prefix.ValueString(), err),
+		)
+		return
+	}
+	etags := make(map[string]string, len(list.Blobs))
+	for _, object := range list.Blobs {
+		etags[object.Pathname] = object.ETag
+	}
+
+	for pathname, existing := range objects {
+		etag, ok := etags[pathname]
+		if !ok {
+			delete(objects, pathname)
+			continue
+		}
+		// An object replaced outside Terraform is uploaded again.
+		if existing.ETag.ValueString() != etag {
+			existing.ETag = types.StringValue(etag)
+			existing.SourceSHA256 = types.StringValue("")
+			objects[pathname] = existing
+		}
+	}
+
+	value, diags := blobDirectoryObjectsValue(ctx, objects)
+	resp.Diagnostics.Append(diags...)
+	state.Objects = value
+	tflog.Info(ctx, "read blob directory", map[string]any{
+		"blob_directory_id": state.ID.ValueString(),
+		"store_id":          state.StoreID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *blobDirectoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state BlobDirectoryResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	prior, diags := state.objects(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan, prior)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "updated blob directory", map[string]any{
+		"blob_directory_id": result.ID.ValueString(),
+		"store_id":          result.StoreID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *blobDirectoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BlobDirectoryResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	objects, diags := state.objects(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBlobObjects(ctx, state.StoreID.ValueString(), slices.Sorted(maps.Keys(objects)), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Blob directory",
+			fmt.Sprintf("Could not delete Blob objects of %s, unexpected error: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+	tflog.Info(ctx, "deleted blob directory", map[string]any{
+		"blob_directory_id": state.ID.ValueString(),
+		"objects":           len(objects),
+	})
+}
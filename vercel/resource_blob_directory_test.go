@@ -0,0 +1,113 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestAcc_BlobDirectoryResource(t *testing.T) {
+	storeName := fmt.Sprintf("test-acc-blob-dir-%s", acctest.RandString(16))
+	dir := t.TempDir()
+	writeFile := func(name, content string) {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeFile("index.html", "<html>one</html>")
+	writeFile("assets/app.js", "console.log(1)")
+	writeFile("assets/app.js.map", "{}")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckBlobDirectoryObjectDeleted(testClient(t), testTeam(t), "site/index.html"),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccBlobDirectoryResourceConfig(storeName, dir)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_blob_directory.test", "objects.%", "2"),
+					resource.TestCheckResourceAttrSet("vercel_blob_directory.test", "objects.site/index.html.url"),
+					resource.TestCheckResourceAttr("vercel_blob_directory.test", "objects.site/index.html.content_type", "text/html"),
+					resource.TestCheckResourceAttr("vercel_blob_directory.test", "objects.site/index.html.cache_control_max_age", "60"),
+					resource.TestCheckResourceAttr("vercel_blob_directory.test", "objects.site/assets/app.js.cache_control_max_age", "86400"),
+					resource.TestCheckNoResourceAttr("vercel_blob_directory.test", "objects.site/assets/app.js.map.url"),
+				),
+			},
+			{
+				PreConfig: func() {
+					writeFile("index.html", "<html>two</html>")
+					if err := os.Remove(filepath.Join(dir, "assets", "app.js")); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: cfg(testAccBlobDirectoryResourceConfig(storeName, dir)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_blob_directory.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_blob_directory.test", "objects.%", "1"),
+					resource.TestCheckResourceAttrSet("vercel_blob_directory.test", "objects.site/index.html.etag"),
+					testCheckBlobDirectoryObjectDeleted(testClient(t), testTeam(t), "site/assets/app.js"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckBlobDirectoryObjectDeleted(testClient *client.Client, teamID, pathname string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["vercel_blob_store.test"]
+		if !ok {
+			// The store has been destroyed, and its objects with it.
+			return nil
+		}
+
+		_, err := testClient.GetBlobObject(context.TODO(), client.GetBlobObjectRequest{
+			Pathname: pathname,
+			StoreID:  rs.Primary.ID,
+			TeamID:   teamID,
+		})
+		if err == nil {
+			return fmt.Errorf("expected %s to be deleted, but it still exists", pathname)
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("unexpected error checking for deleted blob object: %s", err)
+		}
+		return nil
+	}
+}
+
+func testAccBlobDirectoryResourceConfig(storeName, dir string) string {
+	return fmt.Sprintf(`
+resource "vercel_blob_store" "test" {
+  name = "%s"
+}
+
+resource "vercel_blob_directory" "test" {
+  store_id              = vercel_blob_store.test.id
+  source                = %s
+  prefix                = "site/"
+  ignore_patterns       = ["*.map"]
+  cache_control_max_age = 86400
+
+  cache_control = [
+    { pattern = "*.html", max_age = 60 },
+  ]
+}
+`, storeName, hclStringLiteral(dir))
+}
@@ -0,0 +1,89 @@
+package vercel
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBlobDirectoryLocalFiles(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+	for name, content := range map[string]string{
+		"index.html":          "<html></html>",
+		"assets/app.js":       "console.log(1)",
+		"assets/module.wasm":  "wasm",
+		"assets/app.js.map":   "{}",
+		"node_modules/x/a.js": "ignored by default",
+		".vercelignore":       "secret.txt\n",
+		"secret.txt":          "ignored by .vercelignore",
+	} {
+		p := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(p), 0o755); err != nil {
+			t.Fatal(err)
+		}
+		if err := os.WriteFile(p, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	plan := BlobDirectoryResourceModel{
+		Source:             types.StringValue(dir),
+		Prefix:             types.StringValue("site/"),
+		IgnorePatterns:     types.ListValueMust(types.StringType, []attr.Value{types.StringValue("*.map")}),
+		ContentTypes:       types.MapValueMust(types.StringType, map[string]attr.Value{".wasm": types.StringValue("application/wasm")}),
+		CacheControlMaxAge: types.Int64Value(3600),
+		CacheControl: []BlobDirectoryCacheControlRule{
+			{Pattern: types.StringValue("*.html"), MaxAge: types.Int64Value(60)},
+			{Pattern: types.StringValue("assets/**"), MaxAge: types.Int64Value(31536000)},
+		},
+	}
+
+	_, indexSHA256, _, err := hashBlobObjectSource(filepath.Join(dir, "index.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// index.html was uploaded before with the same content and settings, so
+	// it is kept as is. app.js was uploaded with different content.
+	prior := map[string]BlobDirectoryObject{
+		"site/index.html": {
+			URL:                types.StringValue("https://example.com/site/index.html"),
+			ETag:               types.StringValue("etag-index"),
+			SourceSHA256:       types.StringValue(indexSHA256),
+			ContentType:        types.StringValue("text/html"),
+			CacheControlMaxAge: types.Int64Value(60),
+		},
+		"site/assets/app.js": {
+			URL:                types.StringValue("https://example.com/site/assets/app.js"),
+			ETag:               types.StringValue("etag-app"),
+			SourceSHA256:       types.StringValue("stale"),
+			ContentType:        types.StringValue("text/javascript"),
+			CacheControlMaxAge: types.Int64Value(31536000),
+		},
+	}
+	files, diags := plan.localFiles(ctx, prior)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	got := map[string]BlobDirectoryObject{}
+	for _, f := range files {
+		got[f.Pathname] = f.Object
+	}
+	if len(got) != 3 {
+		t.Fatalf("expected index.html, app.js and module.wasm to be synced, got %v", got)
+	}
+	if got["site/index.html"] != prior["site/index.html"] {
+		t.Errorf("expected unchanged index.html to keep its upload, got %+v", got["site/index.html"])
+	}
+	if app := got["site/assets/app.js"]; app.uploaded() || app.CacheControlMaxAge.ValueInt64() != 31536000 {
+		t.Errorf("expected changed app.js to be uploaded again with the assets max-age, got %+v", app)
+	}
+	if wasm := got["site/assets/module.wasm"]; wasm.ContentType.ValueString() != "application/wasm" || wasm.uploaded() {
+		t.Errorf("expected module.wasm to be uploaded as application/wasm, got %+v", wasm)
+	}
+}
@@ -83,7 +83,7 @@ func (r *blobObjectResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
-	_, sourceSHA256, etag, err := readBlobObjectSource(plan.Source.ValueString())
+	size, sourceSHA256, md5ETag, err := hashBlobObjectSource(plan.Source.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddAttributeError(
 			path.Root("source"),
@@ -93,15 +93,44 @@ func (r *blobObjectResource) ModifyPlan(ctx context.Context, req resource.Modify
 		return
 	}
 
-	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), types.StringValue(sourceSHA256))...)
-	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("etag"), types.StringValue(etag))...)
-	if resp.Diagnostics.HasError() {
-		return
+	contentType := plan.ContentType
+	if contentType.IsNull() || contentType.IsUnknown() {
+		contentType = types.StringValue(inferBlobObjectContentType(plan.Pathname.ValueString()))
 	}
 
-	if plan.ContentType.IsNull() || plan.ContentType.IsUnknown() {
-		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_type"), types.StringValue(inferBlobObjectContentType(plan.Pathname.ValueString())))...)
+	etag := types.StringValue(md5ETag)
+	if !req.State.Raw.IsNull() {
+		var state BlobObjectResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		// Objects uploaded in parts do not have an MD5 ETag, so it is only
+		// known once the object has been uploaded or copied.
+		if size >= blobObjectMultipartThreshold {
+			etag = types.StringUnknown()
+			unchanged := state.SourceSHA256.ValueString() == sourceSHA256 &&
+				state.Pathname.Equal(plan.Pathname) &&
+				state.ContentType.Equal(contentType) &&
+				state.CacheControlMaxAge.Equal(plan.CacheControlMaxAge)
+			if unchanged {
+				etag = state.ETag
+			}
+		}
+
+		// A new pathname moves the object rather than replacing the resource,
+		// so the ID follows it.
+		if !plan.StoreID.IsUnknown() {
+			resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("id"), types.StringValue(blobObjectID(plan.StoreID.ValueString(), plan.Pathname.ValueString())))...)
+		}
+	} else if size >= blobObjectMultipartThreshold {
+		etag = types.StringUnknown()
 	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("source_sha256"), types.StringValue(sourceSHA256))...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("etag"), etag)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("content_type"), contentType)...)
 }
 
 func (r *blobObjectResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
@@ -110,6 +139,10 @@ func (r *blobObjectResource) Schema(_ context.Context, _ resource.SchemaRequest,
 Provides a Vercel Blob object.
 
 This resource uploads a local file into a Blob store using a deterministic pathname so the object can be managed in place by Terraform.
+
+When only the ` + "`pathname`" + `, ` + "`content_type`" + ` or ` + "`cache_control_max_age`" + ` change, the object is copied on the server instead of being uploaded again. A new ` + "`pathname`" + ` moves the object: it is copied to the new pathname and the object at the old pathname is deleted.
+
+Files of 100 MB or more are streamed to the store in parts using a multipart upload, so they are never held in memory in full. If such an upload fails part way through, the next apply resumes it from the last part uploaded.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -129,9 +162,8 @@ This resource uploads a local file into a Blob store using a deterministic pathn
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
 			},
 			"pathname": schema.StringAttribute{
-				Required:      true,
-				Description:   "The pathname to upload within the Blob store.",
-				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Required:    true,
+				Description: "The pathname to upload within the Blob store. Changing it moves the existing object to the new pathname.",
 				Validators: []validator.String{
 					stringvalidator.LengthAtLeast(1),
 				},
@@ -209,24 +241,7 @@ func (r *blobObjectResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
-	content, _, _, err := readBlobObjectSource(plan.Source.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("source"),
-			"Invalid Blob object source",
-			fmt.Sprintf("Could not read blob object source file: %s", err),
-		)
-		return
-	}
-
-	object, err := r.client.PutBlobObject(ctx, client.PutBlobObjectRequest{
-		Body:               content,
-		CacheControlMaxAge: plan.CacheControlMaxAge.ValueInt64(),
-		ContentType:        plan.ContentType.ValueString(),
-		Pathname:           plan.Pathname.ValueString(),
-		StoreID:            plan.StoreID.ValueString(),
-		TeamID:             plan.TeamID.ValueString(),
-	})
+	object, err := uploadBlobObject(ctx, r.client, plan)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating Blob object",
@@ -288,24 +303,43 @@ func (r *blobObjectResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
-	content, _, _, err := readBlobObjectSource(plan.Source.ValueString())
-	if err != nil {
-		resp.Diagnostics.AddAttributeError(
-			path.Root("source"),
-			"Invalid Blob object source",
-			fmt.Sprintf("Could not read blob object source file: %s", err),
-		)
+	var state BlobObjectResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
 		return
 	}
 
-	object, err := r.client.PutBlobObject(ctx, client.PutBlobObjectRequest{
-		Body:               content,
-		CacheControlMaxAge: plan.CacheControlMaxAge.ValueInt64(),
-		ContentType:        plan.ContentType.ValueString(),
-		Pathname:           plan.Pathname.ValueString(),
-		StoreID:            plan.StoreID.ValueString(),
-		TeamID:             plan.TeamID.ValueString(),
-	})
+	if err := validateManagedBlobObjectPathname(plan.Pathname.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("pathname"), "Invalid Blob object pathname", err.Error())
+		return
+	}
+
+	var object client.BlobObject
+	var err error
+	moved := !plan.Pathname.Equal(state.Pathname)
+	contentChanged := !plan.SourceSHA256.Equal(state.SourceSHA256) || (!plan.ETag.IsUnknown() && !plan.ETag.Equal(state.ETag))
+	switch {
+	case contentChanged:
+		object, err = uploadBlobObject(ctx, r.client, plan)
+	case moved || !plan.ContentType.Equal(state.ContentType) || !plan.CacheControlMaxAge.Equal(state.CacheControlMaxAge):
+		// The content is unchanged, so copy the existing object rather than
+		// uploading it again.
+		object, err = copyBlobObject(ctx, r.client, client.CopyBlobObjectRequest{
+			FromURL:            state.URL.ValueString(),
+			CacheControlMaxAge: plan.CacheControlMaxAge.ValueInt64(),
+			ContentType:        plan.ContentType.ValueString(),
+			Pathname:           plan.Pathname.ValueString(),
+			StoreID:            plan.StoreID.ValueString(),
+			TeamID:             plan.TeamID.ValueString(),
+		})
+	default:
+		object, err = r.client.GetBlobObject(ctx, client.GetBlobObjectRequest{
+			Pathname: plan.Pathname.ValueString(),
+			StoreID:  plan.StoreID.ValueString(),
+			TeamID:   plan.TeamID.ValueString(),
+		})
+	}
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -318,6 +352,16 @@ func (r *blobObjectResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	if moved {
+		err = r.client.DeleteBlobObject(ctx, state.StoreID.ValueString(), state.Pathname.ValueString(), state.TeamID.ValueString())
+		if err != nil && !client.NotFound(err) {
+			resp.Diagnostics.AddWarning(
+				"Error deleting previous Blob object",
+				fmt.Sprintf("Blob object %s was moved to %s, but the object at the previous pathname could not be deleted and is no longer managed by Terraform: %s", state.ID.ValueString(), plan.Pathname.ValueString(), err),
+			)
+		}
+	}
+
 	result := blobObjectResourceModelFromResponse(plan.Source, plan.SourceSHA256, plan.StoreID.ValueString(), r.client.TeamID(plan.TeamID.ValueString()), object)
 	tflog.Info(ctx, "updated blob object", map[string]any{
 		"blob_object_id": result.ID.ValueString(),
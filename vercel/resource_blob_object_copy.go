@@ -0,0 +1,374 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                   = &blobObjectCopyResource{}
+	_ resource.ResourceWithConfigure      = &blobObjectCopyResource{}
+	_ resource.ResourceWithValidateConfig = &blobObjectCopyResource{}
+)
+
+func newBlobObjectCopyResource() resource.Resource {
+	return &blobObjectCopyResource{}
+}
+
+type blobObjectCopyResource struct {
+	client *client.Client
+}
+
+type BlobObjectCopyResourceModel struct {
+	CacheControl       types.String `tfsdk:"cache_control"`
+	CacheControlMaxAge types.Int64  `tfsdk:"cache_control_max_age"`
+	ContentDisposition types.String `tfsdk:"content_disposition"`
+	ContentType        types.String `tfsdk:"content_type"`
+	DownloadURL        types.String `tfsdk:"download_url"`
+	ETag               types.String `tfsdk:"etag"`
+	ID                 types.String `tfsdk:"id"`
+	Pathname           types.String `tfsdk:"pathname"`
+	Size               types.Int64  `tfsdk:"size"`
+	SourcePathname     types.String `tfsdk:"source_pathname"`
+	SourceStoreID      types.String `tfsdk:"source_store_id"`
+	StoreID            types.String `tfsdk:"store_id"`
+	TeamID             types.String `tfsdk:"team_id"`
+	UploadedAt         types.String `tfsdk:"uploaded_at"`
+	URL                types.String `tfsdk:"url"`
+}
+
+func blobObjectCopyResourceModelFromResponse(config BlobObjectCopyResourceModel, teamID string, object client.BlobObject) BlobObjectCopyResourceModel {
+	return BlobObjectCopyResourceModel{
+		CacheControl:       types.StringValue(object.CacheControl),
+		CacheControlMaxAge: types.Int64Value(parseBlobObjectCacheControlMaxAge(object.CacheControl)),
+		ContentDisposition: types.StringValue(object.ContentDisposition),
+		ContentType:        types.StringValue(object.ContentType),
+		DownloadURL:        types.StringValue(object.DownloadURL),
+		ETag:               types.StringValue(object.ETag),
+		ID:                 types.StringValue(blobObjectID(config.StoreID.ValueString(), object.Pathname)),
+		Pathname:           types.StringValue(object.Pathname),
+		Size:               types.Int64Value(object.Size),
+		SourcePathname:     config.SourcePathname,
+		SourceStoreID:      config.SourceStoreID,
+		StoreID:            config.StoreID,
+		TeamID:             toTeamID(teamID),
+		UploadedAt:         types.StringValue(object.UploadedAt),
+		URL:                types.StringValue(object.URL),
+	}
+}
+
+func (r *blobObjectCopyResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_blob_object_copy"
+}
+
+func (r *blobObjectCopyResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *blobObjectCopyResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a copy of a Vercel Blob object.
+
+The object is copied on the server, to another pathname in the same Blob store or to another Blob store owned by the same team, without being downloaded or uploaded again.
+
+~> The copy is taken when the resource is created. Later changes to the source object are not copied; use ` + "`replace_triggered_by`" + ` to copy it again when the source changes.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The unique identifier for the copied Blob object. Format: `store_id/pathname`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"source_store_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the Blob store containing the object to copy.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"source_pathname": schema.StringAttribute{
+				Required:      true,
+				Description:   "The pathname of the object to copy.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"store_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the Blob store to copy the object into. Defaults to `source_store_id`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team that owns the Blob stores. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"pathname": schema.StringAttribute{
+				Required:      true,
+				Description:   "The pathname of the copy. An existing object at this pathname is overwritten.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"content_type": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The content type to store on the copy. Defaults to the content type of the source object.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"cache_control_max_age": schema.Int64Attribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The cache max-age, in seconds, to apply to the copy. Defaults to the cache max-age of the source object.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(60),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The canonical URL for the copied Blob object.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"download_url": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The copied Blob object URL with download semantics enabled.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"size": schema.Int64Attribute{
+				Computed:      true,
+				Description:   "The size of the copied Blob object in bytes.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"uploaded_at": schema.StringAttribute{
+				Computed:    true,
+				Description: "The timestamp at which the copy was last written.",
+			},
+			"content_disposition": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The content disposition returned for the copied Blob object.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"cache_control": schema.StringAttribute{
+				Computed:    true,
+				Description: "The full Cache-Control header stored on the copied Blob object.",
+			},
+			"etag": schema.StringAttribute{
+				Computed:    true,
+				Description: "The current ETag for the copied Blob object.",
+			},
+		},
+	}
+}
+
+func (r *blobObjectCopyResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config BlobObjectCopyResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.Pathname.IsUnknown() || config.Pathname.IsNull() {
+		return
+	}
+	if err := validateManagedBlobObjectPathname(config.Pathname.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("pathname"), "Invalid Blob object pathname", err.Error())
+		return
+	}
+
+	unknown := config.SourcePathname.IsUnknown() || config.SourceStoreID.IsUnknown() || config.StoreID.IsUnknown()
+	sameStore := config.StoreID.IsNull() || config.StoreID.Equal(config.SourceStoreID)
+	if !unknown && sameStore && config.SourcePathname.Equal(config.Pathname) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("pathname"),
+			"Invalid Blob object copy",
+			"The copy must have a different pathname to the source object, or be copied into a different Blob store.",
+		)
+	}
+}
+
+func (r *blobObjectCopyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BlobObjectCopyResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.StoreID.IsUnknown() || plan.StoreID.IsNull() {
+		plan.StoreID = plan.SourceStoreID
+	}
+
+	source, err := r.client.GetBlobObject(ctx, client.GetBlobObjectRequest{
+		Pathname: plan.SourcePathname.ValueString(),
+		StoreID:  plan.SourceStoreID.ValueString(),
+		TeamID:   plan.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Blob object copy",
+			fmt.Sprintf("Could not read source Blob object %s, unexpected error: %s", blobObjectID(plan.SourceStoreID.ValueString(), plan.SourcePathname.ValueString()), err),
+		)
+		return
+	}
+
+	contentType := source.ContentType
+	if !plan.ContentType.IsUnknown() && !plan.ContentType.IsNull() {
+		contentType = plan.ContentType.ValueString()
+	}
+	cacheControlMaxAge := parseBlobObjectCacheControlMaxAge(source.CacheControl)
+	if !plan.CacheControlMaxAge.IsUnknown() && !plan.CacheControlMaxAge.IsNull() {
+		cacheControlMaxAge = plan.CacheControlMaxAge.ValueInt64()
+	}
+
+	object, err := copyBlobObject(ctx, r.client, client.CopyBlobObjectRequest{
+		FromURL:            source.URL,
+		CacheControlMaxAge: cacheControlMaxAge,
+		ContentType:        contentType,
+		Pathname:           plan.Pathname.ValueString(),
+		StoreID:            plan.StoreID.ValueString(),
+		TeamID:             plan.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Blob object copy",
+			fmt.Sprintf("Could not copy Blob object %s to %s, unexpected error: %s", source.Pathname, plan.Pathname.ValueString(), err),
+		)
+		return
+	}
+
+	result := blobObjectCopyResourceModelFromResponse(plan, r.client.TeamID(plan.TeamID.ValueString()), object)
+	tflog.Info(ctx, "created blob object copy", map[string]any{
+		"blob_object_id": result.ID.ValueString(),
+		"source_id":      blobObjectID(plan.SourceStoreID.ValueString(), plan.SourcePathname.ValueString()),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *blobObjectCopyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BlobObjectCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := r.client.GetBlobObject(ctx, client.GetBlobObjectRequest{
+		Pathname: state.Pathname.ValueString(),
+		StoreID:  state.StoreID.ValueString(),
+		TeamID:   state.TeamID.ValueString(),
+	})
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Blob object copy",
+			fmt.Sprintf("Could not read Blob object %s, unexpected error: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	result := blobObjectCopyResourceModelFromResponse(state, r.client.TeamID(state.TeamID.ValueString()), object)
+	tflog.Info(ctx, "read blob object copy", map[string]any{
+		"blob_object_id": result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes the content type or cache max-age of the copy by copying it
+// onto itself.
+func (r *blobObjectCopyResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state BlobObjectCopyResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	object, err := copyBlobObject(ctx, r.client, client.CopyBlobObjectRequest{
+		FromURL:            state.URL.ValueString(),
+		CacheControlMaxAge: plan.CacheControlMaxAge.ValueInt64(),
+		ContentType:        plan.ContentType.ValueString(),
+		Pathname:           state.Pathname.ValueString(),
+		StoreID:            state.StoreID.ValueString(),
+		TeamID:             state.TeamID.ValueString(),
+	})
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Blob object copy",
+			fmt.Sprintf("Could not update Blob object %s, unexpected error: %s", state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	result := blobObjectCopyResourceModelFromResponse(state, r.client.TeamID(state.TeamID.ValueString()), object)
+	tflog.Info(ctx, "updated blob object copy", map[string]any{
+		"blob_object_id": result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *blobObjectCopyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BlobObjectCopyResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteBlobObject(ctx, state.StoreID.ValueString(), state.Pathname.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Blob object copy",
+			fmt.Sprintf("Could not delete Blob object %s, unexpected error: %s", state.ID.ValueString(), err),
+		)
+	}
+}
@@ -0,0 +1,94 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestAcc_BlobObjectCopyResource(t *testing.T) {
+	suffix := acctest.RandString(16)
+	storeName := fmt.Sprintf("test-acc-blob-copy-%s", suffix)
+	otherStoreName := fmt.Sprintf("test-acc-blob-copy-dst-%s", suffix)
+	source := testBlobObjectSourcePath(t, "object-one.txt")
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testCheckBlobObjectDeleted(testClient(t), "vercel_blob_object_copy.same_store", testTeam(t)),
+			testCheckBlobObjectDeleted(testClient(t), "vercel_blob_object_copy.other_store", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccBlobObjectCopyResourceConfig(storeName, otherStoreName, source, 3600)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckBlobObjectExists(testClient(t), testTeam(t), "vercel_blob_object_copy.same_store"),
+					resource.TestCheckResourceAttrPair("vercel_blob_object_copy.same_store", "store_id", "vercel_blob_store.test", "id"),
+					resource.TestCheckResourceAttr("vercel_blob_object_copy.same_store", "pathname", "copies/object.txt"),
+					resource.TestCheckResourceAttr("vercel_blob_object_copy.same_store", "team_id", testTeam(t)),
+					resource.TestCheckResourceAttr("vercel_blob_object_copy.same_store", "content_type", "text/plain"),
+					resource.TestCheckResourceAttr("vercel_blob_object_copy.same_store", "cache_control_max_age", "3600"),
+					resource.TestCheckResourceAttrPair("vercel_blob_object_copy.same_store", "size", "vercel_blob_object.source", "size"),
+					resource.TestCheckResourceAttrSet("vercel_blob_object_copy.same_store", "url"),
+					resource.TestCheckResourceAttrSet("vercel_blob_object_copy.same_store", "etag"),
+
+					testCheckBlobObjectExists(testClient(t), testTeam(t), "vercel_blob_object_copy.other_store"),
+					resource.TestCheckResourceAttrPair("vercel_blob_object_copy.other_store", "store_id", "vercel_blob_store.other", "id"),
+					resource.TestCheckResourceAttr("vercel_blob_object_copy.other_store", "pathname", "terraform/object.txt"),
+					resource.TestCheckResourceAttr("vercel_blob_object_copy.other_store", "content_type", "text/markdown"),
+				),
+			},
+			{
+				Config: cfg(testAccBlobObjectCopyResourceConfig(storeName, otherStoreName, source, 7200)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_blob_object_copy.same_store", plancheck.ResourceActionUpdate),
+						plancheck.ExpectResourceAction("vercel_blob_object_copy.other_store", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_blob_object_copy.same_store", "cache_control_max_age", "7200"),
+					resource.TestCheckResourceAttr("vercel_blob_object_copy.same_store", "cache_control", "public, max-age=7200"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBlobObjectCopyResourceConfig(storeName, otherStoreName, source string, maxAge int) string {
+	return fmt.Sprintf(`
+resource "vercel_blob_store" "test" {
+  name = "%s"
+}
+
+resource "vercel_blob_store" "other" {
+  name = "%s"
+}
+
+resource "vercel_blob_object" "source" {
+  store_id              = vercel_blob_store.test.id
+  pathname              = "terraform/object.txt"
+  source                = %s
+  content_type          = "text/plain"
+  cache_control_max_age = 3600
+}
+
+resource "vercel_blob_object_copy" "same_store" {
+  source_store_id       = vercel_blob_object.source.store_id
+  source_pathname       = vercel_blob_object.source.pathname
+  pathname              = "copies/object.txt"
+  cache_control_max_age = %d
+}
+
+resource "vercel_blob_object_copy" "other_store" {
+  source_store_id = vercel_blob_object.source.store_id
+  source_pathname = vercel_blob_object.source.pathname
+  store_id        = vercel_blob_store.other.id
+  pathname        = "terraform/object.txt"
+  content_type    = "text/markdown"
+}
+`, storeName, otherStoreName, hclStringLiteral(source), maxAge)
+}
@@ -0,0 +1,68 @@
+package vercel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestBlobObjectCopyValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	res := &blobObjectCopyResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	tests := []struct {
+		name     string
+		storeID  types.String
+		pathname string
+		wantErr  bool
+	}{
+		{name: "new pathname in the same store", storeID: types.StringNull(), pathname: "copies/object.txt"},
+		{name: "same pathname in another store", storeID: types.StringValue("store_456"), pathname: "terraform/object.txt"},
+		{name: "same pathname in the same store", storeID: types.StringNull(), pathname: "terraform/object.txt", wantErr: true},
+		{name: "same pathname with the source store set explicitly", storeID: types.StringValue("store_123"), pathname: "terraform/object.txt", wantErr: true},
+		{name: "unknown store is skipped", storeID: types.StringUnknown(), pathname: "terraform/object.txt"},
+		{name: "invalid pathname", storeID: types.StringNull(), pathname: "copies/", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := BlobObjectCopyResourceModel{
+				CacheControl:       types.StringNull(),
+				CacheControlMaxAge: types.Int64Null(),
+				ContentDisposition: types.StringNull(),
+				ContentType:        types.StringNull(),
+				DownloadURL:        types.StringNull(),
+				ETag:               types.StringNull(),
+				ID:                 types.StringNull(),
+				Pathname:           types.StringValue(tt.pathname),
+				Size:               types.Int64Null(),
+				SourcePathname:     types.StringValue("terraform/object.txt"),
+				SourceStoreID:      types.StringValue("store_123"),
+				StoreID:            tt.storeID,
+				TeamID:             types.StringNull(),
+				UploadedAt:         types.StringNull(),
+				URL:                types.StringNull(),
+			}
+
+			raw := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags := raw.Set(ctx, config)
+			if diags.HasError() {
+				t.Fatalf("raw.Set() returned diagnostics: %v", diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			res.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Raw: raw.Raw, Schema: schemaResp.Schema},
+			}, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("HasError() = %v, want %v\n%v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
@@ -64,6 +65,23 @@ func TestAcc_BlobObjectResource(t *testing.T) {
 					resource.TestCheckResourceAttrSet("vercel_blob_object.test", "etag"),
 				),
 			},
+			{
+				Config: cfg(testAccBlobObjectResourceMovedConfig(storeName, sourceTwo)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_blob_object.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckBlobObjectExists(testClient(t), testTeam(t), "vercel_blob_object.test"),
+					testCheckBlobObjectPathnameDeleted(testClient(t), testTeam(t), "vercel_blob_store.test", "terraform/object.txt"),
+					resource.TestCheckResourceAttr("vercel_blob_object.test", "pathname", "terraform/moved.md"),
+					resource.TestCheckResourceAttr("vercel_blob_object.test", "content_type", "text/markdown"),
+					resource.TestCheckResourceAttr("vercel_blob_object.test", "cache_control_max_age", "7200"),
+					resource.TestMatchResourceAttr("vercel_blob_object.test", "id", regexp.MustCompile(`/terraform/moved\.md$`)),
+					resource.TestMatchResourceAttr("vercel_blob_object.test", "url", regexp.MustCompile(`/terraform/moved\.md$`)),
+				),
+			},
 		},
 	})
 }
@@ -100,6 +118,22 @@ resource "vercel_blob_object" "test" {
 `, storeName, hclStringLiteral(source))
 }
 
+func testAccBlobObjectResourceMovedConfig(storeName, source string) string {
+	return fmt.Sprintf(`
+resource "vercel_blob_store" "test" {
+  name = "%s"
+}
+
+resource "vercel_blob_object" "test" {
+  store_id              = vercel_blob_store.test.id
+  pathname              = "terraform/moved.md"
+  source                = %s
+  content_type          = "text/markdown"
+  cache_control_max_age = 7200
+}
+`, storeName, hclStringLiteral(source))
+}
+
 func testCheckBlobObjectExists(testClient *client.Client, teamID, resourceName string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[resourceName]
@@ -161,3 +195,26 @@ func testBlobObjectSourcePath(t *testing.T, filename string) string {
 
 	return filepath.Join(workingDir, "testdata", "blob", filename)
 }
+
+func testCheckBlobObjectPathnameDeleted(testClient *client.Client, teamID, storeResourceName, pathname string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[storeResourceName]
+		if !ok {
+			return fmt.Errorf("not found: %s", storeResourceName)
+		}
+
+		_, err := testClient.GetBlobObject(context.TODO(), client.GetBlobObjectRequest{
+			Pathname: pathname,
+			StoreID:  rs.Primary.ID,
+			TeamID:   teamID,
+		})
+		if err == nil {
+			return fmt.Errorf("expected blob object %s to be deleted, but it still exists", pathname)
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("unexpected error checking for deleted blob object: %s", err)
+		}
+
+		return nil
+	}
+}
@@ -31,11 +31,19 @@ type bulkRedirectsResource struct {
 }
 
 type bulkRedirectsResourceModel struct {
-	ID        types.String `tfsdk:"id"`
-	ProjectID types.String `tfsdk:"project_id"`
-	TeamID    types.String `tfsdk:"team_id"`
-	VersionID types.String `tfsdk:"version_id"`
-	Redirects types.Set    `tfsdk:"redirects"`
+	ID           types.String `tfsdk:"id"`
+	ProjectID    types.String `tfsdk:"project_id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	Promote      types.Bool   `tfsdk:"promote"`
+	VersionID    types.String `tfsdk:"version_id"`
+	PreviewAlias types.String `tfsdk:"preview_alias"`
+	Redirects    types.Set    `tfsdk:"redirects"`
+}
+
+// promote reports whether changes are promoted to production. State written
+// before promote existed has it unset, which means they are.
+func (m bulkRedirectsResourceModel) promote() bool {
+	return m.Promote.IsNull() || m.Promote.IsUnknown() || m.Promote.ValueBool()
 }
 
 func (r *bulkRedirectsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -66,6 +74,9 @@ Provides a Bulk Redirects resource.
 
 This resource manages the live project-level bulk redirects for a Vercel project.
 Each apply stages the configured redirect set and promotes that version to production.
+
+Set ` + "`promote`" + ` to ` + "`false`" + ` to only stage the redirect set without publishing it, including when the resource is destroyed.
+The redirects are then read from the staged version, and ` + "`version_id`" + ` and ` + "`preview_alias`" + ` identify the draft so it can be reviewed and published with ` + "`vercel_bulk_redirects_promotion`" + `.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -83,9 +94,19 @@ Each apply stages the configured redirect set and promotes that version to produ
 				Description:   "The ID of the Vercel team.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
 			},
+			"promote": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to promote the staged redirects to production. When `false`, the redirects are only staged and can be published with `vercel_bulk_redirects_promotion`. Defaults to `true`.",
+			},
 			"version_id": schema.StringAttribute{
 				Computed:    true,
-				Description: "The ID of the live bulk redirects version managed by this resource.",
+				Description: "The ID of the bulk redirects version managed by this resource. This is the staged version when `promote` is `false` and the redirects have not been published yet.",
+			},
+			"preview_alias": schema.StringAttribute{
+				Computed:    true,
+				Description: "The preview alias of the staged bulk redirects version, if any, for testing the redirects before they are published.",
 			},
 			"redirects": schema.SetNestedAttribute{
 				Description: "The full set of live bulk redirects for the project.",
@@ -129,17 +150,26 @@ Each apply stages the configured redirect set and promotes that version to produ
 	}
 }
 
-func bulkRedirectsResourceStateFromResponse(response client.BulkRedirects) bulkRedirectsResourceModel {
+func bulkRedirectsResourceStateFromResponse(response client.BulkRedirects, promote bool) bulkRedirectsResourceModel {
+	previewAlias := types.StringNull()
+	if response.Version != nil {
+		previewAlias = stringValueOrNull(response.Version.Alias)
+	}
+
 	return bulkRedirectsResourceModel{
-		ID:        types.StringValue(response.ProjectID),
-		ProjectID: types.StringValue(response.ProjectID),
-		TeamID:    toTeamID(response.TeamID),
-		VersionID: bulkRedirectVersionID(response.Version),
-		Redirects: flattenBulkRedirects(response.Redirects),
+		ID:           types.StringValue(response.ProjectID),
+		ProjectID:    types.StringValue(response.ProjectID),
+		TeamID:       toTeamID(response.TeamID),
+		Promote:      types.BoolValue(promote),
+		VersionID:    bulkRedirectVersionID(response.Version),
+		PreviewAlias: previewAlias,
+		Redirects:    flattenBulkRedirects(response.Redirects),
 	}
 }
 
-func (r *bulkRedirectsResource) applyBulkRedirects(ctx context.Context, projectID, teamID string, redirects []client.BulkRedirect) (client.BulkRedirects, error) {
+// applyBulkRedirects stages the redirects and, unless promote is false,
+// promotes the staged version.
+func (r *bulkRedirectsResource) applyBulkRedirects(ctx context.Context, projectID, teamID string, redirects []client.BulkRedirect, promote bool) (client.BulkRedirects, error) {
 	stagedVersion, err := r.client.StageBulkRedirects(ctx, client.StageBulkRedirectsRequest{
 		ProjectID: projectID,
 		TeamID:    teamID,
@@ -150,27 +180,33 @@ func (r *bulkRedirectsResource) applyBulkRedirects(ctx context.Context, projectI
 		return client.BulkRedirects{}, err
 	}
 
-	liveVersion, err := r.client.UpdateBulkRedirectVersion(ctx, client.UpdateBulkRedirectVersionRequest{
-		ProjectID: projectID,
-		TeamID:    teamID,
-		VersionID: stagedVersion.ID,
-		Action:    "promote",
-	})
-	if err != nil {
-		return client.BulkRedirects{}, err
+	version := stagedVersion
+	if promote {
+		version, err = r.client.UpdateBulkRedirectVersion(ctx, client.UpdateBulkRedirectVersionRequest{
+			ProjectID: projectID,
+			TeamID:    teamID,
+			VersionID: stagedVersion.ID,
+			Action:    "promote",
+		})
+		if err != nil {
+			return client.BulkRedirects{}, err
+		}
 	}
 
 	response, err := r.client.GetBulkRedirects(ctx, client.GetBulkRedirectsRequest{
 		ProjectID: projectID,
 		TeamID:    teamID,
-		VersionID: liveVersion.ID,
+		VersionID: version.ID,
 	})
 	if err != nil {
 		return client.BulkRedirects{}, err
 	}
 
 	if response.Version == nil {
-		response.Version = &liveVersion
+		response.Version = &version
+	}
+	if response.Version.Alias == nil {
+		response.Version.Alias = version.Alias
 	}
 
 	return response, nil
@@ -206,7 +242,7 @@ func (r *bulkRedirectsResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	out, err := r.applyBulkRedirects(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), redirects)
+	out, err := r.applyBulkRedirects(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), redirects, plan.promote())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating bulk redirects",
@@ -215,7 +251,7 @@ func (r *bulkRedirectsResource) Create(ctx context.Context, req resource.CreateR
 		return
 	}
 
-	result := bulkRedirectsResourceStateFromResponse(out)
+	result := bulkRedirectsResourceStateFromResponse(out, plan.promote())
 	tflog.Info(ctx, "created bulk redirects", map[string]any{
 		"project_id": result.ProjectID.ValueString(),
 		"team_id":    result.TeamID.ValueString(),
@@ -233,7 +269,7 @@ func (r *bulkRedirectsResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	out, ok, err := readLiveBulkRedirects(ctx, r.client, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	out, ok, err := readBulkRedirects(ctx, r.client, state.ProjectID.ValueString(), state.TeamID.ValueString(), !state.promote())
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -250,7 +286,7 @@ func (r *bulkRedirectsResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	result := bulkRedirectsResourceStateFromResponse(out)
+	result := bulkRedirectsResourceStateFromResponse(out, state.promote())
 	tflog.Info(ctx, "read bulk redirects", map[string]any{
 		"project_id": result.ProjectID.ValueString(),
 		"team_id":    result.TeamID.ValueString(),
@@ -274,7 +310,7 @@ func (r *bulkRedirectsResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	out, err := r.applyBulkRedirects(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), redirects)
+	out, err := r.applyBulkRedirects(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), redirects, plan.promote())
 	if client.NotFound(err) {
 		resp.State.RemoveResource(ctx)
 		return
@@ -287,7 +323,7 @@ func (r *bulkRedirectsResource) Update(ctx context.Context, req resource.UpdateR
 		return
 	}
 
-	result := bulkRedirectsResourceStateFromResponse(out)
+	result := bulkRedirectsResourceStateFromResponse(out, plan.promote())
 	tflog.Info(ctx, "updated bulk redirects", map[string]any{
 		"project_id": result.ProjectID.ValueString(),
 		"team_id":    result.TeamID.ValueString(),
@@ -305,7 +341,7 @@ func (r *bulkRedirectsResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	_, err := r.applyBulkRedirects(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), []client.BulkRedirect{})
+	_, err := r.applyBulkRedirects(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), []client.BulkRedirect{}, state.promote())
 	if client.NotFound(err) {
 		return
 	}
@@ -350,7 +386,7 @@ func (r *bulkRedirectsResource) ImportState(ctx context.Context, req resource.Im
 		return
 	}
 
-	result := bulkRedirectsResourceStateFromResponse(out)
+	result := bulkRedirectsResourceStateFromResponse(out, true)
 	tflog.Info(ctx, "imported bulk redirects", map[string]any{
 		"project_id": result.ProjectID.ValueString(),
 		"team_id":    result.TeamID.ValueString(),
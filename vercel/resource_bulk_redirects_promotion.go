@@ -0,0 +1,222 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource              = &bulkRedirectsPromotionResource{}
+	_ resource.ResourceWithConfigure = &bulkRedirectsPromotionResource{}
+)
+
+func newBulkRedirectsPromotionResource() resource.Resource {
+	return &bulkRedirectsPromotionResource{}
+}
+
+type bulkRedirectsPromotionResource struct {
+	client *client.Client
+}
+
+type BulkRedirectsPromotion struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	VersionID types.String `tfsdk:"version_id"`
+	IsLive    types.Bool   `tfsdk:"is_live"`
+}
+
+func (r *bulkRedirectsPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_bulk_redirects_promotion"
+}
+
+func (r *bulkRedirectsPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *bulkRedirectsPromotionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Promotes a bulk redirects version to production.
+
+Use this together with a ` + "`vercel_bulk_redirects`" + ` resource that sets ` + "`promote = false`" + `, so changes are staged and reviewed, for example through its ` + "`preview_alias`" + `, before they are published. A staged version is promoted, and an earlier version is restored, which allows rolling back.
+
+The version is promoted when the resource is created, or when ` + "`version_id`" + ` changes. Promoting another version outside Terraform is not treated as drift.
+
+~> Each apply of the staging resource creates a new draft, so ` + "`version_id`" + ` must be the ` + "`version_id`" + ` of the ` + "`vercel_bulk_redirects`" + ` resource after its last apply. Reference the attribute rather than copying its value, so that the latest draft is promoted.
+
+Deleting this resource only removes it from Terraform state. The promoted version stays live.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the promoted version.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the project the redirects belong to.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"version_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the bulk redirects version to promote, such as the `version_id` of a `vercel_bulk_redirects` resource.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"is_live": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the version is currently live.",
+			},
+		},
+	}
+}
+
+func findBulkRedirectVersionByID(versions []client.BulkRedirectVersion, versionID string) (client.BulkRedirectVersion, bool) {
+	for _, version := range versions {
+		if version.ID == versionID {
+			return version, true
+		}
+	}
+	return client.BulkRedirectVersion{}, false
+}
+
+func (r *bulkRedirectsPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan BulkRedirectsPromotion
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	versionID := plan.VersionID.ValueString()
+
+	versions, err := r.client.GetBulkRedirectVersions(ctx, projectID, plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error promoting bulk redirects",
+			fmt.Sprintf("Could not get bulk redirects versions of project %s, unexpected error: %s", projectID, err),
+		)
+		return
+	}
+	version, ok := findBulkRedirectVersionByID(versions, versionID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("version_id"),
+			"Bulk redirects version not found",
+			fmt.Sprintf("Version %s of the bulk redirects of project %s could not be found.", versionID, projectID),
+		)
+		return
+	}
+
+	if !version.IsLive {
+		_, err = r.client.UpdateBulkRedirectVersion(ctx, client.UpdateBulkRedirectVersionRequest{
+			ProjectID: projectID,
+			TeamID:    plan.TeamID.ValueString(),
+			VersionID: versionID,
+			Action:    versionPromotionAction(version.IsStaging),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error promoting bulk redirects",
+				fmt.Sprintf("Could not promote bulk redirects version %s of project %s, unexpected error: %s", versionID, projectID, err),
+			)
+			return
+		}
+	}
+
+	result := BulkRedirectsPromotion{
+		ID:        types.StringValue(versionID),
+		ProjectID: plan.ProjectID,
+		TeamID:    toTeamID(r.client.TeamID(plan.TeamID.ValueString())),
+		VersionID: plan.VersionID,
+		IsLive:    types.BoolValue(true),
+	}
+	tflog.Info(ctx, "promoted bulk redirects version", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": projectID,
+		"version_id": versionID,
+		"action":     versionPromotionAction(version.IsStaging),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+// Read refreshes whether the version is still live. The promotion happened
+// once, so a later promotion of another version is not drift.
+func (r *bulkRedirectsPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state BulkRedirectsPromotion
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := r.client.GetBulkRedirectVersions(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading bulk redirects promotion",
+			fmt.Sprintf("Could not get bulk redirects versions of project %s, unexpected error: %s", state.ProjectID.ValueString(), err),
+		)
+		return
+	}
+	version, ok := findBulkRedirectVersionByID(versions, state.VersionID.ValueString())
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.IsLive = types.BoolValue(version.IsLive)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *bulkRedirectsPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating a Bulk Redirects Promotion is not supported. If you see this error, this is a bug in the provider.",
+		"Updating a Bulk Redirects Promotion is not supported. If you see this error, this is a bug in the provider.",
+	)
+}
+
+// Delete leaves the promoted version live.
+func (r *bulkRedirectsPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state BulkRedirectsPromotion
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "removed bulk redirects promotion from state", map[string]any{
+		"project_id": state.ProjectID.ValueString(),
+		"version_id": state.VersionID.ValueString(),
+	})
+}
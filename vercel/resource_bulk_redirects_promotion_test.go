@@ -0,0 +1,68 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_BulkRedirectsPromotion(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), "vercel_project.example", testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccBulkRedirectsPromotionConfig(nameSuffix, false)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_bulk_redirects.example", "promote", "false"),
+					resource.TestCheckResourceAttr("vercel_bulk_redirects.example", "redirects.#", "1"),
+					resource.TestCheckResourceAttrSet("vercel_bulk_redirects.example", "version_id"),
+					testAccBulkRedirectsEmpty(testClient(t), "vercel_project.example", testTeam(t)),
+				),
+			},
+			{
+				Config: cfg(testAccBulkRedirectsPromotionConfig(nameSuffix, true)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccBulkRedirectsExists(testClient(t), "vercel_bulk_redirects.example", testTeam(t)),
+					resource.TestCheckResourceAttrPair("vercel_bulk_redirects_promotion.example", "version_id", "vercel_bulk_redirects.example", "version_id"),
+					resource.TestCheckResourceAttr("vercel_bulk_redirects_promotion.example", "is_live", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccBulkRedirectsPromotionConfig(projectName string, promoted bool) string {
+	promotion := ""
+	if promoted {
+		promotion = `
+resource "vercel_bulk_redirects_promotion" "example" {
+	project_id = vercel_project.example.id
+	version_id = vercel_bulk_redirects.example.version_id
+}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-example-project-%s"
+}
+
+resource "vercel_bulk_redirects" "example" {
+	project_id = vercel_project.example.id
+	promote    = false
+	redirects = [
+		{
+			source      = "/old-path"
+			destination = "/new-path"
+			status_code = 307
+		},
+	]
+}
+%s`, projectName, promotion)
+}
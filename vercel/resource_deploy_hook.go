@@ -0,0 +1,281 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                = &deployHookResource{}
+	_ resource.ResourceWithConfigure   = &deployHookResource{}
+	_ resource.ResourceWithImportState = &deployHookResource{}
+)
+
+func newDeployHookResource() resource.Resource {
+	return &deployHookResource{}
+}
+
+type deployHookResource struct {
+	client *client.Client
+}
+
+func (r *deployHookResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_deploy_hook"
+}
+
+func (r *deployHookResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *deployHookResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Deploy Hook resource.
+
+Deploy hooks are unique URLs that allow you to trigger a deployment of a given branch. The project must be connected to a Git repository.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/deployments/deploy-hooks).
+
+~> Deploy hooks can be managed either with this resource, or inline with the ` + "`git_repository.deploy_hooks`" + ` attribute of a ` + "`vercel_project`" + `. Do not use both for the same project: inline deploy hooks are authoritative, and any hook not declared there will be removed.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the deploy hook.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the project to create the deploy hook for.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the deploy hook.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"ref": schema.StringAttribute{
+				Required:      true,
+				Description:   "The branch or commit hash that should be deployed.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"url": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "A URL that, when a POST request is made to, will trigger a new deployment.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+		},
+	}
+}
+
+// DeployHookResourceModel reflects the state terraform stores internally for a deploy hook.
+type DeployHookResourceModel struct {
+	ID        types.String `tfsdk:"id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	Name      types.String `tfsdk:"name"`
+	Ref       types.String `tfsdk:"ref"`
+	URL       types.String `tfsdk:"url"`
+}
+
+func convertResponseToDeployHook(response client.DeployHook, projectID, teamID string) DeployHookResourceModel {
+	return DeployHookResourceModel{
+		ID:        types.StringValue(response.ID),
+		TeamID:    toTeamID(teamID),
+		ProjectID: types.StringValue(projectID),
+		Name:      types.StringValue(response.Name),
+		Ref:       types.StringValue(response.Ref),
+		URL:       types.StringValue(response.URL),
+	}
+}
+
+func (r *deployHookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DeployHookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	out, err := r.client.CreateDeployHook(ctx, client.CreateDeployHookRequest{
+		ProjectID: plan.ProjectID.ValueString(),
+		TeamID:    teamID,
+		Name:      plan.Name.ValueString(),
+		Ref:       plan.Ref.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating deploy hook",
+			"Could not create deploy hook, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result := convertResponseToDeployHook(out, plan.ProjectID.ValueString(), teamID)
+	tflog.Info(ctx, "created deploy hook", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"hook_id":    result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *deployHookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DeployHookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID := r.client.TeamID(state.TeamID.ValueString())
+	out, err := r.client.GetDeployHook(ctx, client.GetDeployHookRequest{
+		ProjectID: state.ProjectID.ValueString(),
+		TeamID:    teamID,
+		ID:        state.ID.ValueString(),
+	})
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading deploy hook",
+			fmt.Sprintf("Could not get deploy hook %s %s, unexpected error: %s",
+				state.ProjectID.ValueString(),
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDeployHook(out, state.ProjectID.ValueString(), teamID)
+	tflog.Info(ctx, "read deploy hook", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"hook_id":    result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update is never called in practice, as every configurable attribute requires replacement.
+func (r *deployHookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DeployHookResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *deployHookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DeployHookResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDeployHook(ctx, client.DeleteDeployHookRequest{
+		ProjectID: state.ProjectID.ValueString(),
+		TeamID:    state.TeamID.ValueString(),
+		ID:        state.ID.ValueString(),
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting deploy hook",
+			fmt.Sprintf(
+				"Could not delete deploy hook %s, unexpected error: %s",
+				state.ID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted deploy hook", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+		"hook_id":    state.ID.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads the deploy hook from the Vercel API.
+func (r *deployHookResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, hookID, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing deploy hook",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/deploy_hook_id\" or \"project_id/deploy_hook_id\"", req.ID),
+		)
+		return
+	}
+
+	teamID = r.client.TeamID(teamID)
+	out, err := r.client.GetDeployHook(ctx, client.GetDeployHookRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		ID:        hookID,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading deploy hook",
+			fmt.Sprintf("Could not get deploy hook %s %s, unexpected error: %s",
+				projectID,
+				hookID,
+				err,
+			),
+		)
+		return
+	}
+
+	result := convertResponseToDeployHook(out, projectID, teamID)
+	tflog.Info(ctx, "import deploy hook", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"hook_id":    result.ID.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,89 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func testCheckDeployHookExists(testClient *client.Client, teamID string, n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		_, err := testClient.GetDeployHook(context.TODO(), client.GetDeployHookRequest{
+			ProjectID: rs.Primary.Attributes["project_id"],
+			TeamID:    teamID,
+			ID:        rs.Primary.ID,
+		})
+		return err
+	}
+}
+
+func TestAcc_DeployHookResource(t *testing.T) {
+	projectSuffix := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testAccProjectDestroy(testClient(t), "vercel_project.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccDeployHookConfig(projectSuffix, testGithubRepo(t), "main")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckDeployHookExists(testClient(t), testTeam(t), "vercel_deploy_hook.test"),
+					resource.TestCheckResourceAttrSet("vercel_deploy_hook.test", "id"),
+					resource.TestCheckResourceAttrSet("vercel_deploy_hook.test", "url"),
+					resource.TestCheckResourceAttr("vercel_deploy_hook.test", "name", "test-hook"),
+					resource.TestCheckResourceAttr("vercel_deploy_hook.test", "ref", "main"),
+				),
+			},
+			{
+				ResourceName:      "vercel_deploy_hook.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: func(s *terraform.State) (string, error) {
+					rs, ok := s.RootModule().Resources["vercel_deploy_hook.test"]
+					if !ok {
+						return "", fmt.Errorf("not found: vercel_deploy_hook.test")
+					}
+					return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+				},
+			},
+			{
+				Config: cfg(testAccDeployHookConfig(projectSuffix, testGithubRepo(t), "staging")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckDeployHookExists(testClient(t), testTeam(t), "vercel_deploy_hook.test"),
+					resource.TestCheckResourceAttr("vercel_deploy_hook.test", "ref", "staging"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDeployHookConfig(projectSuffix, githubRepo, ref string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-deploy-hook-%[1]s"
+  git_repository = {
+    type = "github"
+    repo = "%[2]s"
+  }
+}
+
+resource "vercel_deploy_hook" "test" {
+  project_id = vercel_project.test.id
+  name       = "test-hook"
+  ref        = "%[3]s"
+}
+`, projectSuffix, githubRepo, ref)
+}
@@ -25,6 +25,8 @@ var (
 	_ resource.Resource                   = &dnsRecordResource{}
 	_ resource.ResourceWithConfigure      = &dnsRecordResource{}
 	_ resource.ResourceWithValidateConfig = &dnsRecordResource{}
+	_ resource.ResourceWithImportState    = &dnsRecordResource{}
+	_ resource.ResourceWithIdentity       = &dnsRecordResource{}
 )
 
 func newDNSRecordResource() resource.Resource {
@@ -64,7 +66,7 @@ Provides a DNS Record resource.
 
 DNS records are instructions that live in authoritative DNS servers and provide information about a domain.
 
-~> The ` + "`value` field" + ` must be specified on all DNS record types except ` + "`SRV`" + `. When using ` + "`SRV`" + ` DNS records, the ` + "`srv`" + ` field must be specified.
+~> The ` + "`value` field" + ` must be specified on all DNS record types except ` + "`SRV`" + ` and ` + "`HTTPS`" + `. When using ` + "`SRV`" + ` DNS records, the ` + "`srv`" + ` field must be specified, and when using ` + "`HTTPS`" + ` DNS records, the ` + "`https`" + ` field must be specified.
 
 For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/custom-domains#dns-records)
         `,
@@ -79,7 +81,7 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
 			},
 			"domain": schema.StringAttribute{
-				Description:   "The domain name, or zone, that the DNS record should be created beneath.",
+				Description:   "The domain name, or zone, that the DNS record should be created beneath. Reference the `name` of a `vercel_domain` to ensure the domain has been added before the record is created.",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				Required:      true,
 			},
@@ -88,15 +90,15 @@ For more detailed information, please see the [Vercel documentation](https://ver
 				Required:    true,
 			},
 			"type": schema.StringAttribute{
-				Description:   "The type of DNS record. Available types: " + "`A`" + ", " + "`AAAA`" + ", " + "`ALIAS`" + ", " + "`CAA`" + ", " + "`CNAME`" + ", " + "`MX`" + ", " + "`NS`" + ", " + "`SRV`" + ", " + "`TXT`" + ".",
+				Description:   "The type of DNS record. Available types: " + "`A`" + ", " + "`AAAA`" + ", " + "`ALIAS`" + ", " + "`CAA`" + ", " + "`CNAME`" + ", " + "`HTTPS`" + ", " + "`MX`" + ", " + "`NS`" + ", " + "`SRV`" + ", " + "`TXT`" + ".",
 				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
 				Required:      true,
 				Validators: []validator.String{
-					stringvalidator.OneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "MX", "NS", "SRV", "TXT"),
+					stringvalidator.OneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "HTTPS", "MX", "NS", "SRV", "TXT"),
 				},
 			},
 			"value": schema.StringAttribute{
-				// required if any record type apart from SRV.
+				// required if any record type apart from SRV and HTTPS.
 				Description: "The value of the DNS record. The format depends on the 'type' property.\nFor an 'A' record, this should be a valid IPv4 address.\nFor an 'AAAA' record, this should be an IPv6 address.\nFor 'ALIAS' records, this should be a hostname.\nFor 'CAA' records, this should specify specify which Certificate Authorities (CAs) are allowed to issue certificates for the domain.\nFor 'CNAME' records, this should be a different domain name.\nFor 'MX' records, this should specify the mail server responsible for accepting messages on behalf of the domain name.\nFor 'TXT' records, this can contain arbitrary text.",
 				Optional:    true,
 			},
@@ -160,10 +162,37 @@ For more detailed information, please see the [Vercel documentation](https://ver
 					},
 				},
 			},
+			"https": schema.SingleNestedAttribute{
+				Description: "Settings for an HTTPS record.",
+				Optional:    true, // required for HTTPS records.
+				Attributes: map[string]schema.Attribute{
+					"priority": schema.Int64Attribute{
+						Description: "The priority of the record. A priority of 0 makes the record an alias to `target`, while higher values describe the service endpoint with `params`.",
+						Required:    true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(0),
+							int64validator.AtMost(65535),
+						},
+					},
+					"target": schema.StringAttribute{
+						Description: "The hostname of the service endpoint, or `.` to use the record's own name.",
+						Required:    true,
+					},
+					"params": schema.StringAttribute{
+						Description: "The service parameters of the record, for example `alpn=h2,h3`.",
+						Optional:    true,
+					},
+				},
+			},
 		},
 	}
 }
 
+// IdentitySchema returns the identity schema for a DNS Record resource.
+func (r *dnsRecordResource) IdentitySchema(_ context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = teamResourceIdentitySchema("The ID of the DNS Record.")
+}
+
 // SRV reflect the state terraform stores internally for a nested SRV Record.
 type SRV struct {
 	Port     types.Int64  `tfsdk:"port"`
@@ -172,6 +201,21 @@ type SRV struct {
 	Weight   types.Int64  `tfsdk:"weight"`
 }
 
+// HTTPSRecord reflects the state terraform stores internally for the https block of a DNS Record.
+type HTTPSRecord struct {
+	Priority types.Int64  `tfsdk:"priority"`
+	Target   types.String `tfsdk:"target"`
+	Params   types.String `tfsdk:"params"`
+}
+
+var httpsAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"priority": types.Int64Type,
+		"target":   types.StringType,
+		"params":   types.StringType,
+	},
+}
+
 var srvAttrType = types.ObjectType{
 	AttrTypes: map[string]attr.Type{
 		"port":     types.Int64Type,
@@ -188,6 +232,7 @@ type DNSRecord struct {
 	MXPriority types.Int64  `tfsdk:"mx_priority"`
 	Name       types.String `tfsdk:"name"`
 	SRV        types.Object `tfsdk:"srv"`
+	HTTPS      types.Object `tfsdk:"https"`
 	TTL        types.Int64  `tfsdk:"ttl"`
 	TeamID     types.String `tfsdk:"team_id"`
 	Type       types.String `tfsdk:"type"`
@@ -195,6 +240,13 @@ type DNSRecord struct {
 	Comment    types.String `tfsdk:"comment"`
 }
 
+func (d DNSRecord) identity() TeamResourceIdentity {
+	return TeamResourceIdentity{
+		TeamID: d.TeamID,
+		ID:     d.ID,
+	}
+}
+
 func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
 	var srv *client.SRV = nil
 	if d.Type.ValueString() == "SRV" {
@@ -210,6 +262,11 @@ func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
 		}
 	}
 
+	var https *client.HTTPS = nil
+	if d.Type.ValueString() == "HTTPS" {
+		https = httpsObjectToRequest(d.HTTPS)
+	}
+
 	return client.CreateDNSRecordRequest{
 		Domain:     d.Domain.ValueString(),
 		MXPriority: d.MXPriority.ValueInt64(),
@@ -218,10 +275,43 @@ func (d DNSRecord) toCreateDNSRecordRequest() client.CreateDNSRecordRequest {
 		Type:       d.Type.ValueString(),
 		Value:      d.Value.ValueString(),
 		SRV:        srv,
+		HTTPS:      https,
 		Comment:    d.Comment.ValueString(),
 	}
 }
 
+// httpsObjectToRequest converts an https block into its API representation, or nil if it is not set.
+func httpsObjectToRequest(obj types.Object) *client.HTTPS {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	var h HTTPSRecord
+	_ = obj.As(context.Background(), &h, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+	return &client.HTTPS{
+		Priority: h.Priority.ValueInt64(),
+		Target:   h.Target.ValueString(),
+		Params:   h.Params.ValueString(),
+	}
+}
+
+// parseHTTPSRecordValue splits the value the API returns for an HTTPS record, in the form
+// '{priority} {target} {params...}', back into its parts.
+func parseHTTPSRecordValue(value string) (client.HTTPS, error) {
+	split := strings.SplitN(value, " ", 3)
+	if len(split) < 2 {
+		return client.HTTPS{}, fmt.Errorf("expected a value '{priority} {target} {params}', but got %s", value)
+	}
+	priority, err := strconv.ParseInt(split[0], 10, 64)
+	if err != nil {
+		return client.HTTPS{}, fmt.Errorf("expected HTTPS record priority to be an int, but got %s", split[0])
+	}
+	https := client.HTTPS{Priority: priority, Target: split[1]}
+	if len(split) == 3 {
+		https.Params = strings.TrimSpace(split[2])
+	}
+	return https, nil
+}
+
 func (d DNSRecord) toUpdateRequest() client.UpdateDNSRecordRequest {
 	var srv *client.SRVUpdate = nil
 	if !d.SRV.IsNull() && !d.SRV.IsUnknown() {
@@ -248,13 +338,14 @@ func (d DNSRecord) toUpdateRequest() client.UpdateDNSRecordRequest {
 		MXPriority: d.MXPriority.ValueInt64Pointer(),
 		Name:       d.Name.ValueStringPointer(),
 		SRV:        srv,
+		HTTPS:      httpsObjectToRequest(d.HTTPS),
 		TTL:        ttlPtr,
 		Value:      d.Value.ValueStringPointer(),
 		Comment:    d.Comment.ValueString(),
 	}
 }
 
-func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srvObj types.Object) (record DNSRecord, err error) {
+func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srvObj, httpsObj types.Object) (record DNSRecord, err error) {
 	record = DNSRecord{
 		Domain:     types.StringValue(r.Domain),
 		ID:         types.StringValue(r.ID),
@@ -264,6 +355,38 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srvObj t
 		TeamID:     toTeamID(r.TeamID),
 		Type:       types.StringValue(r.RecordType),
 		Comment:    types.StringValue(r.Comment),
+		HTTPS:      types.ObjectNull(httpsAttrType.AttrTypes),
+	}
+
+	if r.RecordType == "HTTPS" {
+		https, err := parseHTTPSRecordValue(r.Value)
+		if err != nil {
+			return record, err
+		}
+		targetVal := types.StringValue(https.Target)
+		paramsVal := types.StringNull()
+		if https.Params != "" {
+			paramsVal = types.StringValue(https.Params)
+		}
+		// Preserve user formatting for target (without trailing dot) and an empty params string
+		if !httpsObj.IsNull() && !httpsObj.IsUnknown() {
+			var h HTTPSRecord
+			_ = httpsObj.As(context.Background(), &h, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+			if fmt.Sprintf("%s.", h.Target.ValueString()) == targetVal.ValueString() {
+				targetVal = h.Target
+			}
+			if h.Params.ValueString() == https.Params {
+				paramsVal = h.Params
+			}
+		}
+		record.HTTPS = types.ObjectValueMust(httpsAttrType.AttrTypes, map[string]attr.Value{
+			"priority": types.Int64Value(https.Priority),
+			"target":   targetVal,
+			"params":   paramsVal,
+		})
+		record.Value = types.StringNull()
+		record.SRV = types.ObjectNull(srvAttrType.AttrTypes)
+		return record, nil
 	}
 
 	if r.RecordType == "SRV" {
@@ -338,6 +461,36 @@ func convertResponseToDNSRecord(r client.DNSRecord, value types.String, srvObj t
 	return record, nil
 }
 
+// dnsRecordConfigErrors checks that the attributes set on a DNS record are consistent with its type.
+// It is shared between the vercel_dns_record and vercel_dns_zone resources.
+func dnsRecordConfigErrors(recordType string, value types.String, srv, https types.Object, mxPriority types.Int64) (problems []string) {
+	if recordType == "SRV" && (srv.IsNull() || srv.IsUnknown()) {
+		problems = append(problems, "A DNS Record type of 'SRV' requires the `srv` attribute to be set")
+	}
+	if recordType == "HTTPS" && (https.IsNull() || https.IsUnknown()) {
+		problems = append(problems, "A DNS Record type of 'HTTPS' requires the `https` attribute to be set")
+	}
+	if recordType != "SRV" && recordType != "HTTPS" && value.IsNull() {
+		problems = append(problems, fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", recordType))
+	}
+	if (recordType == "SRV" || recordType == "HTTPS") && !value.IsNull() {
+		problems = append(problems, fmt.Sprintf("The `value` attribute should not be set on records of `type` '%s'", recordType))
+	}
+	if recordType != "HTTPS" && !https.IsNull() && !https.IsUnknown() {
+		problems = append(problems, "The `https` attribute should only be set on records of `type` 'HTTPS'")
+	}
+	if recordType != "SRV" && !srv.IsNull() && !srv.IsUnknown() {
+		problems = append(problems, "The `srv` attribute should only be set on records of `type` 'SRV'")
+	}
+	if recordType != "MX" && !mxPriority.IsNull() {
+		problems = append(problems, "The `mx_priority` attribute should only be set on records of `type` 'MX'")
+	}
+	if recordType == "MX" && mxPriority.IsNull() {
+		problems = append(problems, "A DNS Record type of 'MX' requires the `mx_priority` attribute to be set")
+	}
+	return problems
+}
+
 // ValidateConfig validates the Resource configuration.
 func (r *dnsRecordResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
 	var config DNSRecord
@@ -347,46 +500,8 @@ func (r *dnsRecordResource) ValidateConfig(ctx context.Context, req resource.Val
 		return
 	}
 
-	if config.Type.ValueString() == "SRV" && (config.SRV.IsNull() || config.SRV.IsUnknown()) {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"A DNS Record type of 'SRV' requires the `srv` attribute to be set",
-		)
-	}
-
-	if config.Type.ValueString() != "SRV" && config.Value.IsNull() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			fmt.Sprintf("The `value` attribute must be set on records of `type` '%s'", config.Type.ValueString()),
-		)
-	}
-
-	if config.Type.ValueString() == "SRV" && !config.Value.IsNull() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"The `value` attribute should not be set on records of `type` 'SRV'",
-		)
-	}
-
-	if config.Type.ValueString() != "SRV" && !config.SRV.IsNull() && !config.SRV.IsUnknown() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"The `srv` attribute should only be set on records of `type` 'SRV'",
-		)
-	}
-
-	if config.Type.ValueString() != "MX" && !config.MXPriority.IsNull() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"The `mx_priority` attribute should only be set on records of `type` 'MX'",
-		)
-	}
-
-	if config.Type.ValueString() == "MX" && config.MXPriority.IsNull() {
-		resp.Diagnostics.AddError(
-			"DNS Record Invalid",
-			"A DNS Record type of 'MX' requires the `mx_priority` attribute to be set",
-		)
+	for _, message := range dnsRecordConfigErrors(config.Type.ValueString(), config.Value, config.SRV, config.HTTPS, config.MXPriority) {
+		resp.Diagnostics.AddError("DNS Record Invalid", message)
 	}
 }
 
@@ -409,7 +524,7 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV)
+	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV, plan.HTTPS)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -428,6 +543,8 @@ func (r *dnsRecordResource) Create(ctx context.Context, req resource.CreateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read will read a DNS record from the vercel API and provide terraform with information about it.
@@ -457,7 +574,7 @@ func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, state.Value, state.SRV)
+	result, err := convertResponseToDNSRecord(out, state.Value, state.SRV, state.HTTPS)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -476,6 +593,8 @@ func (r *dnsRecordResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update will update a DNS record via the vercel API.
@@ -513,7 +632,7 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV)
+	result, err := convertResponseToDNSRecord(out, plan.Value, plan.SRV, plan.HTTPS)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error parsing DNS Record response",
@@ -532,6 +651,8 @@ func (r *dnsRecordResource) Update(ctx context.Context, req resource.UpdateReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete a DNS record from within terraform.
@@ -570,11 +691,17 @@ func (r *dnsRecordResource) Delete(ctx context.Context, req resource.DeleteReque
 
 // ImportState takes an identifier and reads all the DNS Record information from the Vercel API.
 func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	teamID, recordID, ok := splitInto1Or2(req.ID)
+	importID, diags := importIDFromRequest(ctx, req, "team_id", "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, recordID, ok := splitInto1Or2(importID)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Error importing DNS Record",
-			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/record_id\" or \"record_id\"", req.ID),
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/record_id\" or \"record_id\"", importID),
 		)
 		return
 	}
@@ -592,7 +719,7 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
-	result, err := convertResponseToDNSRecord(out, types.String{}, types.ObjectNull(srvAttrType.AttrTypes))
+	result, err := convertResponseToDNSRecord(out, types.String{}, types.ObjectNull(srvAttrType.AttrTypes), types.ObjectNull(httpsAttrType.AttrTypes))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error processing DNS Record response",
@@ -605,9 +732,11 @@ func (r *dnsRecordResource) ImportState(ctx context.Context, req resource.Import
 		return
 	}
 
-	diags := resp.State.Set(ctx, result)
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,71 @@
+package vercel
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestConvertResponseToDNSRecordHTTPS(t *testing.T) {
+	planned := types.ObjectValueMust(httpsAttrType.AttrTypes, map[string]attr.Value{
+		"priority": types.Int64Value(1),
+		"target":   types.StringValue("svc.example.com"),
+		"params":   types.StringNull(),
+	})
+	record, err := convertResponseToDNSRecord(client.DNSRecord{
+		ID:         "rec_123",
+		Domain:     "example.com",
+		Name:       "svc",
+		RecordType: "HTTPS",
+		TTL:        60,
+		Value:      "1 svc.example.com.",
+	}, types.StringNull(), types.ObjectNull(srvAttrType.AttrTypes), planned)
+	if err != nil {
+		t.Fatalf("convertResponseToDNSRecord() error = %v", err)
+	}
+	if !record.HTTPS.Equal(planned) {
+		t.Errorf("https = %s, want the planned value %s", record.HTTPS, planned)
+	}
+	if !record.Value.IsNull() || !record.SRV.IsNull() {
+		t.Errorf("value = %s, srv = %s, want both null", record.Value, record.SRV)
+	}
+
+	record, err = convertResponseToDNSRecord(client.DNSRecord{
+		Name:       "svc",
+		RecordType: "HTTPS",
+		Value:      "1 . alpn=h2,h3 port=443",
+	}, types.StringNull(), types.ObjectNull(srvAttrType.AttrTypes), types.ObjectNull(httpsAttrType.AttrTypes))
+	if err != nil {
+		t.Fatalf("convertResponseToDNSRecord() error = %v", err)
+	}
+	want := types.ObjectValueMust(httpsAttrType.AttrTypes, map[string]attr.Value{
+		"priority": types.Int64Value(1),
+		"target":   types.StringValue("."),
+		"params":   types.StringValue("alpn=h2,h3 port=443"),
+	})
+	if !record.HTTPS.Equal(want) {
+		t.Errorf("https = %s, want %s", record.HTTPS, want)
+	}
+}
+
+func TestDNSRecordConfigErrorsHTTPS(t *testing.T) {
+	https := types.ObjectValueMust(httpsAttrType.AttrTypes, map[string]attr.Value{
+		"priority": types.Int64Value(1),
+		"target":   types.StringValue("."),
+		"params":   types.StringNull(),
+	})
+	noSRV := types.ObjectNull(srvAttrType.AttrTypes)
+	noHTTPS := types.ObjectNull(httpsAttrType.AttrTypes)
+
+	if problems := dnsRecordConfigErrors("HTTPS", types.StringNull(), noSRV, https, types.Int64Null()); len(problems) != 0 {
+		t.Errorf("valid HTTPS record has problems: %v", problems)
+	}
+	if problems := dnsRecordConfigErrors("HTTPS", types.StringValue("1 ."), noSRV, noHTTPS, types.Int64Null()); len(problems) != 2 {
+		t.Errorf("HTTPS record with value and no https block: problems = %v, want 2", problems)
+	}
+	if problems := dnsRecordConfigErrors("A", types.StringValue("127.0.0.1"), noSRV, https, types.Int64Null()); len(problems) != 1 {
+		t.Errorf("A record with https block: problems = %v, want 1", problems)
+	}
+}
@@ -0,0 +1,962 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                     = &dnsZoneResource{}
+	_ resource.ResourceWithConfigure        = &dnsZoneResource{}
+	_ resource.ResourceWithConfigValidators = &dnsZoneResource{}
+	_ resource.ResourceWithImportState      = &dnsZoneResource{}
+	_ resource.ResourceWithModifyPlan       = &dnsZoneResource{}
+	_ resource.ResourceWithValidateConfig   = &dnsZoneResource{}
+)
+
+func newDNSZoneResource() resource.Resource {
+	return &dnsZoneResource{}
+}
+
+type dnsZoneResource struct {
+	client *client.Client
+}
+
+func (r *dnsZoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_dns_zone"
+}
+
+func (r *dnsZoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *dnsZoneResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.ExactlyOneOf(
+			path.MatchRoot("records"),
+			path.MatchRoot("zone_file"),
+		),
+	}
+}
+
+func (r *dnsZoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a DNS Zone resource.
+
+A DNS Zone manages every DNS record on a domain as a single resource. Records that exist on the domain but are not declared are deleted. Changes are applied one record at a time, with deletes first, then updates, then creates. If a change fails partway through, the records that were already changed are kept in state and the remaining changes are retried on the next apply.
+
+Records can either be declared individually with the ` + "`records`" + ` attribute, or provided as a BIND zone file with the ` + "`zone_file`" + ` attribute.
+
+~> This resource is authoritative. Do not use it alongside ` + "`vercel_dns_record`" + ` resources for the same domain, as any record not declared here will be removed.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/concepts/projects/custom-domains#dns-records)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of this resource. This is the same as the domain.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The team ID that the domain and DNS records belong to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"domain": schema.StringAttribute{
+				Required:      true,
+				Description:   "The domain name, or zone, whose DNS records should be managed. Reference the `name` of a `vercel_domain` to ensure the domain has been added before any records are created.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"ignore_system_records": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether records that Vercel creates and manages automatically, such as the apex `ALIAS` and `CAA` records, should be ignored. When `false`, such records must be declared or they will be deleted. Defaults to `true`.",
+			},
+			"zone_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "The DNS records for the domain, as an RFC 1035 (BIND) zone file. `SOA` records are ignored. Conflicts with `records`.",
+			},
+			"exported_zone_file": schema.StringAttribute{
+				Computed:    true,
+				Description: "The DNS records currently on the domain, rendered as an RFC 1035 (BIND) zone file.",
+			},
+			"records": schema.SetNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The DNS records that should exist on the domain. Computed from `zone_file` when that is used instead.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "The subdomain name of the record. This should be an empty string if the record is for the root domain.",
+						},
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The type of DNS record. Available types: `A`, `AAAA`, `ALIAS`, `CAA`, `CNAME`, `HTTPS`, `MX`, `NS`, `SRV`, `TXT`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("A", "AAAA", "ALIAS", "CAA", "CNAME", "HTTPS", "MX", "NS", "SRV", "TXT"),
+							},
+						},
+						"value": schema.StringAttribute{
+							Optional:    true,
+							Description: "The value of the DNS record. The format depends on the `type`, and matches the `value` of a `vercel_dns_record`. Required for all types except `SRV` and `HTTPS`.",
+						},
+						"ttl": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The TTL value in seconds. Must be a number between 60 and 2147483647. If unspecified, it will default to 60 seconds.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(60),
+								int64validator.AtMost(2147483647),
+							},
+						},
+						"mx_priority": schema.Int64Attribute{
+							Optional:    true,
+							Description: "The priority of the MX record. Required for `MX` records. A smaller value indicates a higher priority.",
+							Validators: []validator.Int64{
+								int64validator.AtLeast(0),
+								int64validator.AtMost(65535),
+							},
+						},
+						"comment": schema.StringAttribute{
+							Optional:    true,
+							Description: "A comment explaining what the DNS record is for.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 500),
+							},
+						},
+						"srv": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Settings for an SRV record. Required for `SRV` records.",
+							Attributes: map[string]schema.Attribute{
+								"weight": schema.Int64Attribute{
+									Required:    true,
+									Description: "A relative weight for records with the same priority, higher value means higher chance of getting picked.",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+								"port": schema.Int64Attribute{
+									Required:    true,
+									Description: "The TCP or UDP port on which the service is to be found.",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+								"priority": schema.Int64Attribute{
+									Required:    true,
+									Description: "The priority of the target host, lower value means more preferred.",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+								"target": schema.StringAttribute{
+									Required:    true,
+									Description: "The canonical hostname of the machine providing the service.",
+								},
+							},
+						},
+						"https": schema.SingleNestedAttribute{
+							Optional:    true,
+							Description: "Settings for an HTTPS record. Required for `HTTPS` records.",
+							Attributes: map[string]schema.Attribute{
+								"priority": schema.Int64Attribute{
+									Required:    true,
+									Description: "The priority of the record. A priority of 0 makes the record an alias to `target`.",
+									Validators: []validator.Int64{
+										int64validator.AtLeast(0),
+										int64validator.AtMost(65535),
+									},
+								},
+								"target": schema.StringAttribute{
+									Required:    true,
+									Description: "The hostname of the service endpoint, or `.` to use the record's own name.",
+								},
+								"params": schema.StringAttribute{
+									Optional:    true,
+									Description: "The service parameters of the record, for example `alpn=h2,h3`.",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// DNSZone reflects the state terraform stores internally for a DNS zone.
+type DNSZone struct {
+	ID                  types.String `tfsdk:"id"`
+	TeamID              types.String `tfsdk:"team_id"`
+	Domain              types.String `tfsdk:"domain"`
+	IgnoreSystemRecords types.Bool   `tfsdk:"ignore_system_records"`
+	ZoneFile            types.String `tfsdk:"zone_file"`
+	ExportedZoneFile    types.String `tfsdk:"exported_zone_file"`
+	Records             types.Set    `tfsdk:"records"`
+}
+
+// DNSZoneRecord is a single record within a DNS zone.
+type DNSZoneRecord struct {
+	Name       types.String `tfsdk:"name"`
+	Type       types.String `tfsdk:"type"`
+	Value      types.String `tfsdk:"value"`
+	TTL        types.Int64  `tfsdk:"ttl"`
+	MXPriority types.Int64  `tfsdk:"mx_priority"`
+	Comment    types.String `tfsdk:"comment"`
+	SRV        types.Object `tfsdk:"srv"`
+	HTTPS      types.Object `tfsdk:"https"`
+}
+
+var dnsZoneRecordAttrType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"name":        types.StringType,
+		"type":        types.StringType,
+		"value":       types.StringType,
+		"ttl":         types.Int64Type,
+		"mx_priority": types.Int64Type,
+		"comment":     types.StringType,
+		"srv":         srvAttrType,
+		"https":       httpsAttrType,
+	},
+}
+
+// defaultDNSRecordTTL is the TTL Vercel applies to a record when none is specified.
+const defaultDNSRecordTTL = 60
+
+func (z DNSZone) records(ctx context.Context) (records []DNSZoneRecord, diags diag.Diagnostics) {
+	if z.Records.IsNull() || z.Records.IsUnknown() {
+		return nil, nil
+	}
+	diags = z.Records.ElementsAs(ctx, &records, false)
+	return records, diags
+}
+
+func (r DNSZoneRecord) toCreateDNSRecordRequest(ctx context.Context, domain string) (client.CreateDNSRecordRequest, diag.Diagnostics) {
+	request := client.CreateDNSRecordRequest{
+		Domain:     domain,
+		Name:       r.Name.ValueString(),
+		Type:       r.Type.ValueString(),
+		Value:      r.Value.ValueString(),
+		TTL:        r.TTL.ValueInt64(),
+		MXPriority: r.MXPriority.ValueInt64(),
+		Comment:    r.Comment.ValueString(),
+	}
+	if !r.SRV.IsNull() && !r.SRV.IsUnknown() {
+		var srv SRV
+		diags := r.SRV.As(ctx, &srv, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+		if diags.HasError() {
+			return request, diags
+		}
+		request.SRV = &client.SRV{
+			Port:     srv.Port.ValueInt64(),
+			Priority: srv.Priority.ValueInt64(),
+			Target:   srv.Target.ValueString(),
+			Weight:   srv.Weight.ValueInt64(),
+		}
+	}
+	request.HTTPS = httpsObjectToRequest(r.HTTPS)
+	return request, nil
+}
+
+func dnsZoneRecordFromRequest(request client.CreateDNSRecordRequest) DNSZoneRecord {
+	record := DNSZoneRecord{
+		Name:       types.StringValue(request.Name),
+		Type:       types.StringValue(request.Type),
+		Value:      types.StringValue(request.Value),
+		TTL:        types.Int64Null(),
+		MXPriority: types.Int64Null(),
+		Comment:    types.StringNull(),
+		SRV:        types.ObjectNull(srvAttrType.AttrTypes),
+		HTTPS:      types.ObjectNull(httpsAttrType.AttrTypes),
+	}
+	if request.TTL != 0 {
+		record.TTL = types.Int64Value(request.TTL)
+	}
+	if request.Comment != "" {
+		record.Comment = types.StringValue(request.Comment)
+	}
+	if request.Type == "MX" {
+		record.MXPriority = types.Int64Value(request.MXPriority)
+	}
+	if request.Type == "SRV" && request.SRV != nil {
+		record.Value = types.StringNull()
+		record.SRV = types.ObjectValueMust(srvAttrType.AttrTypes, map[string]attr.Value{
+			"port":     types.Int64Value(request.SRV.Port),
+			"priority": types.Int64Value(request.SRV.Priority),
+			"target":   types.StringValue(request.SRV.Target),
+			"weight":   types.Int64Value(request.SRV.Weight),
+		})
+	}
+	if request.Type == "HTTPS" && request.HTTPS != nil {
+		params := types.StringNull()
+		if request.HTTPS.Params != "" {
+			params = types.StringValue(request.HTTPS.Params)
+		}
+		record.Value = types.StringNull()
+		record.HTTPS = types.ObjectValueMust(httpsAttrType.AttrTypes, map[string]attr.Value{
+			"priority": types.Int64Value(request.HTTPS.Priority),
+			"target":   types.StringValue(request.HTTPS.Target),
+			"params":   params,
+		})
+	}
+	return record
+}
+
+// dnsZoneRequestFromResponse converts a record returned by ListDNSRecords into the same shape
+// as a record declared in configuration. The list endpoint returns the MX and SRV priorities in
+// their own fields, so the value holds only the host, or the SRV weight, port and target.
+func dnsZoneRequestFromResponse(r client.DNSRecord) (client.CreateDNSRecordRequest, error) {
+	request := client.CreateDNSRecordRequest{
+		Domain:  r.Domain,
+		Name:    r.Name,
+		Type:    r.RecordType,
+		Value:   r.Value,
+		TTL:     r.TTL,
+		Comment: r.Comment,
+	}
+	fields := strings.Fields(r.Value)
+	switch r.RecordType {
+	case "MX":
+		if len(fields) != 1 {
+			return request, fmt.Errorf("expected an MX value of '{host}', but got %s", r.Value)
+		}
+		request.MXPriority = r.MXPriority
+	case "SRV":
+		if len(fields) != 3 {
+			return request, fmt.Errorf("expected an SRV value of '{weight} {port} {target}', but got %s", r.Value)
+		}
+		var parts [2]int64
+		for i := range parts {
+			v, err := strconv.ParseInt(fields[i], 10, 64)
+			if err != nil {
+				return request, fmt.Errorf("expected SRV weight and port to be ints, but got %s", r.Value)
+			}
+			parts[i] = v
+		}
+		request.Value = ""
+		request.SRV = &client.SRV{Priority: r.Priority, Weight: parts[0], Port: parts[1], Target: fields[2]}
+	case "HTTPS":
+		https, err := parseHTTPSRecordValue(r.Value)
+		if err != nil {
+			return request, err
+		}
+		request.Value = ""
+		request.HTTPS = &https
+	}
+	return request, nil
+}
+
+// dnsZoneRecordKey identifies a record by everything that cannot be changed in place. Records
+// with the same key are updated, rather than replaced, when their TTL or comment differs.
+func dnsZoneRecordKey(r client.CreateDNSRecordRequest) string {
+	value := r.Value
+	switch r.Type {
+	case "ALIAS", "CNAME", "MX", "NS":
+		value = strings.ToLower(strings.TrimSuffix(value, "."))
+	case "SRV":
+		if r.SRV != nil {
+			value = fmt.Sprintf("%d %d %d %s", r.SRV.Priority, r.SRV.Weight, r.SRV.Port, strings.ToLower(strings.TrimSuffix(r.SRV.Target, ".")))
+		}
+	case "HTTPS":
+		if r.HTTPS != nil {
+			target := r.HTTPS.Target
+			if target != "." {
+				target = strings.ToLower(strings.TrimSuffix(target, "."))
+			}
+			value = fmt.Sprintf("%d %s %s", r.HTTPS.Priority, target, r.HTTPS.Params)
+		}
+	}
+	return fmt.Sprintf("%s|%s|%s|%d", strings.ToLower(r.Name), r.Type, value, r.MXPriority)
+}
+
+func dnsZoneRecordTTL(r client.CreateDNSRecordRequest) int64 {
+	if r.TTL == 0 {
+		return defaultDNSRecordTTL
+	}
+	return r.TTL
+}
+
+// dnsZoneRecordsMatch reports whether an existing record already satisfies a desired one.
+func dnsZoneRecordsMatch(desired, existing client.CreateDNSRecordRequest) bool {
+	return dnsZoneRecordKey(desired) == dnsZoneRecordKey(existing) &&
+		dnsZoneRecordTTL(desired) == dnsZoneRecordTTL(existing) &&
+		desired.Comment == existing.Comment
+}
+
+// matchDNSZoneRecords pairs each desired record with an existing record that has the same key.
+// The result holds, for each desired record, the index of its existing record or -1.
+func matchDNSZoneRecords(desired, existing []client.CreateDNSRecordRequest) []int {
+	available := map[string][]int{}
+	for i, e := range existing {
+		key := dnsZoneRecordKey(e)
+		available[key] = append(available[key], i)
+	}
+
+	matches := make([]int, len(desired))
+	for i := range matches {
+		matches[i] = -1
+	}
+	// Prefer exact matches first, so an unchanged record is never mistaken for one needing an update.
+	for _, exact := range []bool{true, false} {
+		for i, d := range desired {
+			if matches[i] != -1 {
+				continue
+			}
+			key := dnsZoneRecordKey(d)
+			for n, j := range available[key] {
+				if exact && !dnsZoneRecordsMatch(d, existing[j]) {
+					continue
+				}
+				matches[i] = j
+				available[key] = append(available[key][:n:n], available[key][n+1:]...)
+				break
+			}
+		}
+	}
+	return matches
+}
+
+type dnsZoneUpdate struct {
+	RecordID string
+	Request  client.UpdateDNSRecordRequest
+}
+
+// dnsZoneChanges are the operations needed to make the records on a domain match the desired records.
+type dnsZoneChanges struct {
+	Create []client.CreateDNSRecordRequest
+	Update []dnsZoneUpdate
+	Delete []client.DNSRecord
+}
+
+func (c dnsZoneChanges) empty() bool {
+	return len(c.Create) == 0 && len(c.Update) == 0 && len(c.Delete) == 0
+}
+
+func diffDNSZoneRecords(desired []client.CreateDNSRecordRequest, existing []client.DNSRecord) (changes dnsZoneChanges, err error) {
+	existingRequests := make([]client.CreateDNSRecordRequest, 0, len(existing))
+	for _, e := range existing {
+		request, err := dnsZoneRequestFromResponse(e)
+		if err != nil {
+			return changes, err
+		}
+		existingRequests = append(existingRequests, request)
+	}
+
+	matches := matchDNSZoneRecords(desired, existingRequests)
+	matched := make([]bool, len(existing))
+	for i, d := range desired {
+		j := matches[i]
+		if j == -1 {
+			changes.Create = append(changes.Create, d)
+			continue
+		}
+		matched[j] = true
+		if dnsZoneRecordsMatch(d, existingRequests[j]) {
+			continue
+		}
+		ttl := dnsZoneRecordTTL(d)
+		changes.Update = append(changes.Update, dnsZoneUpdate{
+			RecordID: existing[j].ID,
+			Request: client.UpdateDNSRecordRequest{
+				TTL:     &ttl,
+				Comment: d.Comment,
+			},
+		})
+	}
+	for j, e := range existing {
+		if !matched[j] {
+			changes.Delete = append(changes.Delete, e)
+		}
+	}
+	return changes, nil
+}
+
+// reconcileDNSZoneRecords converts the records on a domain into terraform records. Where a
+// record matches one in the prior state, the prior value is kept so that formatting differences,
+// such as an omitted TTL or a trailing dot, do not cause a diff.
+func reconcileDNSZoneRecords(ctx context.Context, domain string, prior []DNSZoneRecord, existing []client.DNSRecord) ([]DNSZoneRecord, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	priorRequests := make([]client.CreateDNSRecordRequest, 0, len(prior))
+	for _, p := range prior {
+		request, d := p.toCreateDNSRecordRequest(ctx, domain)
+		diags.Append(d...)
+		priorRequests = append(priorRequests, request)
+	}
+	existingRequests := make([]client.CreateDNSRecordRequest, 0, len(existing))
+	for _, e := range existing {
+		request, err := dnsZoneRequestFromResponse(e)
+		if err != nil {
+			diags.AddError("Error reading DNS zone", fmt.Sprintf("Could not parse DNS record %s: %s", e.ID, err))
+			continue
+		}
+		existingRequests = append(existingRequests, request)
+	}
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	records := make([]DNSZoneRecord, 0, len(existingRequests))
+	used := make([]bool, len(existingRequests))
+	for i, j := range matchDNSZoneRecords(priorRequests, existingRequests) {
+		if j == -1 || !dnsZoneRecordsMatch(priorRequests[i], existingRequests[j]) {
+			continue
+		}
+		used[j] = true
+		records = append(records, prior[i])
+	}
+	for j, e := range existingRequests {
+		if !used[j] {
+			records = append(records, dnsZoneRecordFromRequest(e))
+		}
+	}
+	return records, diags
+}
+
+func dnsZoneRecordsSet(ctx context.Context, records []DNSZoneRecord) (types.Set, diag.Diagnostics) {
+	return types.SetValueFrom(ctx, dnsZoneRecordAttrType, records)
+}
+
+// listManagedDNSRecords lists the records on a domain that the zone is responsible for.
+func (r *dnsZoneResource) listManagedDNSRecords(ctx context.Context, domain, teamID string, ignoreSystemRecords bool) ([]client.DNSRecord, error) {
+	records, err := r.client.ListDNSRecords(ctx, domain, teamID)
+	if err != nil {
+		return nil, err
+	}
+	managed := make([]client.DNSRecord, 0, len(records))
+	for _, record := range records {
+		if ignoreSystemRecords && record.Creator == "system" {
+			continue
+		}
+		managed = append(managed, record)
+	}
+	return managed, nil
+}
+
+func (r *dnsZoneResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config DNSZone
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The zone file is parsed relative to the domain, which is often a reference to a vercel_domain
+	// resource and so not known until apply.
+	if !config.ZoneFile.IsNull() && !config.ZoneFile.IsUnknown() && !config.Domain.IsUnknown() {
+		if _, err := client.ParseZoneFile(config.Domain.ValueString(), strings.NewReader(config.ZoneFile.ValueString())); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("zone_file"),
+				"Invalid zone file",
+				fmt.Sprintf("The zone file could not be parsed: %s", err),
+			)
+		}
+	}
+
+	if config.Records.IsNull() || config.Records.IsUnknown() {
+		return
+	}
+	for _, element := range config.Records.Elements() {
+		object, ok := element.(types.Object)
+		if !ok || object.IsUnknown() {
+			continue
+		}
+		var record DNSZoneRecord
+		diags := object.As(ctx, &record, basetypes.ObjectAsOptions{UnhandledNullAsEmpty: true, UnhandledUnknownAsEmpty: true})
+		resp.Diagnostics.Append(diags...)
+		if diags.HasError() || record.Type.IsUnknown() {
+			continue
+		}
+		for _, message := range dnsRecordConfigErrors(record.Type.ValueString(), record.Value, record.SRV, record.HTTPS, record.MXPriority) {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("records").AtSetValue(object),
+				"DNS Record Invalid",
+				fmt.Sprintf("%s (record %q of type %s)", message, record.Name.ValueString(), record.Type.ValueString()),
+			)
+		}
+	}
+}
+
+// ModifyPlan computes the records from the zone file, when one is used, so that the plan shows
+// exactly which records will change.
+func (r *dnsZoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.ZoneFile.IsNull() && !plan.ZoneFile.IsUnknown() && !plan.Domain.IsUnknown() {
+		requests, err := client.ParseZoneFile(plan.Domain.ValueString(), strings.NewReader(plan.ZoneFile.ValueString()))
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("zone_file"),
+				"Invalid zone file",
+				fmt.Sprintf("The zone file could not be parsed: %s", err),
+			)
+			return
+		}
+		records := make([]DNSZoneRecord, 0, len(requests))
+		for _, request := range requests {
+			records = append(records, dnsZoneRecordFromRequest(request))
+		}
+		plan.Records, diags = dnsZoneRecordsSet(ctx, records)
+		resp.Diagnostics.Append(diags...)
+	}
+
+	plan.ExportedZoneFile = types.StringUnknown()
+	if !req.State.Raw.IsNull() {
+		var state DNSZone
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if plan.Records.Equal(state.Records) && plan.IgnoreSystemRecords.Equal(state.IgnoreSystemRecords) {
+			plan.ExportedZoneFile = state.ExportedZoneFile
+		}
+	}
+
+	diags = resp.Plan.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// apply makes the records on the domain match the plan, and returns the resulting state. If any
+// change fails, the state reflects the records that actually exist on the domain.
+func (r *dnsZoneResource) apply(ctx context.Context, plan DNSZone) (DNSZone, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	domain := plan.Domain.ValueString()
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	ignoreSystemRecords := plan.IgnoreSystemRecords.ValueBool()
+
+	planned, d := plan.records(ctx)
+	diags.Append(d...)
+	desired := make([]client.CreateDNSRecordRequest, 0, len(planned))
+	for _, record := range planned {
+		request, d := record.toCreateDNSRecordRequest(ctx, domain)
+		diags.Append(d...)
+		desired = append(desired, request)
+	}
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	existing, err := r.listManagedDNSRecords(ctx, domain, teamID, ignoreSystemRecords)
+	if err != nil {
+		diags.AddError(
+			"Error reading DNS zone",
+			fmt.Sprintf("Could not list DNS records for %s, unexpected error: %s", domain, err),
+		)
+		return plan, diags
+	}
+	changes, err := diffDNSZoneRecords(desired, existing)
+	if err != nil {
+		diags.AddError(
+			"Error reading DNS zone",
+			fmt.Sprintf("Could not parse DNS records for %s, unexpected error: %s", domain, err),
+		)
+		return plan, diags
+	}
+	tflog.Info(ctx, "applying DNS zone changes", map[string]any{
+		"domain":  domain,
+		"team_id": teamID,
+		"create":  len(changes.Create),
+		"update":  len(changes.Update),
+		"delete":  len(changes.Delete),
+	})
+
+	// Deletes go first, so that a record being replaced (e.g. a CNAME) does not conflict
+	// with its replacement.
+	applyErr := func() error {
+		for _, record := range changes.Delete {
+			err := r.client.DeleteDNSRecord(ctx, domain, record.ID, teamID)
+			if err != nil && !client.NotFound(err) {
+				return fmt.Errorf("deleting %s record %q: %w", record.RecordType, record.Name, err)
+			}
+		}
+		for _, update := range changes.Update {
+			if _, err := r.client.UpdateDNSRecord(ctx, teamID, update.RecordID, update.Request); err != nil {
+				return fmt.Errorf("updating record %s: %w", update.RecordID, err)
+			}
+		}
+		for _, request := range changes.Create {
+			if _, err := r.client.CreateDNSRecord(ctx, teamID, request); err != nil {
+				return fmt.Errorf("creating %s record %q: %w", request.Type, request.Name, err)
+			}
+		}
+		return nil
+	}()
+
+	result := DNSZone{
+		ID:                  types.StringValue(domain),
+		TeamID:              toTeamID(teamID),
+		Domain:              plan.Domain,
+		IgnoreSystemRecords: plan.IgnoreSystemRecords,
+		ZoneFile:            plan.ZoneFile,
+		Records:             plan.Records,
+	}
+	current, err := r.listManagedDNSRecords(ctx, domain, teamID, ignoreSystemRecords)
+	if err != nil {
+		diags.AddError(
+			"Error reading DNS zone",
+			fmt.Sprintf("Could not list DNS records for %s, unexpected error: %s", domain, err),
+		)
+		return result, diags
+	}
+	result.ExportedZoneFile = types.StringValue(client.RenderZoneFile(domain, current))
+
+	if applyErr != nil {
+		diags.AddError(
+			"Error applying DNS zone",
+			fmt.Sprintf("Could not update the DNS records for %s, unexpected error: %s", domain, applyErr),
+		)
+		records, d := reconcileDNSZoneRecords(ctx, domain, planned, current)
+		diags.Append(d...)
+		result.Records, d = dnsZoneRecordsSet(ctx, records)
+		diags.Append(d...)
+	}
+	return result, diags
+}
+
+func (r *dnsZoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if result.ExportedZoneFile.IsUnknown() || result.ExportedZoneFile.IsNull() {
+		return
+	}
+	tflog.Info(ctx, "created DNS zone", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dnsZoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state DNSZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := state.Domain.ValueString()
+	teamID := r.client.TeamID(state.TeamID.ValueString())
+	current, err := r.listManagedDNSRecords(ctx, domain, teamID, state.IgnoreSystemRecords.ValueBool())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			fmt.Sprintf("Could not list DNS records for %s, unexpected error: %s", domain, err),
+		)
+		return
+	}
+
+	prior, diags := state.records(ctx)
+	resp.Diagnostics.Append(diags...)
+	records, diags := reconcileDNSZoneRecords(ctx, domain, prior, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Records, diags = dnsZoneRecordsSet(ctx, records)
+	resp.Diagnostics.Append(diags...)
+	state.ID = types.StringValue(domain)
+	state.TeamID = toTeamID(teamID)
+	state.ExportedZoneFile = types.StringValue(client.RenderZoneFile(domain, current))
+	tflog.Info(ctx, "read DNS zone", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  domain,
+		"records": len(records),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *dnsZoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan DNSZone
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.apply(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if result.ExportedZoneFile.IsUnknown() || result.ExportedZoneFile.IsNull() {
+		return
+	}
+	tflog.Info(ctx, "updated DNS zone", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Domain.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete removes the records managed by the zone. Records on the domain that are not in state,
+// such as system records, are left in place.
+func (r *dnsZoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state DNSZone
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	domain := state.Domain.ValueString()
+	teamID := r.client.TeamID(state.TeamID.ValueString())
+	current, err := r.listManagedDNSRecords(ctx, domain, teamID, state.IgnoreSystemRecords.ValueBool())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting DNS zone",
+			fmt.Sprintf("Could not list DNS records for %s, unexpected error: %s", domain, err),
+		)
+		return
+	}
+
+	records, diags := state.records(ctx)
+	resp.Diagnostics.Append(diags...)
+	managed := make([]client.CreateDNSRecordRequest, 0, len(records))
+	for _, record := range records {
+		request, diags := record.toCreateDNSRecordRequest(ctx, domain)
+		resp.Diagnostics.Append(diags...)
+		managed = append(managed, request)
+	}
+	existing := make([]client.CreateDNSRecordRequest, 0, len(current))
+	for _, record := range current {
+		request, err := dnsZoneRequestFromResponse(record)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting DNS zone",
+				fmt.Sprintf("Could not parse DNS record %s, unexpected error: %s", record.ID, err),
+			)
+		}
+		existing = append(existing, request)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, j := range matchDNSZoneRecords(managed, existing) {
+		if j == -1 {
+			continue
+		}
+		err := r.client.DeleteDNSRecord(ctx, domain, current[j].ID, teamID)
+		if err != nil && !client.NotFound(err) {
+			resp.Diagnostics.AddError(
+				"Error deleting DNS zone",
+				fmt.Sprintf("Could not delete DNS record %s, unexpected error: %s", current[j].ID, err),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "deleted DNS zone", map[string]any{
+		"team_id": teamID,
+		"domain":  domain,
+	})
+}
+
+// ImportState takes an identifier and reads all of the records on the domain into state.
+func (r *dnsZoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, domain, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing DNS zone",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/domain\" or \"domain\"", req.ID),
+		)
+		return
+	}
+
+	teamID = r.client.TeamID(teamID)
+	current, err := r.listManagedDNSRecords(ctx, domain, teamID, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading DNS zone",
+			fmt.Sprintf("Could not list DNS records for %s, unexpected error: %s", domain, err),
+		)
+		return
+	}
+
+	records, diags := reconcileDNSZoneRecords(ctx, domain, nil, current)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result := DNSZone{
+		ID:                  types.StringValue(domain),
+		TeamID:              toTeamID(teamID),
+		Domain:              types.StringValue(domain),
+		IgnoreSystemRecords: types.BoolValue(true),
+		ZoneFile:            types.StringNull(),
+		ExportedZoneFile:    types.StringValue(client.RenderZoneFile(domain, current)),
+	}
+	result.Records, diags = dnsZoneRecordsSet(ctx, records)
+	resp.Diagnostics.Append(diags...)
+	tflog.Info(ctx, "import DNS zone", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  domain,
+		"records": len(records),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
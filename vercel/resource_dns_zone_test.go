@@ -0,0 +1,110 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func testAccDNSZoneRecordCount(testClient *client.Client, domain, teamID, name string, want int) resource.TestCheckFunc {
+	return func(*terraform.State) error {
+		records, err := testClient.ListDNSRecords(context.TODO(), domain, teamID)
+		if err != nil {
+			return err
+		}
+		got := 0
+		for _, record := range records {
+			if record.Name == name {
+				got++
+			}
+		}
+		if got != want {
+			return fmt.Errorf("expected %d records named %q, found %d", want, name, got)
+		}
+		return nil
+	}
+}
+
+func TestAcc_DNSZone(t *testing.T) {
+	nameSuffix := acctest.RandString(16)
+	domain := testDomain(t)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccDNSZoneRecordCount(testClient(t), domain, testTeam(t), "zone-"+nameSuffix, 0),
+			testAccDNSZoneRecordCount(testClient(t), domain, testTeam(t), "mail-"+nameSuffix, 0),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain = "%[1]s"
+  records = [
+    {
+      name  = "zone-%[2]s"
+      type  = "A"
+      value = "127.0.0.1"
+    },
+    {
+      name    = "zone-%[2]s"
+      type    = "TXT"
+      value   = "hello"
+      ttl     = 120
+      comment = "a txt record"
+    },
+    {
+      name        = "mail-%[2]s"
+      type        = "MX"
+      value       = "mail.example.com"
+      mx_priority = 10
+    },
+  ]
+}
+`, domain, nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "id", domain),
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "records.#", "3"),
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "ignore_system_records", "true"),
+					resource.TestCheckResourceAttrSet("vercel_dns_zone.test", "exported_zone_file"),
+					testAccDNSZoneRecordCount(testClient(t), domain, testTeam(t), "zone-"+nameSuffix, 2),
+					testAccDNSZoneRecordCount(testClient(t), domain, testTeam(t), "mail-"+nameSuffix, 1),
+				),
+			},
+			{
+				ResourceName:            "vercel_dns_zone.test",
+				ImportState:             true,
+				ImportStateId:           fmt.Sprintf("%s/%s", testTeam(t), domain),
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"records"},
+			},
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_dns_zone" "test" {
+  domain    = "%[1]s"
+  zone_file = <<-EOT
+    $ORIGIN %[1]s.
+    zone-%[2]s 300 IN A   127.0.0.2
+    zone-%[2]s 120 IN TXT "hello" ; a txt record
+  EOT
+}
+`, domain, nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_dns_zone.test", "records.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("vercel_dns_zone.test", "records.*", map[string]string{
+						"name":  "zone-" + nameSuffix,
+						"type":  "A",
+						"value": "127.0.0.2",
+						"ttl":   "300",
+					}),
+					testAccDNSZoneRecordCount(testClient(t), domain, testTeam(t), "zone-"+nameSuffix, 2),
+					testAccDNSZoneRecordCount(testClient(t), domain, testTeam(t), "mail-"+nameSuffix, 0),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,248 @@
+package vercel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestDiffDNSZoneRecords(t *testing.T) {
+	desired := []client.CreateDNSRecordRequest{
+		// Unchanged, the API returns the default TTL and a trailing dot.
+		{Name: "www", Type: "CNAME", Value: "cname.vercel-dns.com"},
+		// TTL changed, so updated in place.
+		{Name: "", Type: "A", Value: "76.76.21.21", TTL: 3600},
+		// Comment changed, so updated in place.
+		{Name: "", Type: "MX", Value: "mail.example.com", MXPriority: 10, Comment: "mail"},
+		// Value changed, so the old record is deleted and a new one created.
+		{Name: "", Type: "TXT", Value: "v=spf1 -all"},
+		// Unchanged SRV record.
+		{Name: "_sip._tcp", Type: "SRV", SRV: &client.SRV{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com"}},
+	}
+	// Records as returned by the list endpoint, with the MX and SRV priorities in their own fields.
+	existing := []client.DNSRecord{
+		{ID: "rec_cname", Name: "www", RecordType: "CNAME", Value: "cname.vercel-dns.com.", TTL: 60},
+		{ID: "rec_a", Name: "", RecordType: "A", Value: "76.76.21.21", TTL: 60},
+		{ID: "rec_mx", Name: "", RecordType: "MX", Value: "mail.example.com.", MXPriority: 10, TTL: 60},
+		{ID: "rec_txt", Name: "", RecordType: "TXT", Value: "v=spf1 ~all", TTL: 60},
+		{ID: "rec_srv", Name: "_sip._tcp", RecordType: "SRV", Value: "5 5060 sip.example.com.", Priority: 10, TTL: 60},
+		{ID: "rec_old", Name: "old", RecordType: "A", Value: "127.0.0.1", TTL: 60},
+	}
+
+	changes, err := diffDNSZoneRecords(desired, existing)
+	if err != nil {
+		t.Fatalf("diffDNSZoneRecords() error = %v", err)
+	}
+
+	if len(changes.Create) != 1 || changes.Create[0].Value != "v=spf1 -all" {
+		t.Errorf("creates = %+v, want only the new TXT record", changes.Create)
+	}
+	updates := map[string]client.UpdateDNSRecordRequest{}
+	for _, u := range changes.Update {
+		updates[u.RecordID] = u.Request
+	}
+	if len(updates) != 2 {
+		t.Errorf("updates = %+v, want the A and MX records", changes.Update)
+	}
+	if u, ok := updates["rec_a"]; !ok || *u.TTL != 3600 {
+		t.Errorf("A record update = %+v, want TTL 3600", u)
+	}
+	if u, ok := updates["rec_mx"]; !ok || *u.TTL != 60 || u.Comment != "mail" {
+		t.Errorf("MX record update = %+v, want TTL 60 and comment", u)
+	}
+	deleted := map[string]bool{}
+	for _, d := range changes.Delete {
+		deleted[d.ID] = true
+	}
+	if len(deleted) != 2 || !deleted["rec_txt"] || !deleted["rec_old"] {
+		t.Errorf("deletes = %+v, want the old TXT and undeclared A records", changes.Delete)
+	}
+}
+
+func TestDiffDNSZoneRecordsIsEmptyWhenInSync(t *testing.T) {
+	desired := []client.CreateDNSRecordRequest{
+		{Name: "", Type: "A", Value: "76.76.21.21"},
+		{Name: "", Type: "A", Value: "76.76.21.21", TTL: 120},
+	}
+	existing := []client.DNSRecord{
+		{ID: "rec_2", Name: "", RecordType: "A", Value: "76.76.21.21", TTL: 120},
+		{ID: "rec_1", Name: "", RecordType: "A", Value: "76.76.21.21", TTL: 60},
+	}
+
+	changes, err := diffDNSZoneRecords(desired, existing)
+	if err != nil {
+		t.Fatalf("diffDNSZoneRecords() error = %v", err)
+	}
+	if !changes.empty() {
+		t.Fatalf("changes = %+v, want none", changes)
+	}
+}
+
+func TestDNSZoneRequestFromResponse(t *testing.T) {
+	tests := []struct {
+		name    string
+		record  client.DNSRecord
+		want    client.CreateDNSRecordRequest
+		wantErr bool
+	}{
+		{
+			name:   "MX priority from its own field",
+			record: client.DNSRecord{RecordType: "MX", Value: "mail.example.com.", MXPriority: 10},
+			want:   client.CreateDNSRecordRequest{Type: "MX", Value: "mail.example.com.", MXPriority: 10},
+		},
+		{
+			name:   "SRV priority from its own field",
+			record: client.DNSRecord{RecordType: "SRV", Value: "5 5060 sip.example.com.", Priority: 10},
+			want:   client.CreateDNSRecordRequest{Type: "SRV", SRV: &client.SRV{Priority: 10, Weight: 5, Port: 5060, Target: "sip.example.com."}},
+		},
+		{
+			name:    "SRV with a non numeric port",
+			record:  client.DNSRecord{RecordType: "SRV", Value: "5 sip sip.example.com."},
+			wantErr: true,
+		},
+		{
+			name:    "MX with an unexpected value",
+			record:  client.DNSRecord{RecordType: "MX", Value: "10 mail.example.com."},
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := dnsZoneRequestFromResponse(tt.record)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("dnsZoneRequestFromResponse() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("dnsZoneRequestFromResponse() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestReconcileDNSZoneRecordsPreservesPriorFormatting(t *testing.T) {
+	ctx := context.Background()
+	prior := []DNSZoneRecord{
+		dnsZoneRecordFromRequest(client.CreateDNSRecordRequest{Name: "www", Type: "CNAME", Value: "cname.vercel-dns.com"}),
+	}
+	existing := []client.DNSRecord{
+		{ID: "rec_cname", Name: "www", RecordType: "CNAME", Value: "cname.vercel-dns.com.", TTL: 60},
+		{ID: "rec_mx", Name: "", RecordType: "MX", Value: "mail.example.com.", MXPriority: 10, TTL: 300, Comment: "mail"},
+	}
+
+	records, diags := reconcileDNSZoneRecords(ctx, "example.com", prior, existing)
+	if diags.HasError() {
+		t.Fatalf("reconcileDNSZoneRecords() diags = %v", diags)
+	}
+	if len(records) != 2 {
+		t.Fatalf("records = %+v, want 2", records)
+	}
+	if records[0].Value.ValueString() != "cname.vercel-dns.com" || !records[0].TTL.IsNull() {
+		t.Errorf("CNAME record = %+v, want the prior value with no TTL", records[0])
+	}
+	mx := records[1]
+	if mx.Value.ValueString() != "mail.example.com." || mx.MXPriority != types.Int64Value(10) || mx.TTL != types.Int64Value(300) || mx.Comment != types.StringValue("mail") {
+		t.Errorf("MX record = %+v, want it read from the API", mx)
+	}
+}
+
+func TestDNSZoneValidateConfigZoneFile(t *testing.T) {
+	ctx := context.Background()
+	res := &dnsZoneResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	zoneFile := "$ORIGIN example.com.\nwww 60 IN A 76.76.21.21\n"
+	tests := []struct {
+		name     string
+		domain   types.String
+		zoneFile string
+		wantErr  bool
+	}{
+		{name: "valid zone file", domain: types.StringValue("example.com"), zoneFile: zoneFile},
+		{name: "unknown domain is skipped", domain: types.StringUnknown(), zoneFile: zoneFile},
+		{name: "invalid zone file", domain: types.StringValue("example.com"), zoneFile: "www 60 IN BOGUS value\n", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			raw := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags := raw.Set(ctx, DNSZone{
+				ID:                  types.StringNull(),
+				TeamID:              types.StringNull(),
+				Domain:              tt.domain,
+				IgnoreSystemRecords: types.BoolNull(),
+				ZoneFile:            types.StringValue(tt.zoneFile),
+				ExportedZoneFile:    types.StringNull(),
+				Records:             types.SetNull(dnsZoneRecordAttrType),
+			})
+			if diags.HasError() {
+				t.Fatalf("raw.Set() returned diagnostics: %v", diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			res.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Raw: raw.Raw, Schema: schemaResp.Schema},
+			}, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("HasError() = %v, want %v\n%v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestDNSZoneValidateConfigRecordsReportsTheInvalidRecord(t *testing.T) {
+	ctx := context.Background()
+	res := &dnsZoneResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	valid := dnsZoneRecordFromRequest(client.CreateDNSRecordRequest{Name: "www", Type: "CNAME", Value: "cname.vercel-dns.com"})
+	invalid := dnsZoneRecordFromRequest(client.CreateDNSRecordRequest{Name: "", Type: "A", Value: "76.76.21.21"})
+	invalid.MXPriority = types.Int64Value(10)
+	records, diags := dnsZoneRecordsSet(ctx, []DNSZoneRecord{valid, invalid})
+	if diags.HasError() {
+		t.Fatalf("dnsZoneRecordsSet() returned diagnostics: %v", diags)
+	}
+
+	raw := tfsdk.Plan{Schema: schemaResp.Schema}
+	diags = raw.Set(ctx, DNSZone{
+		ID:                  types.StringNull(),
+		TeamID:              types.StringNull(),
+		Domain:              types.StringValue("example.com"),
+		IgnoreSystemRecords: types.BoolNull(),
+		ZoneFile:            types.StringNull(),
+		ExportedZoneFile:    types.StringNull(),
+		Records:             records,
+	})
+	if diags.HasError() {
+		t.Fatalf("raw.Set() returned diagnostics: %v", diags)
+	}
+
+	resp := &resource.ValidateConfigResponse{}
+	res.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Raw: raw.Raw, Schema: schemaResp.Schema},
+	}, resp)
+	if resp.Diagnostics.ErrorsCount() != 1 {
+		t.Fatalf("diagnostics = %v, want one error", resp.Diagnostics)
+	}
+	invalidObject, diags := types.ObjectValueFrom(ctx, dnsZoneRecordAttrType.AttrTypes, invalid)
+	if diags.HasError() {
+		t.Fatalf("types.ObjectValueFrom() returned diagnostics: %v", diags)
+	}
+	withPath, ok := resp.Diagnostics.Errors()[0].(diag.DiagnosticWithPath)
+	if !ok {
+		t.Fatalf("diagnostic %v has no path", resp.Diagnostics.Errors()[0])
+	}
+	if want := path.Root("records").AtSetValue(invalidObject); !withPath.Path().Equal(want) {
+		t.Errorf("diagnostic path = %s, want %s", withPath.Path(), want)
+	}
+}
@@ -0,0 +1,561 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/resourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                     = &domainResource{}
+	_ resource.ResourceWithConfigure        = &domainResource{}
+	_ resource.ResourceWithConfigValidators = &domainResource{}
+	_ resource.ResourceWithImportState      = &domainResource{}
+)
+
+func newDomainResource() resource.Resource {
+	return &domainResource{}
+}
+
+type domainResource struct {
+	client *client.Client
+}
+
+func (r *domainResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_domain"
+}
+
+func (r *domainResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *domainResource) ConfigValidators(_ context.Context) []resource.ConfigValidator {
+	return []resource.ConfigValidator{
+		resourcevalidator.Conflicting(
+			path.MatchRoot("transfer_auth_code"),
+			path.MatchRoot("move_token"),
+		),
+	}
+}
+
+func (r *domainResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Domain resource.
+
+A Domain is added to a team or personal account before it can be used by projects or have DNS records managed by Vercel.
+Domains can be added directly, moved in from another Vercel account, or transferred in from another registrar.
+
+Changing the ` + "`team_id`" + ` of an existing domain moves it to the new team, rather than removing and re-adding it.
+
+For more detailed information, please see the [Vercel documentation](https://vercel.com/docs/domains).
+
+~> Removing a domain also removes all of its DNS records.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the domain.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the domain should exist under. Required when configuring a team resource if a default team has not been set in the provider. Changing this moves the domain to the new team.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The apex domain name, for example `example.com`.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"nameserver_mode": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the domain is intended to use Vercel's nameservers (`vercel`), or nameservers hosted elsewhere (`external`). For domains registered elsewhere, the nameservers must also be changed at the registrar.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("vercel", "external"),
+				},
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"custom_nameservers": schema.SetAttribute{
+				Optional:    true,
+				ElementType: types.StringType,
+				Description: "Custom nameservers for the domain. Only supported for domains registered through Vercel.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(2),
+				},
+			},
+			"auto_renew": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "Whether the domain should be automatically renewed. Only supported for domains registered through Vercel.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.UseStateForUnknown()},
+			},
+			"move_token": schema.StringAttribute{
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "A token used to move the domain in from a Vercel account you are not a member of. The token is obtained by moving the domain out of the other account.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"transfer_auth_code": schema.StringAttribute{
+				Optional:      true,
+				Sensitive:     true,
+				Description:   "The authorization code from the current registrar. When set, the domain is transferred in to Vercel rather than simply added.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"transfer_expected_price": schema.Float64Attribute{
+				Optional:      true,
+				Description:   "The price you expect to be charged for transferring the domain in. The transfer fails if the price differs.",
+				PlanModifiers: []planmodifier.Float64{float64planmodifier.RequiresReplace()},
+				Validators: []validator.Float64{
+					float64validator.AlsoRequires(path.MatchRoot("transfer_auth_code")),
+				},
+			},
+			"transfer_status": schema.StringAttribute{
+				Computed:    true,
+				Description: "The status of an inbound registrar transfer. One of `none`, `pending` or `completed`.",
+			},
+			"verified": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the ownership of the domain has been verified.",
+			},
+			"service_type": schema.StringAttribute{
+				Computed:    true,
+				Description: "How the domain's DNS is served. `zeit.world` when Vercel is the DNS provider, `external` when nameservers are hosted elsewhere, or `na` when unknown.",
+			},
+			"nameservers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The nameservers the domain is currently using.",
+			},
+			"intended_nameservers": schema.ListAttribute{
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "The Vercel nameservers the domain should be pointed at to use Vercel DNS.",
+			},
+			"registered_with_vercel": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the domain was purchased from, or transferred in to, Vercel.",
+			},
+			"created_at": schema.Int64Attribute{
+				Computed:      true,
+				Description:   "The Unix timestamp, in milliseconds, when the domain was added.",
+				PlanModifiers: []planmodifier.Int64{int64planmodifier.UseStateForUnknown()},
+			},
+			"expires_at": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The Unix timestamp, in milliseconds, when the domain registration expires. Only set for domains registered through Vercel.",
+			},
+		},
+	}
+}
+
+// Domain reflects the state terraform stores internally for a domain.
+type Domain struct {
+	ID                    types.String  `tfsdk:"id"`
+	TeamID                types.String  `tfsdk:"team_id"`
+	Name                  types.String  `tfsdk:"name"`
+	NameserverMode        types.String  `tfsdk:"nameserver_mode"`
+	CustomNameservers     types.Set     `tfsdk:"custom_nameservers"`
+	AutoRenew             types.Bool    `tfsdk:"auto_renew"`
+	MoveToken             types.String  `tfsdk:"move_token"`
+	TransferAuthCode      types.String  `tfsdk:"transfer_auth_code"`
+	TransferExpectedPrice types.Float64 `tfsdk:"transfer_expected_price"`
+	TransferStatus        types.String  `tfsdk:"transfer_status"`
+	Verified              types.Bool    `tfsdk:"verified"`
+	ServiceType           types.String  `tfsdk:"service_type"`
+	Nameservers           types.List    `tfsdk:"nameservers"`
+	IntendedNameservers   types.List    `tfsdk:"intended_nameservers"`
+	RegisteredWithVercel  types.Bool    `tfsdk:"registered_with_vercel"`
+	CreatedAt             types.Int64   `tfsdk:"created_at"`
+	ExpiresAt             types.Int64   `tfsdk:"expires_at"`
+}
+
+func (d Domain) zone() *bool {
+	if d.NameserverMode.IsNull() || d.NameserverMode.IsUnknown() {
+		return nil
+	}
+	zone := d.NameserverMode.ValueString() == "vercel"
+	return &zone
+}
+
+func (d Domain) toCreateDomainRequest() client.CreateDomainRequest {
+	request := client.CreateDomainRequest{
+		TeamID: d.TeamID.ValueString(),
+		Name:   d.Name.ValueString(),
+		Method: "add",
+		Zone:   d.zone(),
+	}
+	if !d.MoveToken.IsNull() {
+		request.Method = "move-in"
+		request.Token = d.MoveToken.ValueString()
+	}
+	if !d.TransferAuthCode.IsNull() {
+		request.Method = "transfer-in"
+		request.AuthCode = d.TransferAuthCode.ValueString()
+		request.ExpectedPrice = d.TransferExpectedPrice.ValueFloat64Pointer()
+	}
+	return request
+}
+
+// toUpdateDomainRequest builds the settings that differ between the plan and the state. The boolean
+// result is false when nothing needs to change.
+func (d Domain) toUpdateDomainRequest(ctx context.Context, state Domain) (client.UpdateDomainRequest, bool) {
+	var request client.UpdateDomainRequest
+	changed := false
+	if !d.NameserverMode.IsUnknown() && !d.NameserverMode.Equal(state.NameserverMode) {
+		request.Zone = d.zone()
+		changed = request.Zone != nil
+	}
+	if !d.AutoRenew.IsUnknown() && !d.AutoRenew.IsNull() && !d.AutoRenew.Equal(state.AutoRenew) {
+		request.Renew = d.AutoRenew.ValueBoolPointer()
+		changed = true
+	}
+	if !d.CustomNameservers.Equal(state.CustomNameservers) {
+		nameservers := []string{}
+		if !d.CustomNameservers.IsNull() {
+			_ = d.CustomNameservers.ElementsAs(ctx, &nameservers, false)
+		}
+		slices.Sort(nameservers)
+		request.CustomNameservers = &nameservers
+		changed = true
+	}
+	return request, changed
+}
+
+func convertResponseToDomain(ctx context.Context, response client.Domain, plan Domain) (Domain, error) {
+	nameservers, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(response.Nameservers))
+	if diags.HasError() {
+		return Domain{}, fmt.Errorf("error reading domain nameservers: %s - %s", diags[0].Summary(), diags[0].Detail())
+	}
+	intendedNameservers, diags := types.ListValueFrom(ctx, types.StringType, nonNilStrings(response.IntendedNameservers))
+	if diags.HasError() {
+		return Domain{}, fmt.Errorf("error reading domain intended nameservers: %s - %s", diags[0].Summary(), diags[0].Detail())
+	}
+
+	// Custom nameservers are tracked when configured, or when they have been set outside of Terraform.
+	customNameservers := types.SetNull(types.StringType)
+	if !plan.CustomNameservers.IsNull() || len(response.CustomNameservers) > 0 {
+		customNameservers, diags = types.SetValueFrom(ctx, types.StringType, nonNilStrings(response.CustomNameservers))
+		if diags.HasError() {
+			return Domain{}, fmt.Errorf("error reading domain custom nameservers: %s - %s", diags[0].Summary(), diags[0].Detail())
+		}
+	}
+
+	return Domain{
+		ID:                    types.StringValue(response.ID),
+		TeamID:                toTeamID(response.TeamID),
+		Name:                  types.StringValue(response.Name),
+		NameserverMode:        types.StringValue(domainNameserverMode(response, plan.NameserverMode)),
+		CustomNameservers:     customNameservers,
+		AutoRenew:             types.BoolPointerValue(response.Renew),
+		MoveToken:             plan.MoveToken,
+		TransferAuthCode:      plan.TransferAuthCode,
+		TransferExpectedPrice: plan.TransferExpectedPrice,
+		TransferStatus:        types.StringValue(response.TransferStatus()),
+		Verified:              types.BoolValue(response.Verified),
+		ServiceType:           types.StringValue(response.ServiceType),
+		Nameservers:           nameservers,
+		IntendedNameservers:   intendedNameservers,
+		RegisteredWithVercel:  types.BoolValue(response.RegisteredWithVercel()),
+		CreatedAt:             types.Int64Value(response.CreatedAt),
+		ExpiresAt:             types.Int64PointerValue(response.ExpiresAt),
+	}, nil
+}
+
+// domainNameserverMode returns the nameserver mode of a domain. The service type only changes once the
+// nameserver switch has propagated, so the configured mode is kept for as long as the API's zone setting
+// agrees with it. A mode changed outside of Terraform still shows as drift.
+func domainNameserverMode(domain client.Domain, configured types.String) string {
+	if !configured.IsNull() && !configured.IsUnknown() && domain.Zone == (configured.ValueString() == "vercel") {
+		return configured.ValueString()
+	}
+	if domain.UsesVercelNameservers() {
+		return "vercel"
+	}
+	return "external"
+}
+
+func nonNilStrings(v []string) []string {
+	if v == nil {
+		return []string{}
+	}
+	return v
+}
+
+func (r *domainResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Domain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.CreateDomain(ctx, plan.toCreateDomainRequest())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating domain",
+			"Could not create domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	// Settings that can't be passed on creation are applied straight afterwards.
+	if update, changed := plan.toUpdateDomainRequest(ctx, Domain{
+		NameserverMode:    plan.NameserverMode,
+		AutoRenew:         types.BoolNull(),
+		CustomNameservers: types.SetNull(types.StringType),
+	}); changed {
+		out, err = r.client.UpdateDomain(ctx, out.Name, out.TeamID, update)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating domain",
+				fmt.Sprintf("Could not update settings for domain %s, unexpected error: %s", out.Name, err),
+			)
+			return
+		}
+	}
+
+	if !out.Verified {
+		out, err = r.client.VerifyDomain(ctx, out.Name, out.TeamID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating domain",
+				fmt.Sprintf("Could not verify domain %s, unexpected error: %s", plan.Name.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	result, err := convertResponseToDomain(ctx, out, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating domain",
+			"Could not read domain, unexpected error: "+err.Error(),
+		)
+		return
+	}
+	tflog.Info(ctx, "created domain", map[string]any{
+		"team_id":  result.TeamID.ValueString(),
+		"domain":   result.Name.ValueString(),
+		"verified": result.Verified.ValueBool(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *domainResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Domain
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s", state.Name.ValueString(), err),
+		)
+		return
+	}
+
+	result, err := convertResponseToDomain(ctx, out, state)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s", state.Name.ValueString(), err),
+		)
+		return
+	}
+	tflog.Info(ctx, "read domain", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *domainResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Domain
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	var state Domain
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating domain",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s", state.Name.ValueString(), err),
+		)
+		return
+	}
+
+	fromTeamID := r.client.TeamID(state.TeamID.ValueString())
+	toTeamID := r.client.TeamID(plan.TeamID.ValueString())
+	if fromTeamID != toTeamID {
+		out, err = r.client.MoveDomain(ctx, state.Name.ValueString(), fromTeamID, toTeamID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating domain",
+				fmt.Sprintf("Could not move domain %s to team %s, unexpected error: %s", state.Name.ValueString(), toTeamID, err),
+			)
+			return
+		}
+		tflog.Info(ctx, "moved domain", map[string]any{
+			"domain":       state.Name.ValueString(),
+			"from_team_id": fromTeamID,
+			"to_team_id":   toTeamID,
+		})
+	}
+
+	if update, changed := plan.toUpdateDomainRequest(ctx, state); changed {
+		out, err = r.client.UpdateDomain(ctx, plan.Name.ValueString(), toTeamID, update)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating domain",
+				fmt.Sprintf("Could not update domain %s, unexpected error: %s", plan.Name.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	if !out.Verified {
+		out, err = r.client.VerifyDomain(ctx, plan.Name.ValueString(), toTeamID)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating domain",
+				fmt.Sprintf("Could not verify domain %s, unexpected error: %s", plan.Name.ValueString(), err),
+			)
+			return
+		}
+	}
+
+	result, err := convertResponseToDomain(ctx, out, plan)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating domain",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s", plan.Name.ValueString(), err),
+		)
+		return
+	}
+	tflog.Info(ctx, "updated domain", map[string]any{
+		"team_id":  result.TeamID.ValueString(),
+		"domain":   result.Name.ValueString(),
+		"verified": result.Verified.ValueBool(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *domainResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Domain
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.DeleteDomain(ctx, state.Name.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting domain",
+			fmt.Sprintf("Could not delete domain %s, unexpected error: %s", state.Name.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted domain", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+		"domain":  state.Name.ValueString(),
+	})
+}
+
+// ImportState takes an identifier and reads the domain from the Vercel API.
+func (r *domainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, name, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing domain",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/domain\" or \"domain\"", req.ID),
+		)
+		return
+	}
+
+	out, err := r.client.GetDomain(ctx, name, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s", name, err),
+		)
+		return
+	}
+
+	result, err := convertResponseToDomain(ctx, out, Domain{
+		CustomNameservers:     types.SetNull(types.StringType),
+		MoveToken:             types.StringNull(),
+		TransferAuthCode:      types.StringNull(),
+		TransferExpectedPrice: types.Float64Null(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading domain",
+			fmt.Sprintf("Could not read domain %s, unexpected error: %s", name, err),
+		)
+		return
+	}
+	tflog.Info(ctx, "import domain", map[string]any{
+		"team_id": result.TeamID.ValueString(),
+		"domain":  result.Name.ValueString(),
+	})
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
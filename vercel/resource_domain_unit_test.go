@@ -0,0 +1,99 @@
+package vercel
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func domainForRequestTests() Domain {
+	return Domain{
+		TeamID:                types.StringValue("team_123"),
+		Name:                  types.StringValue("example.com"),
+		NameserverMode:        types.StringUnknown(),
+		CustomNameservers:     types.SetNull(types.StringType),
+		AutoRenew:             types.BoolUnknown(),
+		MoveToken:             types.StringNull(),
+		TransferAuthCode:      types.StringNull(),
+		TransferExpectedPrice: types.Float64Null(),
+	}
+}
+
+func TestDomainToCreateDomainRequestMethod(t *testing.T) {
+	domain := domainForRequestTests()
+	if got := domain.toCreateDomainRequest(); got.Method != "add" || got.Zone != nil {
+		t.Fatalf("toCreateDomainRequest() = %#v, want method add without zone", got)
+	}
+
+	domain.NameserverMode = types.StringValue("vercel")
+	domain.MoveToken = types.StringValue("token")
+	got := domain.toCreateDomainRequest()
+	if got.Method != "move-in" || got.Token != "token" || got.Zone == nil || !*got.Zone {
+		t.Fatalf("toCreateDomainRequest() = %#v, want move-in with zone", got)
+	}
+
+	domain = domainForRequestTests()
+	domain.TransferAuthCode = types.StringValue("auth")
+	domain.TransferExpectedPrice = types.Float64Value(20)
+	got = domain.toCreateDomainRequest()
+	if got.Method != "transfer-in" || got.AuthCode != "auth" || got.ExpectedPrice == nil || *got.ExpectedPrice != 20 {
+		t.Fatalf("toCreateDomainRequest() = %#v, want transfer-in with auth code and price", got)
+	}
+}
+
+func TestDomainToUpdateDomainRequestOnlyIncludesChanges(t *testing.T) {
+	ctx := context.Background()
+	state := domainForRequestTests()
+	state.NameserverMode = types.StringValue("external")
+	state.AutoRenew = types.BoolValue(true)
+
+	plan := state
+	if _, changed := plan.toUpdateDomainRequest(ctx, state); changed {
+		t.Fatal("toUpdateDomainRequest() reported a change for an identical plan")
+	}
+
+	plan.NameserverMode = types.StringValue("vercel")
+	plan.AutoRenew = types.BoolValue(false)
+	plan.CustomNameservers = types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("ns2.example.net"),
+		types.StringValue("ns1.example.net"),
+	})
+	request, changed := plan.toUpdateDomainRequest(ctx, state)
+	if !changed {
+		t.Fatal("toUpdateDomainRequest() reported no change")
+	}
+	if request.Zone == nil || !*request.Zone {
+		t.Fatalf("Zone = %v, want true", request.Zone)
+	}
+	if request.Renew == nil || *request.Renew {
+		t.Fatalf("Renew = %v, want false", request.Renew)
+	}
+	if request.CustomNameservers == nil || !reflect.DeepEqual(*request.CustomNameservers, []string{"ns1.example.net", "ns2.example.net"}) {
+		t.Fatalf("CustomNameservers = %v, want sorted nameservers", request.CustomNameservers)
+	}
+
+	// Removing custom nameservers resets them, rather than leaving them untouched.
+	request, changed = state.toUpdateDomainRequest(ctx, plan)
+	if !changed || request.CustomNameservers == nil || len(*request.CustomNameservers) != 0 {
+		t.Fatalf("CustomNameservers = %v, want an empty list", request.CustomNameservers)
+	}
+}
+
+func TestDomainNameserverModeKeepsConfiguredModeUntilPropagated(t *testing.T) {
+	pending := client.Domain{Zone: true, ServiceType: "external"}
+	if got := domainNameserverMode(pending, types.StringValue("vercel")); got != "vercel" {
+		t.Fatalf("domainNameserverMode() = %q, want the configured mode while the switch propagates", got)
+	}
+	if got := domainNameserverMode(pending, types.StringNull()); got != "external" {
+		t.Fatalf("domainNameserverMode() = %q, want the service type without a configured mode", got)
+	}
+
+	changedOutside := client.Domain{Zone: false, ServiceType: "external"}
+	if got := domainNameserverMode(changedOutside, types.StringValue("vercel")); got != "external" {
+		t.Fatalf("domainNameserverMode() = %q, want external when the zone setting was changed", got)
+	}
+}
@@ -21,6 +21,7 @@ var (
 	_ resource.Resource                = &edgeConfigResource{}
 	_ resource.ResourceWithConfigure   = &edgeConfigResource{}
 	_ resource.ResourceWithImportState = &edgeConfigResource{}
+	_ resource.ResourceWithIdentity    = &edgeConfigResource{}
 )
 
 func newEdgeConfigResource() resource.Resource {
@@ -86,12 +87,24 @@ An Edge Config is a global data store that enables experimentation with feature
 	}
 }
 
+// IdentitySchema returns the identity schema for an edgeConfig resource.
+func (r *edgeConfigResource) IdentitySchema(_ context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = teamResourceIdentitySchema("The ID of the Edge Config.")
+}
+
 type EdgeConfig struct {
 	Name   types.String `tfsdk:"name"`
 	ID     types.String `tfsdk:"id"`
 	TeamID types.String `tfsdk:"team_id"`
 }
 
+func (e EdgeConfig) identity() TeamResourceIdentity {
+	return TeamResourceIdentity{
+		TeamID: e.TeamID,
+		ID:     e.ID,
+	}
+}
+
 func responseToEdgeConfig(out client.EdgeConfig) EdgeConfig {
 	return EdgeConfig{
 		Name:   types.StringValue(out.Slug),
@@ -133,6 +146,8 @@ func (r *edgeConfigResource) Create(ctx context.Context, req resource.CreateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read will read edgeConfig information by requesting it from the Vercel API, and will update terraform
@@ -173,6 +188,8 @@ func (r *edgeConfigResource) Read(ctx context.Context, req resource.ReadRequest,
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update does nothing.
@@ -216,6 +233,8 @@ func (r *edgeConfigResource) Update(ctx context.Context, req resource.UpdateRequ
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes an Edge Config.
@@ -251,11 +270,17 @@ func (r *edgeConfigResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *edgeConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	teamID, id, ok := splitInto1Or2(req.ID)
+	importID, diags := importIDFromRequest(ctx, req, "team_id", "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, id, ok := splitInto1Or2(importID)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Error importing Edge Config",
-			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/edge_config_id\" or \"edge_config_id\"", req.ID),
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/edge_config_id\" or \"edge_config_id\"", importID),
 		)
 		return
 	}
@@ -283,9 +308,11 @@ func (r *edgeConfigResource) ImportState(ctx context.Context, req resource.Impor
 		"edge_config_id": result.ID.ValueString(),
 	})
 
-	diags := resp.State.Set(ctx, result)
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
@@ -28,6 +28,7 @@ var (
 	_ resource.Resource                     = &edgeConfigItemResource{}
 	_ resource.ResourceWithConfigure        = &edgeConfigItemResource{}
 	_ resource.ResourceWithConfigValidators = &edgeConfigItemResource{}
+	_ resource.ResourceWithModifyPlan       = &edgeConfigItemResource{}
 )
 
 func newEdgeConfigItemResource() resource.Resource {
@@ -78,6 +79,8 @@ Provides an Edge Config Item.
 An Edge Config is a global data store that enables experimentation with feature flags, A/B testing, critical redirects, and more.
 
 An Edge Config Item is a value within an Edge Config.
+
+If the Edge Config has a schema, the value is validated against it during plan.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -117,6 +120,13 @@ An Edge Config Item is a value within an Edge Config.
 				Computed:      true,
 				PlanModifiers: []planmodifier.Dynamic{dynamicplanmodifier.RequiresReplaceIfConfigured()},
 			},
+			"schema_definition": schema.StringAttribute{
+				Description: "A JSON Schema to validate the value against during plan, such as the `definition` of a `vercel_edge_config_schema`. Defaults to the schema stored on the Edge Config.",
+				Optional:    true,
+				Validators: []validator.String{
+					validateJSON(),
+				},
+			},
 		},
 	}
 }
@@ -128,6 +138,49 @@ type EdgeConfigItem struct {
 	Key          types.String  `tfsdk:"key"`
 	Value        types.String  `tfsdk:"value"`
 	ValueJSON    types.Dynamic `tfsdk:"value_json"`
+	// SchemaDefinition is only used during plan, so it is kept as configured.
+	SchemaDefinition types.String `tfsdk:"schema_definition"`
+}
+
+func (r *edgeConfigItemResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan EdgeConfigItem
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Key.IsUnknown() {
+		return
+	}
+
+	// Values that are not known yet are validated during apply by the API.
+	raw, err := buildJSONRaw(ctx, plan.Value, plan.ValueJSON)
+	if err != nil {
+		return
+	}
+
+	schema, diags := loadEdgeConfigSchema(ctx, r.client, plan.EdgeConfigID, plan.TeamID, plan.SchemaDefinition)
+	resp.Diagnostics.Append(diags...)
+	if schema == nil {
+		return
+	}
+	// Only this item is known, so the schema can not be checked for the Edge
+	// Config as a whole.
+	violations, err := edgeConfigSchemaViolations(schema, map[string]json.RawMessage{plan.Key.ValueString(): raw}, true)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error validating Edge Config Item",
+			fmt.Sprintf("Could not validate the value against the Edge Config schema, unexpected error: %s", err),
+		)
+		return
+	}
+	p := path.Root("value_json")
+	if !plan.Value.IsNull() && !plan.Value.IsUnknown() {
+		p = path.Root("value")
+	}
+	for _, v := range violations {
+		resp.Diagnostics.AddAttributeError(p, "Edge Config item does not match schema", v.String())
+	}
 }
 
 // edgeConfigItemKeyRegex validates Edge Config item keys.
@@ -403,6 +456,7 @@ func (r *edgeConfigItemResource) Create(ctx context.Context, req resource.Create
 		resp.Diagnostics.AddError("Error parsing Edge Config Item", err.Error())
 		return
 	}
+	result.SchemaDefinition = plan.SchemaDefinition
 	tflog.Info(ctx, "created Edge Config Item", map[string]any{
 		"edge_config_id": plan.EdgeConfigID.ValueString(),
 		"key":            result.Key.ValueString(),
@@ -451,6 +505,7 @@ func (r *edgeConfigItemResource) Read(ctx context.Context, req resource.ReadRequ
 		resp.Diagnostics.AddError("Error parsing Edge Config Item", err.Error())
 		return
 	}
+	result.SchemaDefinition = state.SchemaDefinition
 	tflog.Info(ctx, "read edge config token", map[string]any{
 		"edge_config_id": state.EdgeConfigID.ValueString(),
 		"team_id":        state.TeamID.ValueString(),
@@ -464,12 +519,19 @@ func (r *edgeConfigItemResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 }
 
-// Update is the same as Create
+// Update only changes schema_definition, as changes to any other attribute
+// replace the item.
 func (r *edgeConfigItemResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	resp.Diagnostics.AddError(
-		"Updating an Edge Config Item is not supported. If you see this error, this is a bug in the provider.",
-		"Updating an Edge Config Item is not supported. If you see this error, this is a bug in the provider.",
-	)
+	var plan, state EdgeConfigItem
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.SchemaDefinition = plan.SchemaDefinition
+	diags := resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes an Edge Config Item.
@@ -544,6 +606,7 @@ func (r *edgeConfigItemResource) ImportState(ctx context.Context, req resource.I
 		resp.Diagnostics.AddError("Error parsing Edge Config Item", err.Error())
 		return
 	}
+	result.SchemaDefinition = types.StringNull()
 	tflog.Info(ctx, "import edge config schema", map[string]any{
 		"team_id":        result.TeamID.ValueString(),
 		"edge_config_id": result.EdgeConfigID.ValueString(),
@@ -0,0 +1,538 @@
+package vercel
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"reflect"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                = &edgeConfigItemsResource{}
+	_ resource.ResourceWithConfigure   = &edgeConfigItemsResource{}
+	_ resource.ResourceWithImportState = &edgeConfigItemsResource{}
+	_ resource.ResourceWithModifyPlan  = &edgeConfigItemsResource{}
+)
+
+func newEdgeConfigItemsResource() resource.Resource {
+	return &edgeConfigItemsResource{}
+}
+
+type edgeConfigItemsResource struct {
+	client *client.Client
+}
+
+type EdgeConfigItems struct {
+	ID               types.String `tfsdk:"id"`
+	EdgeConfigID     types.String `tfsdk:"edge_config_id"`
+	TeamID           types.String `tfsdk:"team_id"`
+	Items            types.Map    `tfsdk:"items"`
+	CheckDigest      types.Bool   `tfsdk:"check_digest"`
+	Digest           types.String `tfsdk:"digest"`
+	SchemaDefinition types.String `tfsdk:"schema_definition"`
+}
+
+func (r *edgeConfigItemsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_config_items"
+}
+
+func (r *edgeConfigItemsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *edgeConfigItemsResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides the full set of items in an Edge Config.
+
+An Edge Config is a global data store that enables experimentation with feature flags, A/B testing, critical redirects, and more.
+
+This resource is authoritative: items in the Edge Config that are not part of ` + "`items`" + ` are deleted. All changes are written in a single request.
+
+If the Edge Config has a schema, items are validated against it during plan.
+
+~> This resource should not be used together with ` + "`vercel_edge_config_item`" + ` resources for the same Edge Config, as they will overwrite each other.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The ID of the Edge Config store.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"edge_config_id": schema.StringAttribute{
+				Description:   "The ID of the Edge Config store.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Edge Config should exist under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"items": schema.MapAttribute{
+				Description: "The items of the Edge Config, as a map of key to JSON encoded value. Use `jsonencode(...)` to set values.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(
+						edgeConfigItemKeyRegex,
+						"Key must be 1-256 chars: letters, numbers, '_' or '-'",
+					)),
+					mapvalidator.ValueStringsAre(validateJSON()),
+				},
+			},
+			"check_digest": schema.BoolAttribute{
+				Description: "When true, changes are only written if the Edge Config has not changed since it was last read, so that writes made outside of Terraform since the plan are not overwritten. Defaults to `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"digest": schema.StringAttribute{
+				Description: "The digest of the Edge Config, which changes whenever its items change.",
+				Computed:    true,
+			},
+			"schema_definition": schema.StringAttribute{
+				Description: "A JSON Schema to validate `items` against during plan, such as the `definition` of a `vercel_edge_config_schema`. Defaults to the schema stored on the Edge Config.",
+				Optional:    true,
+				Validators: []validator.String{
+					validateJSON(),
+				},
+			},
+		},
+	}
+}
+
+func (r *edgeConfigItemsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan EdgeConfigItems
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || plan.Items.IsUnknown() {
+		return
+	}
+
+	// Items whose value is not known yet are left out, and only the items that
+	// are known are validated.
+	items := map[string]json.RawMessage{}
+	partial := false
+	for key, value := range plan.Items.Elements() {
+		v, ok := value.(types.String)
+		if !ok || v.IsUnknown() || v.IsNull() {
+			partial = true
+			continue
+		}
+		items[key] = json.RawMessage(v.ValueString())
+	}
+
+	schema, diags := loadEdgeConfigSchema(ctx, r.client, plan.EdgeConfigID, plan.TeamID, plan.SchemaDefinition)
+	resp.Diagnostics.Append(diags...)
+	if schema == nil {
+		return
+	}
+	violations, err := edgeConfigSchemaViolations(schema, items, partial)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("items"),
+			"Error validating Edge Config Items",
+			fmt.Sprintf("Could not validate items against the Edge Config schema, unexpected error: %s", err),
+		)
+		return
+	}
+	for _, v := range violations {
+		p := path.Root("items")
+		if v.Key != "" {
+			p = p.AtMapKey(v.Key)
+		}
+		resp.Diagnostics.AddAttributeError(p, "Edge Config item does not match schema", v.String())
+	}
+}
+
+// edgeConfigItemValuesEqual reports whether two JSON documents encode the
+// same value, ignoring formatting and the order of object keys. Numbers are
+// compared as written, so that large integers are not rounded.
+func edgeConfigItemValuesEqual(a, b []byte) bool {
+	av, err := decodeEdgeConfigItemValue(a)
+	if err != nil {
+		return false
+	}
+	bv, err := decodeEdgeConfigItemValue(b)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(av, bv)
+}
+
+func decodeEdgeConfigItemValue(value []byte) (v any, err error) {
+	decoder := json.NewDecoder(bytes.NewReader(value))
+	decoder.UseNumber()
+	err = decoder.Decode(&v)
+	return v, err
+}
+
+// edgeConfigItemsOperations works out the operations that turn the current
+// items into the planned ones. Keys are visited in order so the batch is
+// deterministic.
+func edgeConfigItemsOperations(current map[string]json.RawMessage, planned map[string]string) []client.EdgeConfigOperation {
+	keys := make([]string, 0, len(current)+len(planned))
+	for key := range current {
+		keys = append(keys, key)
+	}
+	for key := range planned {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var operations []client.EdgeConfigOperation
+	for _, key := range keys {
+		value, inPlan := planned[key]
+		existing, inCurrent := current[key]
+		switch {
+		case !inPlan:
+			operations = append(operations, client.EdgeConfigOperation{Operation: "delete", Key: key})
+		case !inCurrent:
+			operations = append(operations, client.EdgeConfigOperation{Operation: "create", Key: key, Value: json.RawMessage(value)})
+		case !edgeConfigItemValuesEqual(existing, []byte(value)):
+			operations = append(operations, client.EdgeConfigOperation{Operation: "update", Key: key, Value: json.RawMessage(value)})
+		}
+	}
+	return operations
+}
+
+// edgeConfigItemsFromResponse converts the items of an Edge Config to state,
+// keeping the formatting of preferred values that encode the same JSON.
+func edgeConfigItemsFromResponse(items []client.EdgeConfigItem, preferred map[string]string) (map[string]string, error) {
+	result := make(map[string]string, len(items))
+	for _, item := range items {
+		if p, ok := preferred[item.Key]; ok && edgeConfigItemValuesEqual([]byte(p), item.Value) {
+			result[item.Key] = p
+			continue
+		}
+		if len(item.Value) == 0 {
+			result[item.Key] = "null"
+			continue
+		}
+		var b bytes.Buffer
+		if err := json.Compact(&b, item.Value); err != nil {
+			return nil, fmt.Errorf("could not parse value of item %s: %w", item.Key, err)
+		}
+		result[item.Key] = b.String()
+	}
+	return result, nil
+}
+
+func edgeConfigItemsMap(ctx context.Context, value types.Map) (map[string]string, diag.Diagnostics) {
+	items := map[string]string{}
+	if value.IsNull() || value.IsUnknown() {
+		return items, nil
+	}
+	diags := value.ElementsAs(ctx, &items, false)
+	return items, diags
+}
+
+func edgeConfigItemsCurrent(items map[string]string) map[string]json.RawMessage {
+	current := make(map[string]json.RawMessage, len(items))
+	for key, value := range items {
+		current[key] = json.RawMessage(value)
+	}
+	return current
+}
+
+func edgeConfigItemsPreconditionFailed(err error) bool {
+	var apiErr client.APIError
+	return errors.As(err, &apiErr) && apiErr.StatusCode == http.StatusPreconditionFailed
+}
+
+// write applies the planned items and returns the resulting state.
+func (r *edgeConfigItemsResource) write(ctx context.Context, plan EdgeConfigItems, current map[string]json.RawMessage, digest string) (EdgeConfigItems, diag.Diagnostics) {
+	planned, diags := edgeConfigItemsMap(ctx, plan.Items)
+	if diags.HasError() {
+		return plan, diags
+	}
+
+	operations := edgeConfigItemsOperations(current, planned)
+	if len(operations) > 0 {
+		if !plan.CheckDigest.ValueBool() {
+			digest = ""
+		}
+		err := r.client.UpdateEdgeConfigItems(ctx, client.UpdateEdgeConfigItemsRequest{
+			EdgeConfigID: plan.EdgeConfigID.ValueString(),
+			TeamID:       plan.TeamID.ValueString(),
+			Items:        operations,
+			Digest:       digest,
+		})
+		if edgeConfigItemsPreconditionFailed(err) {
+			diags.AddError(
+				"Error updating Edge Config Items",
+				fmt.Sprintf("Edge Config %s has changed since it was last read, so the changes were not written. Run the plan again to review the latest items.", plan.EdgeConfigID.ValueString()),
+			)
+			return plan, diags
+		}
+		if err != nil {
+			diags.AddError(
+				"Error updating Edge Config Items",
+				fmt.Sprintf("Could not update items of Edge Config %s, unexpected error: %s", plan.EdgeConfigID.ValueString(), err),
+			)
+			return plan, diags
+		}
+	}
+
+	out, err := r.client.GetEdgeConfig(ctx, plan.EdgeConfigID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error reading Edge Config",
+			fmt.Sprintf("Could not read Edge Config %s, unexpected error: %s", plan.EdgeConfigID.ValueString(), err),
+		)
+		return plan, diags
+	}
+
+	tflog.Info(ctx, "wrote edge config items", map[string]any{
+		"edge_config_id": plan.EdgeConfigID.ValueString(),
+		"operations":     len(operations),
+	})
+	return EdgeConfigItems{
+		ID:               plan.EdgeConfigID,
+		EdgeConfigID:     plan.EdgeConfigID,
+		TeamID:           toTeamID(r.client.TeamID(plan.TeamID.ValueString())),
+		Items:            plan.Items,
+		CheckDigest:      plan.CheckDigest,
+		Digest:           types.StringValue(out.Digest),
+		SchemaDefinition: plan.SchemaDefinition,
+	}, diags
+}
+
+// read returns the items of an Edge Config, formatted to match preferred
+// where the values are equal.
+func (r *edgeConfigItemsResource) read(ctx context.Context, edgeConfigID, teamID string, preferred map[string]string) (map[string]string, string, error) {
+	// The digest is read before the items, so that a write in between makes
+	// the digest stale rather than hiding the write.
+	out, err := r.client.GetEdgeConfig(ctx, edgeConfigID, teamID)
+	if err != nil {
+		return nil, "", err
+	}
+	items, err := r.client.ListEdgeConfigItems(ctx, edgeConfigID, teamID)
+	if err != nil {
+		return nil, "", err
+	}
+	values, err := edgeConfigItemsFromResponse(items, preferred)
+	return values, out.Digest, err
+}
+
+func (r *edgeConfigItemsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EdgeConfigItems
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The resource is authoritative, so existing items not in the plan are
+	// deleted as part of the first write.
+	existing, digest, err := r.read(ctx, plan.EdgeConfigID.ValueString(), plan.TeamID.ValueString(), nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Edge Config Items",
+			fmt.Sprintf("Could not read items of Edge Config %s, unexpected error: %s", plan.EdgeConfigID.ValueString(), err),
+		)
+		return
+	}
+
+	result, diags := r.write(ctx, plan, edgeConfigItemsCurrent(existing), digest)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *edgeConfigItemsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EdgeConfigItems
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	preferred, diags := edgeConfigItemsMap(ctx, state.Items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values, digest, err := r.read(ctx, state.EdgeConfigID.ValueString(), state.TeamID.ValueString(), preferred)
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config Items",
+			fmt.Sprintf("Could not read items of Edge Config %s, unexpected error: %s", state.EdgeConfigID.ValueString(), err),
+		)
+		return
+	}
+
+	items, diags := types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.Items = items
+	state.Digest = types.StringValue(digest)
+	if state.CheckDigest.IsNull() {
+		state.CheckDigest = types.BoolValue(false)
+	}
+	tflog.Info(ctx, "read edge config items", map[string]any{
+		"edge_config_id": state.EdgeConfigID.ValueString(),
+		"items":          len(values),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *edgeConfigItemsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EdgeConfigItems
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The state was refreshed before planning, so it holds every item in the
+	// Edge Config and the digest they were read at.
+	current, diags := edgeConfigItemsMap(ctx, state.Items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.write(ctx, plan, edgeConfigItemsCurrent(current), state.Digest.ValueString())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *edgeConfigItemsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EdgeConfigItems
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	current, diags := edgeConfigItemsMap(ctx, state.Items)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	operations := edgeConfigItemsOperations(edgeConfigItemsCurrent(current), nil)
+	if len(operations) == 0 {
+		return
+	}
+	err := r.client.UpdateEdgeConfigItems(ctx, client.UpdateEdgeConfigItemsRequest{
+		EdgeConfigID: state.EdgeConfigID.ValueString(),
+		TeamID:       state.TeamID.ValueString(),
+		Items:        operations,
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Edge Config Items",
+			fmt.Sprintf("Could not delete items of Edge Config %s, unexpected error: %s", state.EdgeConfigID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted edge config items", map[string]any{
+		"edge_config_id": state.EdgeConfigID.ValueString(),
+		"items":          len(operations),
+	})
+}
+
+func (r *edgeConfigItemsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, id, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing Edge Config Items",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/edge_config_id\" or \"edge_config_id\"", req.ID),
+		)
+		return
+	}
+
+	values, digest, err := r.read(ctx, id, teamID, nil)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config Items",
+			fmt.Sprintf("Could not read items of Edge Config %s, unexpected error: %s", id, err),
+		)
+		return
+	}
+
+	items, diags := types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result := EdgeConfigItems{
+		ID:               types.StringValue(id),
+		EdgeConfigID:     types.StringValue(id),
+		TeamID:           toTeamID(r.client.TeamID(teamID)),
+		Items:            items,
+		CheckDigest:      types.BoolValue(false),
+		Digest:           types.StringValue(digest),
+		SchemaDefinition: types.StringNull(),
+	}
+	tflog.Info(ctx, "import edge config items", map[string]any{
+		"team_id":        result.TeamID.ValueString(),
+		"edge_config_id": id,
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,120 @@
+package vercel_test
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func getEdgeConfigItemsImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return "", fmt.Errorf("no ID is set")
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.ID), nil
+	}
+}
+
+func TestAcc_EdgeConfigItemsResource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckEdgeConfigDeleted(testClient(t), "vercel_edge_config.test_items", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccResourceEdgeConfigItems(name, `
+    greeting = jsonencode("hello")
+    flags    = jsonencode({ featureA = true, nested = { a = 1, b = [1, 2, 3] } })
+    limit    = jsonencode(10)
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckEdgeConfigExists(testClient(t), testTeam(t), "vercel_edge_config.test_items"),
+					resource.TestCheckResourceAttrPair("vercel_edge_config_items.test", "id", "vercel_edge_config.test_items", "id"),
+					resource.TestCheckResourceAttr("vercel_edge_config_items.test", "items.%", "3"),
+					resource.TestCheckResourceAttr("vercel_edge_config_items.test", "items.greeting", `"hello"`),
+					resource.TestCheckResourceAttr("vercel_edge_config_items.test", "items.limit", "10"),
+					resource.TestCheckResourceAttrSet("vercel_edge_config_items.test", "digest"),
+				),
+			},
+			{
+				ResourceName:            "vercel_edge_config_items.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       getEdgeConfigItemsImportID("vercel_edge_config_items.test"),
+				ImportStateVerifyIgnore: []string{"items.flags", "check_digest"},
+			},
+			{
+				Config: cfg(testAccResourceEdgeConfigItems(name, `
+    greeting = jsonencode("goodbye")
+    flags    = jsonencode({ featureA = false })
+`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_edge_config_items.test", "items.%", "2"),
+					resource.TestCheckResourceAttr("vercel_edge_config_items.test", "items.greeting", `"goodbye"`),
+					resource.TestCheckNoResourceAttr("vercel_edge_config_items.test", "items.limit"),
+					testCheckEdgeConfigItemDeleted(testClient(t), "vercel_edge_config.test_items", "limit", testTeam(t)),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceEdgeConfigItems(name, items string) string {
+	return fmt.Sprintf(`
+resource "vercel_edge_config" "test_items" {
+    name = "%[1]s"
+}
+
+resource "vercel_edge_config_items" "test" {
+    edge_config_id = vercel_edge_config.test_items.id
+    check_digest   = true
+    items = {
+%[2]s
+    }
+}
+`, name, items)
+}
+
+func TestAcc_EdgeConfigItemsResourceSchemaValidation(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(fmt.Sprintf(`
+resource "vercel_edge_config" "test_items_schema" {
+    name = "%[1]s"
+}
+
+resource "vercel_edge_config_items" "test" {
+    edge_config_id    = vercel_edge_config.test_items_schema.id
+    schema_definition = jsonencode({
+        type = "object"
+        properties = {
+            flags = {
+                type       = "object"
+                properties = { rollout = { type = "number", maximum = 1 } }
+            }
+        }
+    })
+    items = {
+        flags = jsonencode({ rollout = 5 })
+    }
+}
+`, name)),
+				ExpectError: regexp.MustCompile(strings.ReplaceAll(`item "flags" does not match the Edge Config schema at "/rollout"`, " ", `\s*`)),
+			},
+		},
+	})
+}
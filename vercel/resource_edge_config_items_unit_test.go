@@ -0,0 +1,66 @@
+package vercel
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestEdgeConfigItemsOperations(t *testing.T) {
+	current := map[string]json.RawMessage{
+		"unchanged": json.RawMessage(`{"a":1,"b":[1,2]}`),
+		"changed":   json.RawMessage(`"old"`),
+		"removed":   json.RawMessage(`true`),
+		"big":       json.RawMessage(`12345678901234567890`),
+	}
+	planned := map[string]string{
+		"unchanged": `{ "b": [1, 2], "a": 1 }`,
+		"changed":   `"new"`,
+		"added":     `null`,
+		"big":       `12345678901234567891`,
+	}
+
+	got := edgeConfigItemsOperations(current, planned)
+	want := []client.EdgeConfigOperation{
+		{Operation: "create", Key: "added", Value: json.RawMessage(`null`)},
+		{Operation: "update", Key: "big", Value: json.RawMessage(`12345678901234567891`)},
+		{Operation: "update", Key: "changed", Value: json.RawMessage(`"new"`)},
+		{Operation: "delete", Key: "removed"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("edgeConfigItemsOperations() = %+v, want %+v", got, want)
+	}
+
+	if got := edgeConfigItemsOperations(current, nil); len(got) != len(current) {
+		t.Errorf("deleting every item produced %d operations, want %d", len(got), len(current))
+	}
+}
+
+func TestEdgeConfigItemsFromResponse(t *testing.T) {
+	items := []client.EdgeConfigItem{
+		{Key: "kept", Value: json.RawMessage(`{"a": 1, "b": 2}`)},
+		{Key: "drifted", Value: json.RawMessage(`{ "a": 2 }`)},
+		{Key: "unmanaged", Value: json.RawMessage(`[1, 2]`)},
+		{Key: "empty"},
+	}
+	preferred := map[string]string{
+		"kept":    `{"b":2,"a":1}`,
+		"drifted": `{"a":1}`,
+	}
+
+	got, err := edgeConfigItemsFromResponse(items, preferred)
+	if err != nil {
+		t.Fatalf("edgeConfigItemsFromResponse() returned error: %v", err)
+	}
+	want := map[string]string{
+		"kept":      `{"b":2,"a":1}`,
+		"drifted":   `{"a":2}`,
+		"unmanaged": `[1,2]`,
+		"empty":     `null`,
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("edgeConfigItemsFromResponse() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,500 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                   = &edgeConfigProjectConnectionResource{}
+	_ resource.ResourceWithConfigure      = &edgeConfigProjectConnectionResource{}
+	_ resource.ResourceWithValidateConfig = &edgeConfigProjectConnectionResource{}
+)
+
+func newEdgeConfigProjectConnectionResource() resource.Resource {
+	return &edgeConfigProjectConnectionResource{}
+}
+
+type edgeConfigProjectConnectionResource struct {
+	client *client.Client
+}
+
+type EdgeConfigProjectConnection struct {
+	ID               types.String `tfsdk:"id"`
+	EdgeConfigID     types.String `tfsdk:"edge_config_id"`
+	ProjectID        types.String `tfsdk:"project_id"`
+	TeamID           types.String `tfsdk:"team_id"`
+	EnvVarName       types.String `tfsdk:"env_var_name"`
+	Target           types.Set    `tfsdk:"target"`
+	Sensitive        types.Bool   `tfsdk:"sensitive"`
+	TokenLabel       types.String `tfsdk:"token_label"`
+	TokenID          types.String `tfsdk:"token_id"`
+	Token            types.String `tfsdk:"token"`
+	ConnectionString types.String `tfsdk:"connection_string"`
+}
+
+const (
+	defaultEdgeConfigConnectionEnvVarName = "EDGE_CONFIG"
+	defaultEdgeConfigConnectionTokenLabel = "Terraform"
+)
+
+func edgeConfigConnectionDefaultTargetValue() types.Set {
+	return types.SetValueMust(types.StringType, []attr.Value{
+		types.StringValue("development"),
+		types.StringValue("preview"),
+		types.StringValue("production"),
+	})
+}
+
+func (r *edgeConfigProjectConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_config_project_connection"
+}
+
+func (r *edgeConfigProjectConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *edgeConfigProjectConnectionResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Connects an Edge Config to a project.
+
+An Edge Config is a global data store that enables experimentation with feature flags, A/B testing, critical redirects, and more.
+
+This resource creates an Edge Config token and writes the connection string for it to a project Environment Variable, ` + "`EDGE_CONFIG`" + ` by default, which the Edge Config client SDK reads. Destroying the resource deletes both the Environment Variable and the token. If either of them is deleted outside Terraform, the connection is created again, replacing the Environment Variable and token that were left behind.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the Environment Variable that holds the connection string.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"edge_config_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the Edge Config to connect.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"project_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the Vercel project to connect to the Edge Config.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team that owns the Edge Config and project. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"env_var_name": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString(defaultEdgeConfigConnectionEnvVarName),
+				Description:   fmt.Sprintf("The name of the Environment Variable to write the connection string to. Defaults to `%s`.", defaultEdgeConfigConnectionEnvVarName),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"target": schema.SetAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     setdefault.StaticValue(edgeConfigConnectionDefaultTargetValue()),
+				Description: "The environments that the Environment Variable should be present on. Valid targets are `production`, `preview` and `development`. Defaults to all of them.",
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+					setvalidator.ValueStringsAre(stringvalidator.OneOf("production", "preview", "development")),
+				},
+			},
+			"sensitive": schema.BoolAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       booldefault.StaticBool(false),
+				Description:   "Whether the Environment Variable is sensitive, meaning it cannot be read via the API or Vercel Dashboard once set. Sensitive Environment Variables can not target `development`. Defaults to `false`.",
+				PlanModifiers: []planmodifier.Bool{boolplanmodifier.RequiresReplace()},
+			},
+			"token_label": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Default:       stringdefault.StaticString(defaultEdgeConfigConnectionTokenLabel),
+				Description:   fmt.Sprintf("The label of the Edge Config token created for the connection. Defaults to `%s`.", defaultEdgeConfigConnectionTokenLabel),
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 52),
+				},
+			},
+			"token_id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the Edge Config token created for the connection.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"token": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "The Edge Config token created for the connection.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"connection_string": schema.StringAttribute{
+				Computed:      true,
+				Sensitive:     true,
+				Description:   "The connection string written to the Environment Variable.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+		},
+	}
+}
+
+func (r *edgeConfigProjectConnectionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config EdgeConfigProjectConnection
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() || !config.Sensitive.ValueBool() {
+		return
+	}
+
+	// An unset target defaults to every environment, including development.
+	development := types.StringValue("development")
+	if config.Target.IsNull() || (!config.Target.IsUnknown() && slices.Contains(config.Target.Elements(), attr.Value(development))) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("target"),
+			"Invalid Edge Config project connection",
+			"A sensitive Environment Variable can not target `development`. Set `target` to `preview` and/or `production`, or set `sensitive` to `false`.",
+		)
+	}
+}
+
+func (c EdgeConfigProjectConnection) envVarType() string {
+	if c.Sensitive.ValueBool() {
+		return "sensitive"
+	}
+	return "encrypted"
+}
+
+func (c EdgeConfigProjectConnection) envVarComment() string {
+	return fmt.Sprintf("Connection string for Edge Config %s", c.EdgeConfigID.ValueString())
+}
+
+func (r *edgeConfigProjectConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EdgeConfigProjectConnection
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var target []string
+	diags = plan.Target.ElementsAs(ctx, &target, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.deleteLeftoverConnection(ctx, plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token, err := r.client.CreateEdgeConfigToken(ctx, client.CreateEdgeConfigTokenRequest{
+		Label:        plan.TokenLabel.ValueString(),
+		TeamID:       plan.TeamID.ValueString(),
+		EdgeConfigID: plan.EdgeConfigID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Edge Config project connection",
+			fmt.Sprintf("Could not create a token for Edge Config %s, unexpected error: %s", plan.EdgeConfigID.ValueString(), err),
+		)
+		return
+	}
+
+	env, err := r.client.CreateEnvironmentVariable(ctx, client.CreateEnvironmentVariableRequest{
+		EnvironmentVariable: client.EnvironmentVariableRequest{
+			Key:     plan.EnvVarName.ValueString(),
+			Value:   token.ConnectionString(),
+			Target:  target,
+			Type:    plan.envVarType(),
+			Comment: plan.envVarComment(),
+		},
+		ProjectID: plan.ProjectID.ValueString(),
+		TeamID:    plan.TeamID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating Edge Config project connection",
+			fmt.Sprintf("Could not create Environment Variable %s on project %s, unexpected error: %s", plan.EnvVarName.ValueString(), plan.ProjectID.ValueString(), err),
+		)
+		// The token is not tracked in state, so it is cleaned up here.
+		if err := r.client.DeleteEdgeConfigToken(ctx, client.EdgeConfigTokenRequest{
+			Token:        token.Token,
+			TeamID:       plan.TeamID.ValueString(),
+			EdgeConfigID: plan.EdgeConfigID.ValueString(),
+		}); err != nil {
+			resp.Diagnostics.AddWarning(
+				"Error deleting Edge Config Token",
+				fmt.Sprintf("Could not delete Edge Config Token %s after the connection failed, it should be deleted manually: %s", token.ID, err),
+			)
+		}
+		return
+	}
+
+	result := EdgeConfigProjectConnection{
+		ID:               types.StringValue(env.ID),
+		EdgeConfigID:     plan.EdgeConfigID,
+		ProjectID:        plan.ProjectID,
+		TeamID:           toTeamID(r.client.TeamID(plan.TeamID.ValueString())),
+		EnvVarName:       plan.EnvVarName,
+		Target:           plan.Target,
+		Sensitive:        plan.Sensitive,
+		TokenLabel:       plan.TokenLabel,
+		TokenID:          types.StringValue(token.ID),
+		Token:            types.StringValue(token.Token),
+		ConnectionString: types.StringValue(token.ConnectionString()),
+	}
+	tflog.Info(ctx, "created edge config project connection", map[string]any{
+		"edge_config_id": result.EdgeConfigID.ValueString(),
+		"project_id":     result.ProjectID.ValueString(),
+		"token_id":       result.TokenID.ValueString(),
+		"env_id":         result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *edgeConfigProjectConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EdgeConfigProjectConnection
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The connection is gone if either half of it is, so that it is created
+	// again. The half that is left is cleaned up by Create, as Read must not
+	// change anything remotely.
+	_, err := r.client.GetEdgeConfigToken(ctx, client.EdgeConfigTokenRequest{
+		Token:        state.Token.ValueString(),
+		TeamID:       state.TeamID.ValueString(),
+		EdgeConfigID: state.EdgeConfigID.ValueString(),
+	})
+	tokenGone := client.NotFound(err)
+	if err != nil && !tokenGone {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config project connection",
+			fmt.Sprintf("Could not get Edge Config Token %s %s, unexpected error: %s", state.EdgeConfigID.ValueString(), state.TokenID.ValueString(), err),
+		)
+		return
+	}
+
+	env, err := r.client.GetEnvironmentVariable(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	envGone := client.NotFound(err)
+	if err != nil && !envGone {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config project connection",
+			fmt.Sprintf("Could not get project environment variable %s %s, unexpected error: %s", state.ProjectID.ValueString(), state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	if tokenGone || envGone {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	target, diags := types.SetValueFrom(ctx, types.StringType, env.Target)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.EnvVarName = types.StringValue(env.Key)
+	state.Target = target
+	state.Sensitive = types.BoolValue(env.Type == "sensitive")
+	tflog.Info(ctx, "read edge config project connection", map[string]any{
+		"edge_config_id": state.EdgeConfigID.ValueString(),
+		"project_id":     state.ProjectID.ValueString(),
+		"env_id":         state.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Update changes the targets of the Environment Variable. Changes to any other
+// attribute replace the connection.
+func (r *edgeConfigProjectConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state EdgeConfigProjectConnection
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var target []string
+	diags := plan.Target.ElementsAs(ctx, &target, false)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.UpdateEnvironmentVariable(ctx, client.UpdateEnvironmentVariableRequest{
+		Target:    target,
+		Type:      plan.envVarType(),
+		Comment:   plan.envVarComment(),
+		ProjectID: state.ProjectID.ValueString(),
+		TeamID:    state.TeamID.ValueString(),
+		EnvID:     state.ID.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Edge Config project connection",
+			fmt.Sprintf("Could not update project environment variable %s %s, unexpected error: %s", state.ProjectID.ValueString(), state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	state.Target = plan.Target
+	tflog.Info(ctx, "updated edge config project connection", map[string]any{
+		"edge_config_id": state.EdgeConfigID.ValueString(),
+		"project_id":     state.ProjectID.ValueString(),
+		"env_id":         state.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *edgeConfigProjectConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EdgeConfigProjectConnection
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.deleteConnection(ctx, state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "deleted edge config project connection", map[string]any{
+		"edge_config_id": state.EdgeConfigID.ValueString(),
+		"project_id":     state.ProjectID.ValueString(),
+		"token_id":       state.TokenID.ValueString(),
+		"env_id":         state.ID.ValueString(),
+	})
+}
+
+// deleteConnection deletes both halves of a connection. A half that is
+// already gone, or a token that is not known, is skipped.
+func (r *edgeConfigProjectConnectionResource) deleteConnection(ctx context.Context, state EdgeConfigProjectConnection) (diags diag.Diagnostics) {
+	// The Environment Variable is deleted before the token, so the project is
+	// never left with a connection string for a token that no longer exists.
+	err := r.client.DeleteEnvironmentVariable(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.ID.ValueString())
+	if err != nil && !client.NotFound(err) {
+		diags.AddError(
+			"Error deleting Edge Config project connection",
+			fmt.Sprintf("Could not delete project environment variable %s %s, unexpected error: %s", state.ProjectID.ValueString(), state.ID.ValueString(), err),
+		)
+		return diags
+	}
+
+	if state.Token.IsNull() {
+		return diags
+	}
+	err = r.client.DeleteEdgeConfigToken(ctx, client.EdgeConfigTokenRequest{
+		Token:        state.Token.ValueString(),
+		TeamID:       state.TeamID.ValueString(),
+		EdgeConfigID: state.EdgeConfigID.ValueString(),
+	})
+	if err != nil && !client.NotFound(err) {
+		diags.AddError(
+			"Error deleting Edge Config project connection",
+			fmt.Sprintf("Could not delete Edge Config Token %s %s, unexpected error: %s", state.EdgeConfigID.ValueString(), state.TokenID.ValueString(), err),
+		)
+	}
+	return diags
+}
+
+// deleteLeftoverConnection deletes the Environment Variable, and the token it
+// holds, left behind by a connection to the same Edge Config whose other half
+// was deleted outside Terraform. Variables are only considered left behind if
+// they have the comment this resource writes, so variables managed in other
+// ways are never touched.
+func (r *edgeConfigProjectConnectionResource) deleteLeftoverConnection(ctx context.Context, plan EdgeConfigProjectConnection) (diags diag.Diagnostics) {
+	envs, err := r.client.GetEnvironmentVariables(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if err != nil {
+		diags.AddError(
+			"Error creating Edge Config project connection",
+			fmt.Sprintf("Could not list environment variables for project %s, unexpected error: %s", plan.ProjectID.ValueString(), err),
+		)
+		return diags
+	}
+	for _, env := range envs {
+		if env.Key != plan.EnvVarName.ValueString() || env.Comment != plan.envVarComment() {
+			continue
+		}
+		leftover := plan
+		leftover.ID = types.StringValue(env.ID)
+		leftover.Token = types.StringNull()
+		// Sensitive values cannot be read, in which case the token is unknown
+		// and only the variable is deleted.
+		if token := edgeConfigConnectionStringToken(env.Value, plan.EdgeConfigID.ValueString()); token != "" {
+			leftover.Token = types.StringValue(token)
+		}
+		tflog.Info(ctx, "deleting leftover edge config project connection", map[string]any{
+			"edge_config_id": plan.EdgeConfigID.ValueString(),
+			"project_id":     plan.ProjectID.ValueString(),
+			"env_id":         env.ID,
+		})
+		diags.Append(r.deleteConnection(ctx, leftover)...)
+		if diags.HasError() {
+			return diags
+		}
+	}
+	return diags
+}
+
+// edgeConfigConnectionStringToken returns the token in a connection string for
+// the given Edge Config, or an empty string if the value is not one.
+func edgeConfigConnectionStringToken(value, edgeConfigID string) string {
+	u, err := url.Parse(value)
+	if err != nil || u.Host != "edge-config.vercel.com" || u.Path != "/"+edgeConfigID {
+		return ""
+	}
+	return u.Query().Get("token")
+}
@@ -0,0 +1,96 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func testCheckEdgeConfigProjectConnectionDeleted(testClient *client.Client, n, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		_, err := testClient.GetEnvironmentVariable(context.TODO(), rs.Primary.Attributes["project_id"], teamID, rs.Primary.ID)
+		if err == nil {
+			return fmt.Errorf("expected the environment variable to be deleted, but it still exists")
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("unexpected error checking for deleted environment variable: %w", err)
+		}
+
+		_, err = testClient.GetEdgeConfigToken(context.TODO(), client.EdgeConfigTokenRequest{
+			Token:        rs.Primary.Attributes["token"],
+			TeamID:       teamID,
+			EdgeConfigID: rs.Primary.Attributes["edge_config_id"],
+		})
+		if err == nil {
+			return fmt.Errorf("expected the edge config token to be deleted, but it still exists")
+		}
+		if !client.NotFound(err) {
+			return fmt.Errorf("unexpected error checking for deleted edge config token: %w", err)
+		}
+		return nil
+	}
+}
+
+func TestAcc_EdgeConfigProjectConnectionResource(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckEdgeConfigProjectConnectionDeleted(testClient(t), "vercel_edge_config_project_connection.test", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccResourceEdgeConfigProjectConnection(name, "")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vercel_edge_config_project_connection.test", "edge_config_id", "vercel_edge_config.test_connection", "id"),
+					resource.TestCheckResourceAttr("vercel_edge_config_project_connection.test", "env_var_name", "EDGE_CONFIG"),
+					resource.TestCheckResourceAttr("vercel_edge_config_project_connection.test", "target.#", "3"),
+					resource.TestCheckResourceAttrSet("vercel_edge_config_project_connection.test", "id"),
+					resource.TestCheckResourceAttrSet("vercel_edge_config_project_connection.test", "token_id"),
+					resource.TestCheckResourceAttrSet("vercel_edge_config_project_connection.test", "connection_string"),
+				),
+			},
+			{
+				Config: cfg(testAccResourceEdgeConfigProjectConnection(name, `target = ["preview", "production"]`)),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("vercel_edge_config_project_connection.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_edge_config_project_connection.test", "target.#", "2"),
+					resource.TestCheckTypeSetElemAttr("vercel_edge_config_project_connection.test", "target.*", "preview"),
+					resource.TestCheckTypeSetElemAttr("vercel_edge_config_project_connection.test", "target.*", "production"),
+				),
+			},
+		},
+	})
+}
+
+func testAccResourceEdgeConfigProjectConnection(name, extra string) string {
+	return fmt.Sprintf(`
+resource "vercel_edge_config" "test_connection" {
+    name = "%[1]s"
+}
+
+resource "vercel_project" "test_connection" {
+    name = "test-acc-edge-config-%[1]s"
+}
+
+resource "vercel_edge_config_project_connection" "test" {
+    edge_config_id = vercel_edge_config.test_connection.id
+    project_id     = vercel_project.test_connection.id
+    %[2]s
+}
+`, name, extra)
+}
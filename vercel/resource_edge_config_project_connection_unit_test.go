@@ -0,0 +1,183 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestEdgeConfigProjectConnectionValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	res := &edgeConfigProjectConnectionResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	targets := func(values ...string) types.Set {
+		elements := make([]attr.Value, len(values))
+		for i, v := range values {
+			elements[i] = types.StringValue(v)
+		}
+		return types.SetValueMust(types.StringType, elements)
+	}
+
+	tests := []struct {
+		name      string
+		sensitive types.Bool
+		target    types.Set
+		wantErr   bool
+	}{
+		{name: "not sensitive with every target", sensitive: types.BoolNull(), target: types.SetNull(types.StringType)},
+		{name: "sensitive without development", sensitive: types.BoolValue(true), target: targets("preview", "production")},
+		{name: "sensitive with development", sensitive: types.BoolValue(true), target: targets("development", "production"), wantErr: true},
+		{name: "sensitive with the default target", sensitive: types.BoolValue(true), target: types.SetNull(types.StringType), wantErr: true},
+		{name: "unknown target is skipped", sensitive: types.BoolValue(true), target: types.SetUnknown(types.StringType)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			config := EdgeConfigProjectConnection{
+				ID:               types.StringNull(),
+				EdgeConfigID:     types.StringValue("ecfg_123"),
+				ProjectID:        types.StringValue("prj_123"),
+				TeamID:           types.StringNull(),
+				EnvVarName:       types.StringNull(),
+				Target:           tt.target,
+				Sensitive:        tt.sensitive,
+				TokenLabel:       types.StringNull(),
+				TokenID:          types.StringNull(),
+				Token:            types.StringNull(),
+				ConnectionString: types.StringNull(),
+			}
+
+			raw := tfsdk.Plan{Schema: schemaResp.Schema}
+			diags := raw.Set(ctx, config)
+			if diags.HasError() {
+				t.Fatalf("raw.Set() returned diagnostics: %v", diags)
+			}
+
+			resp := &resource.ValidateConfigResponse{}
+			res.ValidateConfig(ctx, resource.ValidateConfigRequest{
+				Config: tfsdk.Config{Raw: raw.Raw, Schema: schemaResp.Schema},
+			}, resp)
+			if got := resp.Diagnostics.HasError(); got != tt.wantErr {
+				t.Errorf("HasError() = %v, want %v\n%v", got, tt.wantErr, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestEdgeConfigProjectConnectionReadRemovesPartialConnection(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/edge-config/ecfg_123/token/token_123":
+			w.WriteHeader(http.StatusNotFound)
+			fmt.Fprintln(w, `{"error":{"code":"not_found","message":"token not found"}}`)
+		case r.Method == http.MethodGet && r.URL.Path == "/v10/projects/prj_123/env/env_123":
+			fmt.Fprintln(w, `{"id":"env_123","key":"EDGE_CONFIG","target":["production"],"type":"encrypted"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	res := &edgeConfigProjectConnectionResource{client: client.New("INVALID").WithBaseURL(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	state := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := state.Set(ctx, EdgeConfigProjectConnection{
+		ID:               types.StringValue("env_123"),
+		EdgeConfigID:     types.StringValue("ecfg_123"),
+		ProjectID:        types.StringValue("prj_123"),
+		TeamID:           types.StringValue("team_123"),
+		EnvVarName:       types.StringValue("EDGE_CONFIG"),
+		Target:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("production")}),
+		Sensitive:        types.BoolValue(false),
+		TokenLabel:       types.StringValue("Terraform"),
+		TokenID:          types.StringValue("tok_id"),
+		Token:            types.StringValue("token_123"),
+		ConnectionString: types.StringValue("https://edge-config.vercel.com/ecfg_123?token=token_123"),
+	}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics setting state: %v", diags)
+	}
+
+	resp := &resource.ReadResponse{State: state}
+	res.Read(ctx, resource.ReadRequest{State: state}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !resp.State.Raw.IsNull() {
+		t.Errorf("expected the connection to be removed from state")
+	}
+}
+
+func TestEdgeConfigProjectConnectionCreateReplacesLeftoverConnection(t *testing.T) {
+	var deleted []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v8/projects/prj_123/env":
+			fmt.Fprintln(w, `{"envs":[
+				{"id":"env_old","key":"EDGE_CONFIG","value":"https://edge-config.vercel.com/ecfg_123?token=token_old","comment":"Connection string for Edge Config ecfg_123","type":"encrypted"},
+				{"id":"env_other","key":"EDGE_CONFIG","value":"https://edge-config.vercel.com/ecfg_456?token=token_other","comment":"managed elsewhere","type":"encrypted"}
+			],"pagination":{"count":2}}`)
+		case r.Method == http.MethodDelete:
+			deleted = append(deleted, r.URL.Path)
+			fmt.Fprintln(w, `{}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v1/edge-config/ecfg_123/token":
+			fmt.Fprintln(w, `{"id":"tok_new","token":"token_new"}`)
+		case r.Method == http.MethodPost && r.URL.Path == "/v10/projects/prj_123/env":
+			fmt.Fprintln(w, `{"created":{"id":"env_new","key":"EDGE_CONFIG","target":["production"],"type":"encrypted"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	ctx := context.Background()
+	res := &edgeConfigProjectConnectionResource{client: client.New("INVALID").WithBaseURL(server.URL)}
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	plan := tfsdk.Plan{Schema: schemaResp.Schema}
+	if diags := plan.Set(ctx, EdgeConfigProjectConnection{
+		ID:               types.StringUnknown(),
+		EdgeConfigID:     types.StringValue("ecfg_123"),
+		ProjectID:        types.StringValue("prj_123"),
+		TeamID:           types.StringValue("team_123"),
+		EnvVarName:       types.StringValue("EDGE_CONFIG"),
+		Target:           types.SetValueMust(types.StringType, []attr.Value{types.StringValue("production")}),
+		Sensitive:        types.BoolValue(false),
+		TokenLabel:       types.StringValue("Terraform"),
+		TokenID:          types.StringUnknown(),
+		Token:            types.StringUnknown(),
+		ConnectionString: types.StringUnknown(),
+	}); diags.HasError() {
+		t.Fatalf("unexpected diagnostics setting plan: %v", diags)
+	}
+
+	resp := &resource.CreateResponse{State: tfsdk.State{Schema: schemaResp.Schema}}
+	res.Create(ctx, resource.CreateRequest{Plan: plan}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	want := []string{"/v8/projects/prj_123/env/env_old", "/v1/edge-config/ecfg_123/tokens"}
+	if !reflect.DeepEqual(deleted, want) {
+		t.Errorf("deleted = %v, want %v", deleted, want)
+	}
+}
@@ -0,0 +1,342 @@
+package vercel
+
+import (
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource               = &edgeConfigRestoreResource{}
+	_ resource.ResourceWithConfigure  = &edgeConfigRestoreResource{}
+	_ resource.ResourceWithModifyPlan = &edgeConfigRestoreResource{}
+)
+
+func newEdgeConfigRestoreResource() resource.Resource {
+	return &edgeConfigRestoreResource{}
+}
+
+type edgeConfigRestoreResource struct {
+	client *client.Client
+}
+
+type EdgeConfigRestore struct {
+	ID           types.String `tfsdk:"id"`
+	EdgeConfigID types.String `tfsdk:"edge_config_id"`
+	TeamID       types.String `tfsdk:"team_id"`
+	BackupID     types.String `tfsdk:"backup_id"`
+	Items        types.Map    `tfsdk:"items"`
+	Digest       types.String `tfsdk:"digest"`
+}
+
+func (r *edgeConfigRestoreResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_edge_config_restore"
+}
+
+func (r *edgeConfigRestoreResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *edgeConfigRestoreResource) Schema(_ context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Restores the items of an Edge Config from one of its backups.
+
+The backup is restored when the resource is created, or when ` + "`backup_id`" + ` changes. The plan shows the items that will be restored. Item descriptions are restored along with their values. Items in the Edge Config that are not part of the backup are deleted. The backups of an Edge Config can be found with the ` + "`vercel_edge_config_backups`" + ` data source.
+
+Deleting this resource only removes it from Terraform state. The restored items stay in the Edge Config.
+
+~> This resource should not be used together with ` + "`vercel_edge_config_item`" + ` or ` + "`vercel_edge_config_items`" + ` resources for the same Edge Config, as they will overwrite the restored items on their next apply.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description:   "The unique identifier for this resource. Format: edge_config_id/backup_id.",
+				Computed:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"edge_config_id": schema.StringAttribute{
+				Description:   "The ID of the Edge Config to restore.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the Edge Config exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseStateForUnknown()},
+			},
+			"backup_id": schema.StringAttribute{
+				Description:   "The ID of the backup to restore.",
+				Required:      true,
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"items": schema.MapAttribute{
+				Description: "The items that were restored, as a map of key to JSON encoded value.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"digest": schema.StringAttribute{
+				Description: "The digest of the Edge Config after the backup was restored.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// edgeConfigBackupItems returns the items in a backup as a map of key to JSON
+// encoded value.
+func edgeConfigBackupItems(backup client.EdgeConfigBackup) (map[string]string, error) {
+	items := make([]client.EdgeConfigItem, 0, len(backup.Backup.Items))
+	for key, item := range backup.Backup.Items {
+		items = append(items, client.EdgeConfigItem{Key: key, Value: item.Value})
+	}
+	slices.SortFunc(items, func(a, b client.EdgeConfigItem) int {
+		return cmp.Compare(a.Key, b.Key)
+	})
+	return edgeConfigItemsFromResponse(items, nil)
+}
+
+// edgeConfigRestoreOperations works out the operations that turn the existing
+// items into those in the backup. Items are upserted with the description they
+// had in the backup, so restoring a backup also restores item descriptions.
+func edgeConfigRestoreOperations(existing []client.EdgeConfigItem, backup client.EdgeConfigBackup, restored map[string]string) []client.EdgeConfigOperation {
+	current := make(map[string]client.EdgeConfigItem, len(existing))
+	keys := make([]string, 0, len(existing)+len(restored))
+	for _, item := range existing {
+		current[item.Key] = item
+		keys = append(keys, item.Key)
+	}
+	for key := range restored {
+		if _, ok := current[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	var operations []client.EdgeConfigOperation
+	for _, key := range keys {
+		value, inBackup := restored[key]
+		item, inCurrent := current[key]
+		if !inBackup {
+			operations = append(operations, client.EdgeConfigOperation{Operation: "delete", Key: key})
+			continue
+		}
+		description := backup.Backup.Items[key].Description
+		if inCurrent && edgeConfigItemValuesEqual(item.Value, []byte(value)) && item.Description == description {
+			continue
+		}
+		operations = append(operations, client.EdgeConfigOperation{
+			Operation:   "upsert",
+			Key:         key,
+			Value:       json.RawMessage(value),
+			Description: &description,
+		})
+	}
+	return operations
+}
+
+// ModifyPlan reads the backup being restored, so the plan shows the items that
+// will be restored.
+func (r *edgeConfigRestoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+	var plan EdgeConfigRestore
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	// Items are only unknown when the backup is about to be restored.
+	if resp.Diagnostics.HasError() || !plan.Items.IsUnknown() || plan.EdgeConfigID.IsUnknown() || plan.BackupID.IsUnknown() {
+		return
+	}
+
+	backup, err := r.client.GetEdgeConfigBackup(ctx, plan.EdgeConfigID.ValueString(), plan.BackupID.ValueString(), plan.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("backup_id"),
+			"Edge Config Backup not found",
+			fmt.Sprintf("Backup %s of Edge Config %s could not be found.", plan.BackupID.ValueString(), plan.EdgeConfigID.ValueString()),
+		)
+		return
+	}
+	if err != nil {
+		// The backup is read again during apply, so the items are left unknown.
+		return
+	}
+	values, err := edgeConfigBackupItems(backup)
+	if err != nil {
+		return
+	}
+	items, diags := types.MapValueFrom(ctx, types.StringType, values)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("items"), items)...)
+}
+
+func (r *edgeConfigRestoreResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan EdgeConfigRestore
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	edgeConfigID := plan.EdgeConfigID.ValueString()
+	backupID := plan.BackupID.ValueString()
+	backup, err := r.client.GetEdgeConfigBackup(ctx, edgeConfigID, backupID, plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring Edge Config Backup",
+			fmt.Sprintf("Could not read backup %s of Edge Config %s, unexpected error: %s", backupID, edgeConfigID, err),
+		)
+		return
+	}
+	restored, err := edgeConfigBackupItems(backup)
+	if err != nil {
+		resp.Diagnostics.AddError("Error parsing Edge Config Backup", err.Error())
+		return
+	}
+
+	// The digest is read before the items, and the restore is only written if
+	// the Edge Config has not changed in between.
+	ec, err := r.client.GetEdgeConfig(ctx, edgeConfigID, plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring Edge Config Backup",
+			fmt.Sprintf("Could not read Edge Config %s, unexpected error: %s", edgeConfigID, err),
+		)
+		return
+	}
+	existing, err := r.client.ListEdgeConfigItems(ctx, edgeConfigID, plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error restoring Edge Config Backup",
+			fmt.Sprintf("Could not read items of Edge Config %s, unexpected error: %s", edgeConfigID, err),
+		)
+		return
+	}
+	operations := edgeConfigRestoreOperations(existing, backup, restored)
+	if len(operations) > 0 {
+		err = r.client.UpdateEdgeConfigItems(ctx, client.UpdateEdgeConfigItemsRequest{
+			EdgeConfigID: edgeConfigID,
+			TeamID:       plan.TeamID.ValueString(),
+			Items:        operations,
+			Digest:       ec.Digest,
+		})
+		if edgeConfigItemsPreconditionFailed(err) {
+			resp.Diagnostics.AddError(
+				"Error restoring Edge Config Backup",
+				fmt.Sprintf("Edge Config %s changed while backup %s was being restored, so the backup was not restored. Apply again to restore it.", edgeConfigID, backupID),
+			)
+			return
+		}
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error restoring Edge Config Backup",
+				fmt.Sprintf("Could not restore backup %s of Edge Config %s, unexpected error: %s", backupID, edgeConfigID, err),
+			)
+			return
+		}
+		if ec, err = r.client.GetEdgeConfig(ctx, edgeConfigID, plan.TeamID.ValueString()); err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Edge Config",
+				fmt.Sprintf("Could not read Edge Config %s, unexpected error: %s", edgeConfigID, err),
+			)
+			return
+		}
+	}
+
+	items, diags := types.MapValueFrom(ctx, types.StringType, restored)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	result := EdgeConfigRestore{
+		ID:           types.StringValue(edgeConfigID + "/" + backupID),
+		EdgeConfigID: plan.EdgeConfigID,
+		TeamID:       toTeamID(r.client.TeamID(plan.TeamID.ValueString())),
+		BackupID:     plan.BackupID,
+		Items:        items,
+		Digest:       types.StringValue(ec.Digest),
+	}
+	tflog.Info(ctx, "restored edge config backup", map[string]any{
+		"edge_config_id": edgeConfigID,
+		"backup_id":      backupID,
+		"operations":     len(operations),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Read only checks that the Edge Config still exists. The restore happened
+// once, so later changes to the items are not drift.
+func (r *edgeConfigRestoreResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state EdgeConfigRestore
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetEdgeConfig(ctx, state.EdgeConfigID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Edge Config",
+			fmt.Sprintf("Could not read Edge Config %s, unexpected error: %s", state.EdgeConfigID.ValueString(), err),
+		)
+		return
+	}
+}
+
+func (r *edgeConfigRestoreResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating an Edge Config Restore is not supported. If you see this error, this is a bug in the provider.",
+		"Updating an Edge Config Restore is not supported. If you see this error, this is a bug in the provider.",
+	)
+}
+
+// Delete leaves the restored items in place.
+func (r *edgeConfigRestoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state EdgeConfigRestore
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "removed edge config restore from state", map[string]any{
+		"edge_config_id": state.EdgeConfigID.ValueString(),
+		"backup_id":      state.BackupID.ValueString(),
+	})
+}
@@ -0,0 +1,98 @@
+package vercel_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func testCheckEdgeConfigItemValue(testClient *client.Client, n, key, value, teamID string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		item, err := testClient.GetEdgeConfigItem(context.TODO(), client.EdgeConfigItemRequest{
+			TeamID:       teamID,
+			EdgeConfigID: rs.Primary.ID,
+			Key:          key,
+		})
+		if err != nil {
+			return fmt.Errorf("could not read edge config item %s: %w", key, err)
+		}
+		if string(item.Value) != value {
+			return fmt.Errorf("edge config item %s is %s, want %s", key, item.Value, value)
+		}
+		return nil
+	}
+}
+
+func TestAcc_EdgeConfigRestoreResource(t *testing.T) {
+	name := acctest.RandString(16)
+	edgeConfig := fmt.Sprintf(`
+resource "vercel_edge_config" "test_restore" {
+    name = "%s"
+}
+`, name)
+	items := func(items string) string {
+		return edgeConfig + fmt.Sprintf(`
+resource "vercel_edge_config_items" "test_restore" {
+    edge_config_id = vercel_edge_config.test_restore.id
+    items = {
+%s
+    }
+}
+`, items)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckEdgeConfigDeleted(testClient(t), "vercel_edge_config.test_restore", testTeam(t)),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(items(`greeting = jsonencode("hello")`)),
+			},
+			{
+				Config: cfg(items(`
+        greeting = jsonencode("goodbye")
+        extra    = jsonencode(1)
+`)),
+			},
+			{
+				// Removing the items resource deletes every item.
+				Config: cfg(edgeConfig),
+			},
+			{
+				Config: cfg(edgeConfig + `
+data "vercel_edge_config_backups" "test" {
+    edge_config_id = vercel_edge_config.test_restore.id
+}
+
+resource "vercel_edge_config_restore" "test" {
+    edge_config_id = vercel_edge_config.test_restore.id
+    backup_id = [
+        for backup in data.vercel_edge_config_backups.test.backups : backup.id
+        if lookup(backup.items, "greeting", "") == jsonencode("hello")
+    ][0]
+}
+`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vercel_edge_config_backups.test", "backups.0.id"),
+					resource.TestCheckResourceAttrSet("data.vercel_edge_config_backups.test", "backups.0.created_at"),
+					resource.TestCheckResourceAttrSet("data.vercel_edge_config_backups.test", "backups.0.digest"),
+					resource.TestCheckResourceAttr("vercel_edge_config_restore.test", "items.%", "1"),
+					resource.TestCheckResourceAttr("vercel_edge_config_restore.test", "items.greeting", `"hello"`),
+					resource.TestCheckResourceAttrSet("vercel_edge_config_restore.test", "digest"),
+					testCheckEdgeConfigItemValue(testClient(t), "vercel_edge_config.test_restore", "greeting", `"hello"`, testTeam(t)),
+					testCheckEdgeConfigItemDeleted(testClient(t), "vercel_edge_config.test_restore", "extra", testTeam(t)),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,62 @@
+package vercel
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestEdgeConfigBackupItems(t *testing.T) {
+	var backup client.EdgeConfigBackup
+	backup.Backup.Items = map[string]client.EdgeConfigBackupItem{
+		"greeting": {Value: json.RawMessage(`"hello"`)},
+		"flags":    {Value: json.RawMessage(`{ "enabled": true, "limit": 12345678901234567890 }`)},
+		"empty":    {},
+	}
+
+	got, err := edgeConfigBackupItems(backup)
+	if err != nil {
+		t.Fatalf("edgeConfigBackupItems() error = %s", err)
+	}
+	want := map[string]string{
+		"greeting": `"hello"`,
+		"flags":    `{"enabled":true,"limit":12345678901234567890}`,
+		"empty":    "null",
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("edgeConfigBackupItems() = %v, want %v", got, want)
+	}
+}
+
+func TestEdgeConfigRestoreOperations(t *testing.T) {
+	var backup client.EdgeConfigBackup
+	backup.Backup.Items = map[string]client.EdgeConfigBackupItem{
+		"same":        {Value: json.RawMessage(`"hello"`), Description: "greeting"},
+		"description": {Value: json.RawMessage(`1`), Description: "restored"},
+		"value":       {Value: json.RawMessage(`true`)},
+		"missing":     {Value: json.RawMessage(`"new"`), Description: "was deleted"},
+	}
+	restored, err := edgeConfigBackupItems(backup)
+	if err != nil {
+		t.Fatalf("edgeConfigBackupItems() error = %s", err)
+	}
+	existing := []client.EdgeConfigItem{
+		{Key: "same", Value: json.RawMessage(`"hello"`), Description: "greeting"},
+		{Key: "description", Value: json.RawMessage(`1`), Description: "changed"},
+		{Key: "value", Value: json.RawMessage(`false`), Description: "added since"},
+		{Key: "extra", Value: json.RawMessage(`null`)},
+	}
+
+	description := func(s string) *string { return &s }
+	want := []client.EdgeConfigOperation{
+		{Operation: "upsert", Key: "description", Value: json.RawMessage(`1`), Description: description("restored")},
+		{Operation: "delete", Key: "extra"},
+		{Operation: "upsert", Key: "missing", Value: json.RawMessage(`"new"`), Description: description("was deleted")},
+		{Operation: "upsert", Key: "value", Value: json.RawMessage(`true`), Description: description("")},
+	}
+	if got := edgeConfigRestoreOperations(existing, backup, restored); !reflect.DeepEqual(got, want) {
+		t.Errorf("edgeConfigRestoreOperations() = %+v, want %+v", got, want)
+	}
+}
@@ -4,22 +4,29 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 
 	"github.com/vercel/terraform-provider-vercel/v5/client"
 )
 
 var (
-	_ resource.Resource                = &featureFlagConfigResource{}
-	_ resource.ResourceWithConfigure   = &featureFlagConfigResource{}
-	_ resource.ResourceWithImportState = &featureFlagConfigResource{}
+	_ resource.Resource                   = &featureFlagConfigResource{}
+	_ resource.ResourceWithConfigure      = &featureFlagConfigResource{}
+	_ resource.ResourceWithImportState    = &featureFlagConfigResource{}
+	_ resource.ResourceWithValidateConfig = &featureFlagConfigResource{}
+	_ resource.ResourceWithModifyPlan     = &featureFlagConfigResource{}
 )
 
 func newFeatureFlagConfigResource() resource.Resource {
@@ -31,13 +38,15 @@ type featureFlagConfigResource struct {
 }
 
 type featureFlagConfigModel struct {
-	ID          types.String                `tfsdk:"id"`
-	ProjectID   types.String                `tfsdk:"project_id"`
-	TeamID      types.String                `tfsdk:"team_id"`
-	FlagID      types.String                `tfsdk:"flag_id"`
-	Production  featureFlagEnvironmentModel `tfsdk:"production"`
-	Preview     featureFlagEnvironmentModel `tfsdk:"preview"`
-	Development featureFlagEnvironmentModel `tfsdk:"development"`
+	ID            types.String                      `tfsdk:"id"`
+	ProjectID     types.String                      `tfsdk:"project_id"`
+	TeamID        types.String                      `tfsdk:"team_id"`
+	FlagID        types.String                      `tfsdk:"flag_id"`
+	Production    featureFlagConfigEnvironmentModel `tfsdk:"production"`
+	Preview       featureFlagConfigEnvironmentModel `tfsdk:"preview"`
+	Development   featureFlagConfigEnvironmentModel `tfsdk:"development"`
+	Revisions     types.Map                         `tfsdk:"revisions"`
+	ChangeMessage types.String                      `tfsdk:"change_message"`
 }
 
 func (r *featureFlagConfigResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -73,13 +82,23 @@ func featureFlagConfigEnvironmentSchema(description string) schema.SingleNestedA
 				Description: "Whether the flag should actively evaluate in this environment.",
 			},
 			"default_variant_id": schema.StringAttribute{
-				Required:    true,
-				Description: "The variant to serve when this environment is enabled and no rules match.",
+				Optional:    true,
+				Description: "The variant to serve when this environment is enabled and no rules match. Exactly one of `default_variant_id`, `default_split` or `reuse_environment` must be set.",
 			},
+			"default_split": featureFlagSplitSchema("A weighted split of variants to serve when this environment is enabled and no rules match. Exactly one of `default_variant_id`, `default_split` or `reuse_environment` must be set."),
 			"disabled_variant_id": schema.StringAttribute{
 				Required:    true,
 				Description: "The variant to serve while this environment is disabled or paused.",
 			},
+			"reuse_environment": schema.StringAttribute{
+				Optional:    true,
+				Description: "Serve the same rules, targets and default outcome as another environment, for example `production`. Cannot be combined with `default_variant_id`, `default_split`, `rules` or `targets`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(featureFlagEnvironmentNames...),
+				},
+			},
+			"rules":   featureFlagRulesSchema(),
+			"targets": featureFlagTargetsSchema(),
 		},
 	}
 }
@@ -89,11 +108,13 @@ func (r *featureFlagConfigResource) Schema(_ context.Context, req resource.Schem
 		Description: `
 Provides a Feature Flag Config resource.
 
-This resource manages the simplified static rollout shape for a flag across ` + "`production`" + `, ` + "`preview`" + `, and ` + "`development`" + `.
+This resource manages how a flag is rolled out across ` + "`production`" + `, ` + "`preview`" + `, and ` + "`development`" + `: the default outcome, ordered targeting rules, percentage splits, individually targeted entities, and environments that reuse another environment's configuration.
 
 Use this resource together with ` + "`vercel_feature_flag_definition`" + ` when Terraform should own the rollout. If you omit this resource, the flag definition can still exist while rollout is managed through the Vercel dashboard.
 
-It is intentionally strict: linked environments, rules, and target overrides must not already be configured on the flag when Terraform manages this resource.
+The configuration of every environment is authoritative: rules and targets that are added through the Vercel dashboard are removed on the next apply. Rules are validated during plan, including the variants and segments they reference where those are already known.
+
+Each apply creates a new revision of the flag. Set ` + "`change_message`" + ` to record why Terraform made the change, and use the ` + "`vercel_feature_flag_revisions`" + ` data source to read the history.
 
 Deleting this resource only removes it from Terraform state. The flag and its current configuration stay in Vercel.
 `,
@@ -122,10 +143,119 @@ Deleting this resource only removes it from Terraform state. The flag and its cu
 			"production":  featureFlagConfigEnvironmentSchema("The production environment behavior for this flag."),
 			"preview":     featureFlagConfigEnvironmentSchema("The preview environment behavior for this flag."),
 			"development": featureFlagConfigEnvironmentSchema("The development environment behavior for this flag."),
+			"revisions": schema.MapAttribute{
+				Computed:    true,
+				ElementType: types.Int64Type,
+				Description: "The current revision of each environment, keyed by environment name. Vercel increments an environment's revision whenever its configuration changes.",
+			},
+			"change_message": featureFlagChangeMessageSchema(),
 		},
 	}
 }
 
+func (r *featureFlagConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	environments := map[string]featureFlagConfigEnvironmentModel{}
+	for _, name := range featureFlagEnvironmentNames {
+		var obj types.Object
+		resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(name), &obj)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if obj.IsNull() || obj.IsUnknown() {
+			continue
+		}
+		var env featureFlagConfigEnvironmentModel
+		resp.Diagnostics.Append(obj.As(ctx, &env, basetypes.ObjectAsOptions{})...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		environments[name] = env
+	}
+
+	_, diags := featureFlagConfigEnvironmentsToClient(ctx, environments)
+	resp.Diagnostics.Append(diags...)
+}
+
+// ModifyPlan checks the variants and segments referenced by the config against
+// the flag and project, so that typos are caught before anything is applied.
+func (r *featureFlagConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+	var plan featureFlagConfigModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		// Whole environments are unknown, so there is nothing to check yet.
+		return
+	}
+	if plan.ProjectID.IsUnknown() || plan.FlagID.IsUnknown() {
+		return
+	}
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+
+	environments, diags := featureFlagConfigEnvironmentsToClient(ctx, featureFlagConfigEnvironments(plan))
+	if diags.HasError() {
+		// Already reported by ValidateConfig.
+		return
+	}
+
+	out, err := r.client.GetFeatureFlag(ctx, client.GetFeatureFlagRequest{
+		ProjectID: plan.ProjectID.ValueString(),
+		TeamID:    teamID,
+		FlagID:    plan.FlagID.ValueString(),
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Feature Flag",
+			fmt.Sprintf(
+				"Could not get Feature Flag %s %s %s to validate its config, unexpected error: %s",
+				teamID,
+				plan.ProjectID.ValueString(),
+				plan.FlagID.ValueString(),
+				err,
+			),
+		)
+		return
+	}
+
+	variantIDs := featureFlagVariantIDsFromClient(out.Variants)
+	checkedSegments := map[string]struct{}{}
+	for _, name := range featureFlagEnvironmentNames {
+		env := environments[name]
+		for _, problem := range featureFlagEnvironmentVariantErrors(name, env, variantIDs) {
+			resp.Diagnostics.AddAttributeError(path.Root(name), "Unknown Feature Flag variant", problem)
+		}
+		for _, segmentID := range featureFlagEnvironmentSegmentIDs(env) {
+			if _, ok := checkedSegments[segmentID]; ok {
+				continue
+			}
+			checkedSegments[segmentID] = struct{}{}
+			_, err := r.client.GetFeatureFlagSegment(ctx, client.GetFeatureFlagSegmentRequest{
+				ProjectID: plan.ProjectID.ValueString(),
+				TeamID:    teamID,
+				SegmentID: segmentID,
+			})
+			if client.NotFound(err) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(name).AtName("rules"),
+					"Unknown Feature Flag segment",
+					fmt.Sprintf("%s.rules references segment %q, but no segment with that ID exists in project %s.", name, segmentID, plan.ProjectID.ValueString()),
+				)
+				continue
+			}
+			if err != nil {
+				resp.Diagnostics.AddError(
+					"Error reading Feature Flag Segment",
+					fmt.Sprintf("Could not get Feature Flag Segment %s to validate the config, unexpected error: %s", segmentID, err),
+				)
+				return
+			}
+		}
+	}
+}
+
 func (r *featureFlagConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan featureFlagConfigModel
 	diags := req.Plan.Get(ctx, &plan)
@@ -135,7 +265,13 @@ func (r *featureFlagConfigResource) Create(ctx context.Context, req resource.Cre
 	}
 	plan.TeamID = types.StringValue(r.client.TeamID(plan.TeamID.ValueString()))
 
-	result, diags := r.applyFeatureFlagConfig(ctx, plan)
+	message, diags := featureFlagChangeMessage(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.applyFeatureFlagConfig(ctx, plan, message)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -183,7 +319,7 @@ func (r *featureFlagConfigResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	result, diags := featureFlagConfigFromClient(out, state)
+	result, diags := featureFlagConfigFromClient(ctx, out, state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -202,7 +338,13 @@ func (r *featureFlagConfigResource) Update(ctx context.Context, req resource.Upd
 	}
 	plan.TeamID = types.StringValue(r.client.TeamID(plan.TeamID.ValueString()))
 
-	result, diags := r.applyFeatureFlagConfig(ctx, plan)
+	message, diags := featureFlagChangeMessage(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	result, diags := r.applyFeatureFlagConfig(ctx, plan, message)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -254,7 +396,7 @@ func (r *featureFlagConfigResource) ImportState(ctx context.Context, req resourc
 		return
 	}
 
-	result, diags := featureFlagConfigFromClient(out, featureFlagConfigModel{
+	result, diags := featureFlagConfigFromClient(ctx, out, featureFlagConfigModel{
 		ProjectID: types.StringValue(projectID),
 		TeamID:    types.StringValue(r.client.TeamID(teamID)),
 		FlagID:    types.StringValue(flagID),
@@ -269,7 +411,7 @@ func (r *featureFlagConfigResource) ImportState(ctx context.Context, req resourc
 	resp.Diagnostics.Append(diags...)
 }
 
-func (r *featureFlagConfigResource) applyFeatureFlagConfig(ctx context.Context, plan featureFlagConfigModel) (featureFlagConfigModel, diag.Diagnostics) {
+func (r *featureFlagConfigResource) applyFeatureFlagConfig(ctx context.Context, plan featureFlagConfigModel, message string) (featureFlagConfigModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	out, err := r.client.GetFeatureFlag(ctx, client.GetFeatureFlagRequest{
@@ -298,17 +440,14 @@ func (r *featureFlagConfigResource) applyFeatureFlagConfig(ctx context.Context,
 		return featureFlagConfigModel{}, diags
 	}
 
-	diags.Append(featureFlagConfigValidateExistingEnvironments(out)...)
-	if diags.HasError() {
-		return featureFlagConfigModel{}, diags
-	}
-
-	environments, d := featureFlagEnvironmentsFromModel(map[string]featureFlagEnvironmentModel{
-		"production":  plan.Production,
-		"preview":     plan.Preview,
-		"development": plan.Development,
-	}, featureFlagVariantIDsFromClient(out.Variants))
+	environments, d := featureFlagConfigEnvironmentsToClient(ctx, featureFlagConfigEnvironments(plan))
 	diags.Append(d...)
+	variantIDs := featureFlagVariantIDsFromClient(out.Variants)
+	for _, name := range featureFlagEnvironmentNames {
+		for _, problem := range featureFlagEnvironmentVariantErrors(name, environments[name], variantIDs) {
+			diags.AddError("Unknown Feature Flag variant", problem)
+		}
+	}
 	if diags.HasError() {
 		return featureFlagConfigModel{}, diags
 	}
@@ -318,6 +457,7 @@ func (r *featureFlagConfigResource) applyFeatureFlagConfig(ctx context.Context,
 		TeamID:       plan.TeamID.ValueString(),
 		FlagID:       plan.FlagID.ValueString(),
 		Environments: environments,
+		Message:      message,
 	})
 	if client.NotFound(err) {
 		diags.AddError(
@@ -340,28 +480,12 @@ func (r *featureFlagConfigResource) applyFeatureFlagConfig(ctx context.Context,
 		return featureFlagConfigModel{}, diags
 	}
 
-	result, d := featureFlagConfigFromClient(updated, plan)
+	result, d := featureFlagConfigFromClient(ctx, updated, plan)
 	diags.Append(d...)
 	return result, diags
 }
 
-func featureFlagConfigValidateExistingEnvironments(out client.FeatureFlag) diag.Diagnostics {
-	var diags diag.Diagnostics
-
-	for _, name := range []string{"production", "preview", "development"} {
-		env, ok := out.Environments[name]
-		if !ok {
-			continue
-		}
-		if err := featureFlagEnvironmentShapeError(name, env); err != nil {
-			diags.AddError("Unsupported Feature Flag config", err.Error())
-		}
-	}
-
-	return diags
-}
-
-func featureFlagConfigFromClient(out client.FeatureFlag, ref featureFlagConfigModel) (featureFlagConfigModel, diag.Diagnostics) {
+func featureFlagConfigFromClient(ctx context.Context, out client.FeatureFlag, ref featureFlagConfigModel) (featureFlagConfigModel, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
 	model := featureFlagConfigModel{
@@ -371,25 +495,22 @@ func featureFlagConfigFromClient(out client.FeatureFlag, ref featureFlagConfigMo
 		FlagID:    types.StringValue(out.ID),
 	}
 
-	production, err := featureFlagEnvironmentFromClient("production", out.Environments["production"])
-	if err != nil {
-		diags.AddError("Unsupported Feature Flag config", err.Error())
-		return model, diags
-	}
-	preview, err := featureFlagEnvironmentFromClient("preview", out.Environments["preview"])
-	if err != nil {
-		diags.AddError("Unsupported Feature Flag config", err.Error())
-		return model, diags
-	}
-	development, err := featureFlagEnvironmentFromClient("development", out.Environments["development"])
-	if err != nil {
-		diags.AddError("Unsupported Feature Flag config", err.Error())
+	production, d := featureFlagConfigEnvironmentFromClient(ctx, "production", out.Environments["production"], ref.Production)
+	diags.Append(d...)
+	preview, d := featureFlagConfigEnvironmentFromClient(ctx, "preview", out.Environments["preview"], ref.Preview)
+	diags.Append(d...)
+	development, d := featureFlagConfigEnvironmentFromClient(ctx, "development", out.Environments["development"], ref.Development)
+	diags.Append(d...)
+	if diags.HasError() {
 		return model, diags
 	}
 
 	model.Production = production
 	model.Preview = preview
 	model.Development = development
+	model.Revisions, d = featureFlagEnvironmentRevisions(out.Environments)
+	diags.Append(d...)
+	model.ChangeMessage = types.StringNull()
 	if model.TeamID.IsNull() {
 		model.TeamID = ref.TeamID
 	}
@@ -405,3 +526,13 @@ func featureFlagConfigFromClient(out client.FeatureFlag, ref featureFlagConfigMo
 
 	return model, diags
 }
+
+func featureFlagEnvironmentRevisions(environments map[string]client.FeatureFlagEnvironment) (types.Map, diag.Diagnostics) {
+	revisions := map[string]attr.Value{}
+	for name, env := range environments {
+		if env.Revision != nil {
+			revisions[name] = types.Int64Value(int64(*env.Revision))
+		}
+	}
+	return types.MapValue(types.Int64Type, revisions)
+}
@@ -2,6 +2,7 @@ package vercel_test
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -92,3 +93,137 @@ resource "vercel_feature_flag_config" "test" {
 }
 `, projectSuffix, key, previewDefault, developmentEnabled)
 }
+
+func TestAcc_FeatureFlagConfigResourceRollout(t *testing.T) {
+	projectSuffix := strings.ToLower(acctest.RandString(10))
+	key := fmt.Sprintf("checkout-%s", projectSuffix)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy:             testCheckFeatureFlagDeleted(testClient(t), "vercel_feature_flag_definition.test"),
+		Steps: []resource.TestStep{
+			{
+				Config:      cfg(testAccFeatureFlagConfigResourceRolloutConfig(projectSuffix, key, "missing")),
+				ExpectError: regexp.MustCompile(`(?s)references "missing", but no variant with that ID\s+exists`),
+			},
+			{
+				Config: cfg(testAccFeatureFlagConfigResourceRolloutConfig(projectSuffix, key, "treatment")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testCheckFeatureFlagExists(testClient(t), "vercel_feature_flag_definition.test"),
+					resource.TestCheckResourceAttr("vercel_feature_flag_config.test", "production.rules.#", "2"),
+					resource.TestCheckResourceAttrPair("vercel_feature_flag_config.test", "production.rules.0.conditions.0.segment_ids.0", "vercel_feature_flag_segment.test", "id"),
+					resource.TestCheckResourceAttr("vercel_feature_flag_config.test", "production.rules.1.split.weights.treatment", "10"),
+					resource.TestCheckResourceAttr("vercel_feature_flag_config.test", "production.targets.#", "1"),
+					resource.TestCheckResourceAttr("vercel_feature_flag_config.test", "preview.reuse_environment", "production"),
+					resource.TestCheckResourceAttr("vercel_feature_flag_config.test", "development.default_split.fallback_variant_id", "control"),
+				),
+			},
+			{
+				ResourceName:      "vercel_feature_flag_config.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getFeatureFlagImportID("vercel_feature_flag_config.test"),
+			},
+		},
+	})
+}
+
+func testAccFeatureFlagConfigResourceRolloutConfig(projectSuffix, key, segmentVariant string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-feature-flag-rollout-%[1]s"
+}
+
+resource "vercel_feature_flag_definition" "test" {
+  project_id = vercel_project.test.id
+  key        = "%[2]s"
+  kind       = "string"
+  variant = [
+    {
+      id           = "control"
+      value_string = "control"
+    },
+    {
+      id           = "treatment"
+      value_string = "treatment"
+    },
+  ]
+}
+
+resource "vercel_feature_flag_segment" "test" {
+  project_id = vercel_project.test.id
+  slug       = "beta-%[1]s"
+  name       = "Beta testers"
+  include = [
+    {
+      entity    = "user"
+      attribute = "email"
+      values    = ["alice@example.com"]
+    },
+  ]
+}
+
+resource "vercel_feature_flag_config" "test" {
+  project_id = vercel_project.test.id
+  flag_id    = vercel_feature_flag_definition.test.id
+
+  production = {
+    enabled             = true
+    default_variant_id  = "control"
+    disabled_variant_id = "control"
+    rules = [
+      {
+        conditions = [
+          {
+            segment_ids = [vercel_feature_flag_segment.test.id]
+            operator    = "oneOf"
+          },
+        ]
+        variant_id = "%[3]s"
+      },
+      {
+        conditions = [
+          {
+            entity    = "user"
+            attribute = "plan"
+            operator  = "oneOf"
+            values    = ["pro", "enterprise"]
+          },
+        ]
+        split = {
+          entity              = "user"
+          attribute           = "id"
+          weights             = { control = 90, treatment = 10 }
+          fallback_variant_id = "control"
+        }
+      },
+    ]
+    targets = [
+      {
+        variant_id = "treatment"
+        entity     = "user"
+        attribute  = "id"
+        values     = ["user_123"]
+      },
+    ]
+  }
+
+  preview = {
+    enabled             = true
+    disabled_variant_id = "control"
+    reuse_environment   = "production"
+  }
+
+  development = {
+    enabled             = true
+    disabled_variant_id = "control"
+    default_split = {
+      entity              = "user"
+      attribute           = "id"
+      weights             = { control = 50, treatment = 50 }
+      fallback_variant_id = "control"
+    }
+  }
+}
+`, projectSuffix, key, segmentVariant)
+}
@@ -0,0 +1,485 @@
+package vercel
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func testFeatureFlagStringSet(values ...string) types.Set {
+	if values == nil {
+		return types.SetNull(types.StringType)
+	}
+	elements := make([]attr.Value, 0, len(values))
+	for _, value := range values {
+		elements = append(elements, types.StringValue(value))
+	}
+	return types.SetValueMust(types.StringType, elements)
+}
+
+func testFeatureFlagOptionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
+
+func testFeatureFlagCondition(t *testing.T, entity, attribute, operator, value string, values ...string) attr.Value {
+	t.Helper()
+	condition, diags := types.ObjectValueFrom(context.Background(), featureFlagConditionAttrType.AttrTypes, featureFlagConditionModel{
+		Entity:     testFeatureFlagOptionalString(entity),
+		Attribute:  testFeatureFlagOptionalString(attribute),
+		SegmentIDs: types.SetNull(types.StringType),
+		Operator:   types.StringValue(operator),
+		Value:      testFeatureFlagOptionalString(value),
+		Values:     testFeatureFlagStringSet(values...),
+	})
+	if diags.HasError() {
+		t.Fatalf("condition diags = %v", diags)
+	}
+	return condition
+}
+
+func testFeatureFlagSegmentCondition(t *testing.T, operator string, segmentIDs ...string) attr.Value {
+	t.Helper()
+	condition, diags := types.ObjectValueFrom(context.Background(), featureFlagConditionAttrType.AttrTypes, featureFlagConditionModel{
+		Entity:     types.StringNull(),
+		Attribute:  types.StringNull(),
+		SegmentIDs: testFeatureFlagStringSet(segmentIDs...),
+		Operator:   types.StringValue(operator),
+		Value:      types.StringNull(),
+		Values:     types.SetNull(types.StringType),
+	})
+	if diags.HasError() {
+		t.Fatalf("segment condition diags = %v", diags)
+	}
+	return condition
+}
+
+func testFeatureFlagSplit(t *testing.T, weights map[string]float64, fallback string) types.Object {
+	t.Helper()
+	weightsValue, diags := types.MapValueFrom(context.Background(), types.Float64Type, weights)
+	if diags.HasError() {
+		t.Fatalf("weights diags = %v", diags)
+	}
+	split, diags := types.ObjectValueFrom(context.Background(), featureFlagSplitAttrType.AttrTypes, featureFlagSplitModel{
+		Entity:            types.StringValue("user"),
+		Attribute:         types.StringValue("id"),
+		Weights:           weightsValue,
+		FallbackVariantID: types.StringValue(fallback),
+	})
+	if diags.HasError() {
+		t.Fatalf("split diags = %v", diags)
+	}
+	return split
+}
+
+func testFeatureFlagRules(t *testing.T, rules ...featureFlagRuleModel) types.List {
+	t.Helper()
+	list, diags := types.ListValueFrom(context.Background(), featureFlagRuleAttrType, rules)
+	if diags.HasError() {
+		t.Fatalf("rules diags = %v", diags)
+	}
+	return list
+}
+
+func testFeatureFlagTargets(t *testing.T, targets ...featureFlagTargetModel) types.Set {
+	t.Helper()
+	set, diags := types.SetValueFrom(context.Background(), featureFlagTargetAttrType, targets)
+	if diags.HasError() {
+		t.Fatalf("targets diags = %v", diags)
+	}
+	return set
+}
+
+func testFeatureFlagEnvironment() featureFlagConfigEnvironmentModel {
+	return featureFlagConfigEnvironmentModel{
+		Enabled:           types.BoolValue(true),
+		DefaultVariantID:  types.StringValue("control"),
+		DefaultSplit:      types.ObjectNull(featureFlagSplitAttrType.AttrTypes),
+		DisabledVariantID: types.StringValue("control"),
+		ReuseEnvironment:  types.StringNull(),
+		Rules:             types.ListNull(featureFlagRuleAttrType),
+		Targets:           types.SetNull(featureFlagTargetAttrType),
+	}
+}
+
+func testFeatureFlagRolloutEnvironment(t *testing.T) featureFlagConfigEnvironmentModel {
+	env := testFeatureFlagEnvironment()
+	env.Rules = testFeatureFlagRules(t,
+		featureFlagRuleModel{
+			Conditions: types.ListValueMust(featureFlagConditionAttrType, []attr.Value{
+				testFeatureFlagSegmentCondition(t, "oneOf", "seg_beta"),
+			}),
+			VariantID: types.StringValue("treatment"),
+			Split:     types.ObjectNull(featureFlagSplitAttrType.AttrTypes),
+		},
+		featureFlagRuleModel{
+			Conditions: types.ListValueMust(featureFlagConditionAttrType, []attr.Value{
+				testFeatureFlagCondition(t, "user", "email", "endsWith", "@example.com"),
+				testFeatureFlagCondition(t, "user", "plan", "oneOf", "", "pro", "enterprise"),
+				testFeatureFlagCondition(t, "user", "age", "gte", "18.50"),
+				testFeatureFlagCondition(t, "user", "name", "regex", "^a.*"),
+				testFeatureFlagCondition(t, "user", "id", "ex", ""),
+			}),
+			VariantID: types.StringNull(),
+			Split:     testFeatureFlagSplit(t, map[string]float64{"control": 90, "treatment": 10}, "control"),
+		},
+	)
+	env.Targets = testFeatureFlagTargets(t, featureFlagTargetModel{
+		VariantID: types.StringValue("treatment"),
+		Entity:    types.StringValue("user"),
+		Attribute: types.StringValue("id"),
+		Values:    testFeatureFlagStringSet("user_1", "user_2"),
+	})
+	return env
+}
+
+func TestFeatureFlagConfigEnvironmentsToClient(t *testing.T) {
+	preview := testFeatureFlagEnvironment()
+	preview.DefaultVariantID = types.StringNull()
+	preview.ReuseEnvironment = types.StringValue("production")
+
+	development := testFeatureFlagEnvironment()
+	development.DefaultVariantID = types.StringNull()
+	development.DefaultSplit = testFeatureFlagSplit(t, map[string]float64{"control": 1, "treatment": 1}, "control")
+
+	environments, diags := featureFlagConfigEnvironmentsToClient(context.Background(), map[string]featureFlagConfigEnvironmentModel{
+		"production":  testFeatureFlagRolloutEnvironment(t),
+		"preview":     preview,
+		"development": development,
+	})
+	if diags.HasError() {
+		t.Fatalf("featureFlagConfigEnvironmentsToClient() diags = %v", diags)
+	}
+
+	production := environments["production"]
+	if len(production.Rules) != 2 {
+		t.Fatalf("production rules = %d, want 2", len(production.Rules))
+	}
+	wantSegmentRule := `{"id":"rule-1","conditions":[{"lhs":{"type":"segment"},"cmp":"oneOf","rhs":{"type":"list","items":[{"value":"seg_beta"}]}}],"outcome":{"type":"variant","variantId":"treatment"}}`
+	if got := string(production.Rules[0]); got != wantSegmentRule {
+		t.Errorf("segment rule =\n%s\nwant\n%s", got, wantSegmentRule)
+	}
+
+	var rollout client.FeatureFlagRule
+	if err := json.Unmarshal(production.Rules[1], &rollout); err != nil {
+		t.Fatalf("unmarshal rule: %v", err)
+	}
+	if rollout.ID != "rule-2" || rollout.Outcome.Type != "split" || rollout.Outcome.Weights["treatment"] != 10 || rollout.Outcome.DefaultVariantID != "control" {
+		t.Errorf("rollout rule = %+v", rollout)
+	}
+	wantRHS := []any{
+		"@example.com",
+		map[string]any{"type": "list", "items": []any{map[string]any{"value": "enterprise"}, map[string]any{"value": "pro"}}},
+		18.5,
+		map[string]any{"type": "regex", "pattern": "^a.*", "flags": ""},
+		nil,
+	}
+	for i, condition := range rollout.Conditions {
+		if !reflect.DeepEqual(condition.RHS, wantRHS[i]) {
+			t.Errorf("condition %d rhs = %#v, want %#v", i, condition.RHS, wantRHS[i])
+		}
+	}
+
+	wantTargets := map[string]map[string]map[string][]client.FeatureFlagSegmentValue{
+		"treatment": {"user": {"id": {{Value: "user_1"}, {Value: "user_2"}}}},
+	}
+	if !reflect.DeepEqual(production.Targets, wantTargets) {
+		t.Errorf("targets = %+v, want %+v", production.Targets, wantTargets)
+	}
+
+	if got := environments["preview"]; got.Reuse == nil || !got.Reuse.Active || got.Reuse.Environment != "production" || !reflect.DeepEqual(got.Fallthrough, production.Fallthrough) {
+		t.Errorf("preview = %+v, want it to reuse production", got)
+	}
+	if got := environments["development"].Fallthrough; got.Type != "split" || got.Base.Attribute != "id" || got.Weights["treatment"] != 1 {
+		t.Errorf("development fallthrough = %+v", got)
+	}
+}
+
+func TestFeatureFlagConfigEnvironmentsToClientErrors(t *testing.T) {
+	tests := map[string]struct {
+		modify func(env *featureFlagConfigEnvironmentModel)
+		want   string
+	}{
+		"no default": {
+			modify: func(env *featureFlagConfigEnvironmentModel) { env.DefaultVariantID = types.StringNull() },
+			want:   "One of default_variant_id, default_split or reuse_environment must be set",
+		},
+		"both defaults": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.DefaultSplit = testFeatureFlagSplit(t, map[string]float64{"control": 1}, "control")
+			},
+			want: "Only one of default_variant_id or default_split",
+		},
+		"reuse with rules": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				*env = testFeatureFlagRolloutEnvironment(t)
+				env.DefaultVariantID = types.StringNull()
+				env.ReuseEnvironment = types.StringValue("preview")
+			},
+			want: "rules cannot be set together with reuse_environment",
+		},
+		"reuse self": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.DefaultVariantID = types.StringNull()
+				env.ReuseEnvironment = types.StringValue("production")
+			},
+			want: "cannot reuse its own configuration",
+		},
+		"rule without outcome": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.Rules = testFeatureFlagRules(t, featureFlagRuleModel{
+					Conditions: types.ListValueMust(featureFlagConditionAttrType, []attr.Value{
+						testFeatureFlagCondition(t, "user", "id", "eq", "1"),
+					}),
+					VariantID: types.StringNull(),
+					Split:     types.ObjectNull(featureFlagSplitAttrType.AttrTypes),
+				})
+			},
+			want: "Exactly one of variant_id or split",
+		},
+		"list operator with value": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.Rules = testFeatureFlagRules(t, featureFlagRuleModel{
+					Conditions: types.ListValueMust(featureFlagConditionAttrType, []attr.Value{
+						testFeatureFlagCondition(t, "user", "plan", "oneOf", "pro"),
+					}),
+					VariantID: types.StringValue("treatment"),
+					Split:     types.ObjectNull(featureFlagSplitAttrType.AttrTypes),
+				})
+			},
+			want: "use values instead of value",
+		},
+		"non numeric comparison": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.Rules = testFeatureFlagRules(t, featureFlagRuleModel{
+					Conditions: types.ListValueMust(featureFlagConditionAttrType, []attr.Value{
+						testFeatureFlagCondition(t, "user", "age", "gt", "eighteen"),
+					}),
+					VariantID: types.StringValue("treatment"),
+					Split:     types.ObjectNull(featureFlagSplitAttrType.AttrTypes),
+				})
+			},
+			want: "is not a number",
+		},
+		"invalid timestamp": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.Rules = testFeatureFlagRules(t, featureFlagRuleModel{
+					Conditions: types.ListValueMust(featureFlagConditionAttrType, []attr.Value{
+						testFeatureFlagCondition(t, "user", "createdAt", "before", "yesterday"),
+					}),
+					VariantID: types.StringValue("treatment"),
+					Split:     types.ObjectNull(featureFlagSplitAttrType.AttrTypes),
+				})
+			},
+			want: "is not an RFC 3339 timestamp",
+		},
+		"segment with entity": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				condition, _ := types.ObjectValueFrom(context.Background(), featureFlagConditionAttrType.AttrTypes, featureFlagConditionModel{
+					Entity:     types.StringValue("user"),
+					Attribute:  types.StringNull(),
+					SegmentIDs: testFeatureFlagStringSet("seg_beta"),
+					Operator:   types.StringValue("eq"),
+					Value:      types.StringNull(),
+					Values:     types.SetNull(types.StringType),
+				})
+				env.Rules = testFeatureFlagRules(t, featureFlagRuleModel{
+					Conditions: types.ListValueMust(featureFlagConditionAttrType, []attr.Value{condition}),
+					VariantID:  types.StringValue("treatment"),
+					Split:      types.ObjectNull(featureFlagSplitAttrType.AttrTypes),
+				})
+			},
+			want: "Segment conditions only support the oneOf and !oneOf operators",
+		},
+		"zero weights": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.DefaultVariantID = types.StringNull()
+				env.DefaultSplit = testFeatureFlagSplit(t, map[string]float64{"control": 0, "treatment": 0}, "control")
+			},
+			want: "greater than zero",
+		},
+		"negative weight": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.DefaultVariantID = types.StringNull()
+				env.DefaultSplit = testFeatureFlagSplit(t, map[string]float64{"control": 10, "treatment": -1}, "control")
+			},
+			want: "must not be negative",
+		},
+		"conflicting targets": {
+			modify: func(env *featureFlagConfigEnvironmentModel) {
+				env.Targets = testFeatureFlagTargets(t,
+					featureFlagTargetModel{
+						VariantID: types.StringValue("treatment"),
+						Entity:    types.StringValue("user"),
+						Attribute: types.StringValue("id"),
+						Values:    testFeatureFlagStringSet("user_1"),
+					},
+					featureFlagTargetModel{
+						VariantID: types.StringValue("control"),
+						Entity:    types.StringValue("user"),
+						Attribute: types.StringValue("id"),
+						Values:    testFeatureFlagStringSet("user_1"),
+					},
+				)
+			},
+			want: "is targeted at both",
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			production := testFeatureFlagEnvironment()
+			tt.modify(&production)
+			_, diags := featureFlagConfigEnvironmentsToClient(context.Background(), map[string]featureFlagConfigEnvironmentModel{
+				"production":  production,
+				"preview":     testFeatureFlagEnvironment(),
+				"development": testFeatureFlagEnvironment(),
+			})
+			if !diags.HasError() {
+				t.Fatalf("featureFlagConfigEnvironmentsToClient() succeeded, want error containing %q", tt.want)
+			}
+			var details []string
+			for _, d := range diags.Errors() {
+				details = append(details, d.Detail())
+			}
+			if !strings.Contains(strings.Join(details, "\n"), tt.want) {
+				t.Errorf("errors = %v, want one containing %q", details, tt.want)
+			}
+		})
+	}
+}
+
+func TestFeatureFlagConfigReuseChain(t *testing.T) {
+	preview := testFeatureFlagEnvironment()
+	preview.DefaultVariantID = types.StringNull()
+	preview.ReuseEnvironment = types.StringValue("production")
+	development := testFeatureFlagEnvironment()
+	development.DefaultVariantID = types.StringNull()
+	development.ReuseEnvironment = types.StringValue("preview")
+
+	_, diags := featureFlagConfigEnvironmentsToClient(context.Background(), map[string]featureFlagConfigEnvironmentModel{
+		"production":  testFeatureFlagEnvironment(),
+		"preview":     preview,
+		"development": development,
+	})
+	if !diags.HasError() || !strings.Contains(diags.Errors()[0].Detail(), "itself reuses another environment") {
+		t.Fatalf("diags = %v, want a reuse chain error", diags)
+	}
+}
+
+func TestFeatureFlagConfigEnvironmentRoundTrip(t *testing.T) {
+	want := testFeatureFlagRolloutEnvironment(t)
+	environments, diags := featureFlagConfigEnvironmentsToClient(context.Background(), map[string]featureFlagConfigEnvironmentModel{
+		"production": want,
+	})
+	if diags.HasError() {
+		t.Fatalf("featureFlagConfigEnvironmentsToClient() diags = %v", diags)
+	}
+
+	// Simulate the API response, which decodes the rules generically.
+	raw, err := json.Marshal(environments["production"])
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	var decoded client.FeatureFlagEnvironment
+	if err := json.Unmarshal(raw, &decoded); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	got, diags := featureFlagConfigEnvironmentFromClient(context.Background(), "production", decoded, want)
+	if diags.HasError() {
+		t.Fatalf("featureFlagConfigEnvironmentFromClient() diags = %v", diags)
+	}
+	if !got.Rules.Equal(want.Rules) {
+		t.Errorf("rules =\n%s\nwant\n%s", got.Rules, want.Rules)
+	}
+	if !got.Targets.Equal(want.Targets) {
+		t.Errorf("targets =\n%s\nwant\n%s", got.Targets, want.Targets)
+	}
+	if !got.DefaultVariantID.Equal(want.DefaultVariantID) || !got.DefaultSplit.IsNull() || !got.ReuseEnvironment.IsNull() {
+		t.Errorf("environment = %+v, want %+v", got, want)
+	}
+
+	// Without prior state, as on import, the number is normalised.
+	imported, diags := featureFlagConfigEnvironmentFromClient(context.Background(), "production", decoded, featureFlagConfigEnvironmentModel{})
+	if diags.HasError() {
+		t.Fatalf("featureFlagConfigEnvironmentFromClient() diags = %v", diags)
+	}
+	if !strings.Contains(imported.Rules.String(), `"value":"18.5"`) {
+		t.Errorf("imported rules = %s, want the normalised number", imported.Rules)
+	}
+}
+
+func TestFeatureFlagEnvironmentVariantErrors(t *testing.T) {
+	environments, diags := featureFlagConfigEnvironmentsToClient(context.Background(), map[string]featureFlagConfigEnvironmentModel{
+		"production": testFeatureFlagRolloutEnvironment(t),
+	})
+	if diags.HasError() {
+		t.Fatalf("featureFlagConfigEnvironmentsToClient() diags = %v", diags)
+	}
+
+	problems := featureFlagEnvironmentVariantErrors("production", environments["production"], map[string]struct{}{"control": {}})
+	want := []string{
+		`production.rules[0].variant_id references "treatment", but no variant with that ID exists.`,
+		`production.rules[1].split.weights references "treatment", but no variant with that ID exists.`,
+		`production.targets references "treatment", but no variant with that ID exists.`,
+	}
+	if !reflect.DeepEqual(problems, want) {
+		t.Errorf("problems =\n%s\nwant\n%s", strings.Join(problems, "\n"), strings.Join(want, "\n"))
+	}
+
+	if segments := featureFlagEnvironmentSegmentIDs(environments["production"]); !reflect.DeepEqual(segments, []string{"seg_beta"}) {
+		t.Errorf("segments = %v, want [seg_beta]", segments)
+	}
+}
+
+func TestFeatureFlagRevisionsFromClient(t *testing.T) {
+	revision := func(n int) *int { return &n }
+	versions := []client.FeatureFlagVersion{
+		{ID: "ver_3", Revision: 3, Message: "preview only", Environments: map[string]client.FeatureFlagEnvironment{
+			"production": {Revision: revision(1)},
+			"preview":    {Revision: revision(2)},
+		}},
+		{ID: "ver_1", Revision: 1, Environments: map[string]client.FeatureFlagEnvironment{
+			"production": {Revision: revision(1)},
+			"preview":    {Revision: revision(1)},
+		}},
+		{ID: "ver_4", Revision: 4, Message: "ship it", Environments: map[string]client.FeatureFlagEnvironment{
+			"production": {Revision: revision(2)},
+			"preview":    {Revision: revision(2)},
+		}},
+	}
+
+	ids := func(items []featureFlagRevisionsDataSourceItem) []string {
+		out := []string{}
+		for _, item := range items {
+			out = append(out, item.ID.ValueString())
+		}
+		return out
+	}
+
+	all := featureFlagRevisionsFromClient(versions, "")
+	if got, want := ids(all), []string{"ver_4", "ver_3", "ver_1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("all revisions = %v, want %v", got, want)
+	}
+	if !all[2].Message.IsNull() {
+		t.Errorf("message of ver_1 = %s, want null", all[2].Message)
+	}
+	if got := all[1].EnvironmentRevisions["preview"]; got != 2 {
+		t.Errorf("preview revision of ver_3 = %d, want 2", got)
+	}
+
+	production := featureFlagRevisionsFromClient(versions, "production")
+	if got, want := ids(production), []string{"ver_4", "ver_1"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("production revisions = %v, want %v", got, want)
+	}
+}
@@ -34,14 +34,16 @@ type featureFlagDefinitionResource struct {
 }
 
 type featureFlagDefinitionModel struct {
-	ID          types.String              `tfsdk:"id"`
-	ProjectID   types.String              `tfsdk:"project_id"`
-	TeamID      types.String              `tfsdk:"team_id"`
-	Key         types.String              `tfsdk:"key"`
-	Description types.String              `tfsdk:"description"`
-	Kind        types.String              `tfsdk:"kind"`
-	Archived    types.Bool                `tfsdk:"archived"`
-	Variant     []featureFlagVariantModel `tfsdk:"variant"`
+	ID            types.String              `tfsdk:"id"`
+	ProjectID     types.String              `tfsdk:"project_id"`
+	TeamID        types.String              `tfsdk:"team_id"`
+	Key           types.String              `tfsdk:"key"`
+	Description   types.String              `tfsdk:"description"`
+	Kind          types.String              `tfsdk:"kind"`
+	Archived      types.Bool                `tfsdk:"archived"`
+	Revision      types.Int64               `tfsdk:"revision"`
+	ChangeMessage types.String              `tfsdk:"change_message"`
+	Variant       []featureFlagVariantModel `tfsdk:"variant"`
 }
 
 func (r *featureFlagDefinitionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -115,6 +117,8 @@ Use this resource by itself when you want Terraform to register the flag but lea
 Vercel requires environments when a flag is created, so this resource bootstraps all environments in a paused state using the neutral control/off variant until rollout is managed elsewhere.
 
 If Terraform should also manage the simplified per-environment rollout, pair this resource with ` + "`vercel_feature_flag_config`" + `.
+
+Every change to a flag creates a new revision. Set ` + "`change_message`" + ` to record why Terraform made the change, and use the ` + "`vercel_feature_flag_revisions`" + ` data source to read the history.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
@@ -162,7 +166,12 @@ If Terraform should also manage the simplified per-environment rollout, pair thi
 				Default:     booldefault.StaticBool(false),
 				Description: "Whether the flag should be archived instead of active.",
 			},
-			"variant": featureFlagDefinitionVariantSchema(),
+			"revision": schema.Int64Attribute{
+				Computed:    true,
+				Description: "The current revision of the flag. Vercel increments it on every change, including changes made outside Terraform.",
+			},
+			"change_message": featureFlagChangeMessageSchema(),
+			"variant":        featureFlagDefinitionVariantSchema(),
 		},
 	}
 }
@@ -176,6 +185,12 @@ func (r *featureFlagDefinitionResource) Create(ctx context.Context, req resource
 	}
 	plan.TeamID = types.StringValue(r.client.TeamID(plan.TeamID.ValueString()))
 
+	message, diags := featureFlagChangeMessage(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq, diags := featureFlagDefinitionCreateRequest(plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -200,6 +215,7 @@ func (r *featureFlagDefinitionResource) Create(ctx context.Context, req resource
 			TeamID:    plan.TeamID.ValueString(),
 			FlagID:    out.ID,
 			State:     "archived",
+			Message:   message,
 		})
 		if err != nil {
 			resp.Diagnostics.AddError(
@@ -283,6 +299,11 @@ func (r *featureFlagDefinitionResource) Update(ctx context.Context, req resource
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	updateReq.Message, diags = featureFlagChangeMessage(ctx, req.Config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	out, err := r.client.UpdateFeatureFlag(ctx, updateReq)
 	if client.NotFound(err) {
@@ -461,13 +482,15 @@ func featureFlagDefinitionFromClient(out client.FeatureFlag, ref featureFlagDefi
 	var diags diag.Diagnostics
 
 	model := featureFlagDefinitionModel{
-		ID:          types.StringValue(out.ID),
-		ProjectID:   types.StringValue(out.ProjectID),
-		TeamID:      ref.TeamID,
-		Key:         types.StringValue(out.Slug),
-		Description: featureFlagOptionalStringValue(out.Description, ref.Description),
-		Kind:        types.StringValue(out.Kind),
-		Archived:    types.BoolValue(out.State == "archived"),
+		ID:            types.StringValue(out.ID),
+		ProjectID:     types.StringValue(out.ProjectID),
+		TeamID:        ref.TeamID,
+		Key:           types.StringValue(out.Slug),
+		Description:   featureFlagOptionalStringValue(out.Description, ref.Description),
+		Kind:          types.StringValue(out.Kind),
+		Archived:      types.BoolValue(out.State == "archived"),
+		Revision:      types.Int64Value(int64(out.Revision)),
+		ChangeMessage: types.StringNull(),
 	}
 
 	priorVariants := map[string]featureFlagVariantModel{}
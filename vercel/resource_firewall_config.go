@@ -4,8 +4,13 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"slices"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -24,9 +29,11 @@ import (
 
 // Ensure the implementation satisfies the expected interfaces.
 var (
-	_ resource.Resource                = &firewallConfigResource{}
-	_ resource.ResourceWithConfigure   = &firewallConfigResource{}
-	_ resource.ResourceWithImportState = &firewallConfigResource{}
+	_ resource.Resource                   = &firewallConfigResource{}
+	_ resource.ResourceWithConfigure      = &firewallConfigResource{}
+	_ resource.ResourceWithImportState    = &firewallConfigResource{}
+	_ resource.ResourceWithModifyPlan     = &firewallConfigResource{}
+	_ resource.ResourceWithValidateConfig = &firewallConfigResource{}
 )
 
 func newFirewallConfigResource() resource.Resource { return &firewallConfigResource{} }
@@ -42,7 +49,10 @@ func (r *firewallConfigResource) Metadata(ctx context.Context, req resource.Meta
 func (r *firewallConfigResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		Description: `
-Define Custom Rules to shape the way your traffic is handled by the Vercel Edge Network.`,
+Define Custom Rules to shape the way your traffic is handled by the Vercel Edge Network.
+
+~> With a ` + "`rules`" + ` block, this resource owns every custom rule on the project and removes rules it does not declare. The same applies to IP rules and the ` + "`ip_rules`" + ` block. Leave a block out to manage those rules with ` + "`vercel_firewall_rule`" + ` or ` + "`vercel_firewall_ip_rule`" + ` instead. This resource then changes only the settings it declares. Destroying it deactivates its managed rulesets and removes its own rules, but leaves the firewall enabled. To share the same rules across many projects, use ` + "`vercel_firewall_ruleset`" + `.
+`,
 		Blocks: map[string]schema.Block{
 			"managed_rulesets": schema.SingleNestedBlock{
 				Description: "The managed rulesets that are enabled.",
@@ -182,6 +192,55 @@ Define Custom Rules to shape the way your traffic is handled by the Vercel Edge
 									},
 								},
 							},
+							"paranoia_level": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The paranoia level of the core rule set, from 1 to 4. Higher levels enable more rules, which catch more attacks at the cost of more false positives. Defaults to 1.",
+								Validators: []validator.Int64{
+									int64validator.Between(1, 4),
+								},
+							},
+							"inbound_anomaly_threshold": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The anomaly score at which a request is blocked. Lower values are stricter. Defaults to 5.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"outbound_anomaly_threshold": schema.Int64Attribute{
+								Optional:    true,
+								Description: "The anomaly score at which a response is blocked. Lower values are stricter. Defaults to 4.",
+								Validators: []validator.Int64{
+									int64validator.AtLeast(1),
+								},
+							},
+							"rule_overrides": schema.MapNestedAttribute{
+								Optional:    true,
+								Description: "Overrides for individual core rule set rules, keyed by rule ID, such as `942100`. Use this to turn off or log a single rule that causes false positives without changing the rest of its group.",
+								Validators: []validator.Map{
+									mapvalidator.SizeAtLeast(1),
+								},
+								NestedObject: schema.NestedAttributeObject{
+									Validators: []validator.Object{
+										objectvalidator.AtLeastOneOf(
+											path.MatchRelative().AtName("active"),
+											path.MatchRelative().AtName("action"),
+										),
+									},
+									Attributes: map[string]schema.Attribute{
+										"active": schema.BoolAttribute{
+											Optional:    true,
+											Description: "Whether the rule is active. Set to `false` to turn the rule off.",
+										},
+										"action": schema.StringAttribute{
+											Optional:    true,
+											Description: "The action to take when the rule matches, instead of the action of its group. Must be `deny` or `log`.",
+											Validators: []validator.String{
+												stringvalidator.OneOf("deny", "log"),
+											},
+										},
+									},
+								},
+							},
 						},
 					},
 					"bot_protection": schema.SingleNestedBlock{
@@ -227,7 +286,7 @@ Define Custom Rules to shape the way your traffic is handled by the Vercel Edge
 				},
 			},
 			"rules": schema.SingleNestedBlock{
-				Description: "Custom rules to apply to the project",
+				Description: "Custom rules to apply to the project. When set, any other custom rules on the project are removed. Leave it out to manage custom rules with `vercel_firewall_rule`.",
 				Blocks: map[string]schema.Block{
 					"rule": schema.ListNestedBlock{
 						Validators: []validator.List{
@@ -255,170 +314,18 @@ Define Custom Rules to shape the way your traffic is handled by the Vercel Edge
 									Description: "Rule is active or disabled",
 									Optional:    true,
 								},
-								"action": schema.SingleNestedAttribute{
-									Description: "Actions to take when the condition groups match a request",
-									Required:    true,
-									Attributes: map[string]schema.Attribute{
-										"action": schema.StringAttribute{
-											Description: "Base action",
-											Required:    true,
-											Validators: []validator.String{
-												stringvalidator.OneOf("bypass", "log", "challenge", "deny", "rate_limit", "redirect"),
-											},
-										},
-										"rate_limit": schema.SingleNestedAttribute{
-											Description: "Behavior or a rate limiting action. Required if action is rate_limit",
-											Optional:    true,
-											Attributes: map[string]schema.Attribute{
-												"algo": schema.StringAttribute{
-													Description: "Rate limiting algorithm",
-													Required:    true,
-												},
-												"window": schema.Int64Attribute{
-													Description: "Time window in seconds",
-													Required:    true,
-												},
-												"limit": schema.Int64Attribute{
-													Description: "number of requests allowed in the window",
-													Required:    true,
-												},
-												"keys": schema.ListAttribute{
-													Description: "Keys used to bucket an individual client",
-													Required:    true,
-													ElementType: types.StringType,
-												},
-												"action": schema.StringAttribute{
-													Description: "Action to take when rate limit is exceeded",
-													Required:    true,
-													Validators: []validator.String{
-														stringvalidator.OneOf("bypass", "log", "challenge", "deny", "rate_limit"),
-													},
-												},
-											},
-										},
-										"redirect": schema.SingleNestedAttribute{
-											Description: "How to redirect a request. Required if action is redirect",
-											Optional:    true,
-											Attributes: map[string]schema.Attribute{
-												"location": schema.StringAttribute{
-													Required: true,
-												},
-												"permanent": schema.BoolAttribute{
-													Required: true,
-												},
-											},
-										},
-										"action_duration": schema.StringAttribute{
-											Description: "Forward persistence of a rule action",
-											Optional:    true,
-										},
-									},
-								},
-								"condition_group": schema.ListNestedAttribute{
-									Description: "Sets of conditions that may match a request",
-									Required:    true,
-									NestedObject: schema.NestedAttributeObject{
-										Attributes: map[string]schema.Attribute{
-											"conditions": schema.ListNestedAttribute{
-												Description: "Conditions that must all match within a group",
-												Required:    true,
-												NestedObject: schema.NestedAttributeObject{
-													Attributes: map[string]schema.Attribute{
-														"type": schema.StringAttribute{
-															Description: "Request key type to match against",
-															Required:    true,
-															Validators: []validator.String{
-																stringvalidator.OneOf(
-																	"host",
-																	"path",
-																	"method",
-																	"header",
-																	"query",
-																	"cookie",
-																	"target_path",
-																	"ip_address",
-																	"region",
-																	"protocol",
-																	"scheme",
-																	"environment",
-																	"user_agent",
-																	"geo_continent",
-																	"geo_country",
-																	"geo_country_region",
-																	"geo_city",
-																	"geo_as_number",
-																	"ja4_digest",
-																	"ja3_digest",
-																	"rate_limit_api_id",
-																),
-															},
-														},
-														"op": schema.StringAttribute{
-															Description: "Operator to use for comparison. Options: `re` (regex), `eq` (equals), `neq` (not equals), `ex` (exists), `nex` (not exists), `inc` (includes), `ninc` (not includes), `pre` (prefix), `suf` (suffix), `sub` (substring), `gt` (greater than), `gte` (greater than or equal), `lt` (less than), `lte` (less than or equal). Note: `ex` and `nex` don't require a `value` field, only `key`.",
-															Required:    true,
-															Validators: []validator.String{
-																stringvalidator.OneOf(
-																	"re",
-																	"eq",
-																	"neq",
-																	"ex",
-																	"nex",
-																	"inc",
-																	"ninc",
-																	"pre",
-																	"suf",
-																	"sub",
-																	"gt",
-																	"gte",
-																	"lt",
-																	"lte",
-																),
-															},
-														},
-														"neg": schema.BoolAttribute{
-															Description: "Negate the condition. Defaults to false.",
-															Optional:    true,
-															Computed:    true,
-															Default:     booldefault.StaticBool(false),
-														},
-														"key": schema.StringAttribute{
-															Description: "Key within type to match against",
-															Optional:    true,
-														},
-														"value": schema.StringAttribute{
-															Validators: []validator.String{
-																stringvalidator.ConflictsWith(
-																	path.MatchRelative().AtParent().AtName("values"),
-																	path.MatchRelative().AtParent().AtName("value"),
-																),
-															},
-															Description: "Value to match against. Not required for existence operators (`ex`, `nex`). Use `values` instead for `inc` and `ninc` operators.",
-															Optional:    true,
-														},
-														"values": schema.ListAttribute{
-															Validators: []validator.List{
-																listvalidator.ConflictsWith(
-																	path.MatchRelative().AtParent().AtName("value"),
-																	path.MatchRelative().AtParent().AtName("values"),
-																),
-															},
-															ElementType: types.StringType,
-															Description: "Values to match against if op is inc, ninc",
-															Optional:    true,
-														},
-													},
-												},
-											},
-										},
-									},
-								},
+								"action":           firewallRuleActionAttribute(),
+								"condition_group":  firewallRuleConditionGroupAttribute(),
+								"staged_rollout":   firewallRuleStagedRolloutAttribute(),
+								"effective_action": firewallRuleEffectiveActionAttribute(),
+								"staged_at":        firewallRuleStagedAtAttribute(),
 							},
 						},
 					},
 				},
 			},
 			"ip_rules": schema.SingleNestedBlock{
-				Description: "IP rules to apply to the project.",
+				Description: "IP rules to apply to the project. When set, any other IP rules on the project are removed. Leave it out to manage IP rules with `vercel_firewall_ip_rule`.",
 				Blocks: map[string]schema.Block{
 					"rule": schema.ListNestedBlock{
 						NestedObject: schema.NestedBlockObject{
@@ -475,6 +382,169 @@ Define Custom Rules to shape the way your traffic is handled by the Vercel Edge
 	}
 }
 
+func firewallRuleActionAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Actions to take when the condition groups match a request",
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"action": schema.StringAttribute{
+				Description: "Base action",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("bypass", "log", "challenge", "deny", "rate_limit", "redirect"),
+				},
+			},
+			"rate_limit": schema.SingleNestedAttribute{
+				Description: "Behavior or a rate limiting action. Required if action is rate_limit",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"algo": schema.StringAttribute{
+						Description: "Rate limiting algorithm. Must be `fixed_window` or `token_bucket`.",
+						Required:    true,
+					},
+					"window": schema.Int64Attribute{
+						Description: "Time window in seconds, between 10 and 3600.",
+						Required:    true,
+					},
+					"limit": schema.Int64Attribute{
+						Description: "number of requests allowed in the window, at least 1.",
+						Required:    true,
+					},
+					"keys": schema.ListAttribute{
+						Description: "Keys used to bucket an individual client",
+						Required:    true,
+						ElementType: types.StringType,
+					},
+					"action": schema.StringAttribute{
+						Description: "Action to take when rate limit is exceeded",
+						Required:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("bypass", "log", "challenge", "deny", "rate_limit"),
+						},
+					},
+				},
+			},
+			"redirect": schema.SingleNestedAttribute{
+				Description: "How to redirect a request. Required if action is redirect",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"location": schema.StringAttribute{
+						Required: true,
+					},
+					"permanent": schema.BoolAttribute{
+						Required: true,
+					},
+				},
+			},
+			"action_duration": schema.StringAttribute{
+				Description: "Forward persistence of a rule action",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func firewallRuleConditionGroupAttribute() schema.ListNestedAttribute {
+	return schema.ListNestedAttribute{
+		Description: "Sets of conditions that may match a request",
+		Required:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"conditions": schema.ListNestedAttribute{
+					Description: "Conditions that must all match within a group",
+					Required:    true,
+					NestedObject: schema.NestedAttributeObject{
+						Attributes: map[string]schema.Attribute{
+							"type": schema.StringAttribute{
+								Description: "Request key type to match against",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(
+										"host",
+										"path",
+										"method",
+										"header",
+										"query",
+										"cookie",
+										"target_path",
+										"ip_address",
+										"region",
+										"protocol",
+										"scheme",
+										"environment",
+										"user_agent",
+										"geo_continent",
+										"geo_country",
+										"geo_country_region",
+										"geo_city",
+										"geo_as_number",
+										"ja4_digest",
+										"ja3_digest",
+										"rate_limit_api_id",
+									),
+								},
+							},
+							"op": schema.StringAttribute{
+								Description: "Operator to use for comparison. Options: `re` (regex), `eq` (equals), `neq` (not equals), `ex` (exists), `nex` (not exists), `inc` (includes), `ninc` (not includes), `pre` (prefix), `suf` (suffix), `sub` (substring), `gt` (greater than), `gte` (greater than or equal), `lt` (less than), `lte` (less than or equal). Note: `ex` and `nex` don't require a `value` field, only `key`.",
+								Required:    true,
+								Validators: []validator.String{
+									stringvalidator.OneOf(
+										"re",
+										"eq",
+										"neq",
+										"ex",
+										"nex",
+										"inc",
+										"ninc",
+										"pre",
+										"suf",
+										"sub",
+										"gt",
+										"gte",
+										"lt",
+										"lte",
+									),
+								},
+							},
+							"neg": schema.BoolAttribute{
+								Description: "Negate the condition. Defaults to false.",
+								Optional:    true,
+								Computed:    true,
+								Default:     booldefault.StaticBool(false),
+							},
+							"key": schema.StringAttribute{
+								Description: "Key within type to match against",
+								Optional:    true,
+							},
+							"value": schema.StringAttribute{
+								Validators: []validator.String{
+									stringvalidator.ConflictsWith(
+										path.MatchRelative().AtParent().AtName("values"),
+										path.MatchRelative().AtParent().AtName("value"),
+									),
+								},
+								Description: "Value to match against. Not required for existence operators (`ex`, `nex`). Use `values` instead for `inc` and `ninc` operators.",
+								Optional:    true,
+							},
+							"values": schema.ListAttribute{
+								Validators: []validator.List{
+									listvalidator.ConflictsWith(
+										path.MatchRelative().AtParent().AtName("value"),
+										path.MatchRelative().AtParent().AtName("values"),
+									),
+								},
+								ElementType: types.StringType,
+								Description: "Values to match against if op is inc, ninc",
+								Optional:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
 func (r *firewallConfigResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
@@ -523,6 +593,11 @@ type CRSRule struct {
 	PHP  *CRSRuleConfig `tfsdk:"php"`
 	GEN  *CRSRuleConfig `tfsdk:"gen"`
 	JAVA *CRSRuleConfig `tfsdk:"java"`
+
+	ParanoiaLevel            types.Int64                `tfsdk:"paranoia_level"`
+	InboundAnomalyThreshold  types.Int64                `tfsdk:"inbound_anomaly_threshold"`
+	OutboundAnomalyThreshold types.Int64                `tfsdk:"outbound_anomaly_threshold"`
+	RuleOverrides            map[string]CRSRuleOverride `tfsdk:"rule_overrides"`
 }
 
 func (r *CRSRule) ToMap() map[string]*CRSRuleConfig {
@@ -566,12 +641,15 @@ type FirewallRules struct {
 }
 
 type FirewallRule struct {
-	ID             types.String     `tfsdk:"id"`
-	Name           types.String     `tfsdk:"name"`
-	Description    types.String     `tfsdk:"description"`
-	Active         types.Bool       `tfsdk:"active"`
-	ConditionGroup []ConditionGroup `tfsdk:"condition_group"`
-	Action         Mitigate         `tfsdk:"action"`
+	ID              types.String     `tfsdk:"id"`
+	Name            types.String     `tfsdk:"name"`
+	Description     types.String     `tfsdk:"description"`
+	Active          types.Bool       `tfsdk:"active"`
+	ConditionGroup  []ConditionGroup `tfsdk:"condition_group"`
+	Action          Mitigate         `tfsdk:"action"`
+	StagedRollout   types.Object     `tfsdk:"staged_rollout"`
+	EffectiveAction types.String     `tfsdk:"effective_action"`
+	StagedAt        types.String     `tfsdk:"staged_at"`
 }
 
 func isListOp(op string) bool {
@@ -624,6 +702,9 @@ func (r *FirewallRule) Conditions() ([]client.ConditionGroup, error) {
 }
 
 func (r *FirewallRule) Mitigate() (client.Mitigate, error) {
+	if r.stagedAsLog() {
+		return client.Mitigate{Action: "log"}, nil
+	}
 	mit := client.Mitigate{
 		Action: r.Action.Action.ValueString(),
 	}
@@ -668,9 +749,21 @@ func fromFirewallRule(rule client.FirewallRule, ref FirewallRule) (FirewallRule,
 		r.Active = ref.Active
 	}
 
-	r.Action, err = fromMitigate(rule.Action.Mitigate, ref.Action)
-	if err != nil {
-		return r, err
+	r.StagedRollout = ref.StagedRollout
+	if ref.StagedRollout.IsNull() {
+		r.StagedRollout = types.ObjectNull(firewallRuleStagedRolloutAttrTypes)
+	}
+	r.StagedAt = ref.StagedAt
+	r.EffectiveAction = types.StringValue(rule.Action.Mitigate.Action)
+	// A staged rule is deployed as log until it is promoted, so keep the
+	// intended action rather than reporting drift.
+	if firewallRuleStagedAsLog(rule.Action.Mitigate.Action, ref) {
+		r.Action = ref.Action
+	} else {
+		r.Action, err = fromMitigate(rule.Action.Mitigate, ref.Action)
+		if err != nil {
+			return r, err
+		}
 	}
 	var conditionGroups = make([]ConditionGroup, len(rule.ConditionGroup))
 	for j, group := range rule.ConditionGroup {
@@ -903,7 +996,10 @@ func fromClient(conf client.FirewallConfig, state FirewallConfig) (FirewallConfi
 		cfg.Enabled = state.Enabled
 	}
 
-	if len(conf.Rules) > 0 {
+	// Without a rules or ip_rules block the config does not manage that list,
+	// so rules created by vercel_firewall_rule and vercel_firewall_ip_rule are
+	// not read into it.
+	if state.Rules != nil {
 		rules := make([]FirewallRule, len(conf.Rules))
 		for i, rule := range conf.Rules {
 			// Set empty optional types
@@ -921,7 +1017,7 @@ func fromClient(conf client.FirewallConfig, state FirewallConfig) (FirewallConfi
 		cfg.Rules = &FirewallRules{Rules: rules}
 	}
 
-	if len(conf.IPRules) > 0 {
+	if state.IPRules != nil {
 		ipRules := make([]IPRule, len(conf.IPRules))
 		for i, iprule := range conf.IPRules {
 			ipRules[i] = IPRule{
@@ -945,6 +1041,9 @@ func fromClient(conf client.FirewallConfig, state FirewallConfig) (FirewallConfi
 		cfg.ManagedRulesets = managedRulesets
 		if conf.CRS != nil && state.ManagedRulesets != nil {
 			cfg.ManagedRulesets.OWASP = fromCRS(conf.CRS, state.ManagedRulesets)
+			if cfg.ManagedRulesets.OWASP != nil {
+				cfg.ManagedRulesets.OWASP.setOWASPSettings(conf.ManagedRulesets["owasp"], state.ManagedRulesets.OWASP)
+			}
 		}
 
 		if state.ManagedRulesets != nil && state.ManagedRulesets.BotProtection != nil {
@@ -988,9 +1087,7 @@ func (f *FirewallConfig) toClient() (client.FirewallConfig, error) {
 	if f.ManagedRulesets != nil {
 		conf.ManagedRulesets = make(map[string]client.ManagedRule)
 		if f.ManagedRulesets.OWASP != nil {
-			conf.ManagedRulesets["owasp"] = client.ManagedRule{
-				Active: true,
-			}
+			conf.ManagedRulesets["owasp"] = f.ManagedRulesets.OWASP.toClient()
 			conf.CRS = make(map[string]client.CoreRuleSet)
 			for key, value := range f.ManagedRulesets.OWASP.ToMap() {
 				if value != nil {
@@ -1526,6 +1623,220 @@ func (r *firewallConfigResource) updateFirewallRules(ctx context.Context, state,
 	return r.client.GetFirewallConfig(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
 }
 
+// firewallConfigManagesAllRules reports whether c owns every custom rule and
+// IP rule on the project. A config without a rules or ip_rules block leaves
+// that list to vercel_firewall_rule or vercel_firewall_ip_rule, so it must not
+// be applied by replacing the whole config.
+func firewallConfigManagesAllRules(c FirewallConfig) bool {
+	return c.Rules != nil && c.IPRules != nil
+}
+
+// patchFirewallConfig applies plan one setting at a time. The custom rules and
+// IP rules are only changed when plan manages them, and enabled is left as it
+// is when it is null. ref is the state the live config is read against.
+func (r *firewallConfigResource) patchFirewallConfig(ctx context.Context, ref, plan FirewallConfig) (client.FirewallConfig, error) {
+	projectID, teamID := ref.ProjectID.ValueString(), ref.TeamID.ValueString()
+	patch := func(action string, id, value any) error {
+		return r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+			ProjectID: projectID,
+			TeamID:    teamID,
+			Action:    action,
+			ID:        id,
+			Value:     value,
+		})
+	}
+
+	live, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil && !client.NotFound(err) {
+		return client.FirewallConfig{}, err
+	}
+	if ref.Rules == nil {
+		ref.Rules = &FirewallRules{}
+	}
+	if ref.IPRules == nil {
+		ref.IPRules = &IPRules{}
+	}
+	state, err := fromClient(live, ref)
+	if err != nil {
+		return client.FirewallConfig{}, err
+	}
+	state.TeamID = ref.TeamID
+	current, err := state.toClient()
+	if err != nil {
+		return client.FirewallConfig{}, err
+	}
+	desired, err := plan.toClient()
+	if err != nil {
+		return client.FirewallConfig{}, err
+	}
+
+	if !plan.Enabled.IsNull() && desired.Enabled != live.Enabled {
+		if err := patch("firewallEnabled", nil, desired.Enabled); err != nil {
+			return client.FirewallConfig{}, err
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(desired.ManagedRulesets)) {
+		rule := desired.ManagedRulesets[name]
+		if existing, ok := current.ManagedRulesets[name]; ok {
+			same, err := firewallValuesEqual(existing, rule)
+			if err != nil {
+				return client.FirewallConfig{}, err
+			}
+			if same {
+				continue
+			}
+		}
+		if err := patch("managedRules.update", name, rule); err != nil {
+			return client.FirewallConfig{}, err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(current.ManagedRulesets)) {
+		rule := current.ManagedRulesets[name]
+		if _, ok := desired.ManagedRulesets[name]; ok || !rule.Active {
+			continue
+		}
+		if err := patch("managedRules.update", name, client.ManagedRule{Action: rule.Action}); err != nil {
+			return client.FirewallConfig{}, err
+		}
+	}
+
+	for _, name := range slices.Sorted(maps.Keys(desired.CRS)) {
+		if existing, ok := current.CRS[name]; ok && existing == desired.CRS[name] {
+			continue
+		}
+		if err := patch("crs.update", name, desired.CRS[name]); err != nil {
+			return client.FirewallConfig{}, err
+		}
+	}
+	for _, name := range slices.Sorted(maps.Keys(current.CRS)) {
+		rule := current.CRS[name]
+		if _, ok := desired.CRS[name]; ok || !rule.Active {
+			continue
+		}
+		if err := patch("crs.update", name, client.CoreRuleSet{Action: rule.Action}); err != nil {
+			return client.FirewallConfig{}, err
+		}
+	}
+
+	if plan.Rules != nil {
+		if _, err := r.updateFirewallRules(ctx, state, plan); err != nil {
+			return client.FirewallConfig{}, err
+		}
+	}
+
+	if plan.IPRules != nil {
+		removals, inserts := diffFirewallIPRules(current.IPRules, desired.IPRules)
+		for _, rule := range removals {
+			if err := patch("ip.remove", rule.ID, nil); err != nil {
+				return client.FirewallConfig{}, err
+			}
+		}
+		for _, rule := range inserts {
+			rule.ID = ""
+			if err := patch("ip.insert", nil, rule); err != nil {
+				return client.FirewallConfig{}, err
+			}
+		}
+	}
+
+	return r.client.GetFirewallConfig(ctx, projectID, teamID)
+}
+
+func firewallValuesEqual(a, b any) (bool, error) {
+	aFingerprint, err := firewallValueFingerprint(a)
+	if err != nil {
+		return false, err
+	}
+	bFingerprint, err := firewallValueFingerprint(b)
+	if err != nil {
+		return false, err
+	}
+	return aFingerprint == bFingerprint, nil
+}
+
+// diffFirewallIPRules returns the IP rules to remove from current and insert
+// to reach desired. New IP rules are added to the end of the list, so every
+// rule after the first difference is replaced to keep the configured order.
+func diffFirewallIPRules(current, desired []client.IPRule) (removals, inserts []client.IPRule) {
+	normalizedCurrent := normalizeFirewallIPRules(current)
+	normalizedDesired := normalizeFirewallIPRules(desired)
+
+	same := 0
+	for same < len(normalizedCurrent) && same < len(normalizedDesired) && normalizedCurrent[same] == normalizedDesired[same] {
+		same++
+	}
+
+	return current[same:], desired[same:]
+}
+
+// ValidateConfig checks the rules for mistakes the API would otherwise only
+// report when the config is applied.
+func (r *firewallConfigResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config FirewallConfig
+	// Values that are not known yet cannot be read into the model; they are
+	// checked again once they are known.
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
+
+	if config.Rules != nil {
+		for i, rule := range config.Rules.Rules {
+			rulePath := path.Root("rules").AtName("rule").AtListIndex(i)
+			resp.Diagnostics.Append(validateFirewallRule(ctx, rulePath, rule)...)
+		}
+	}
+	if config.IPRules != nil {
+		for i, rule := range config.IPRules.Rules {
+			rulePath := path.Root("ip_rules").AtName("rule").AtListIndex(i)
+			resp.Diagnostics.Append(validateFirewallIPString(rulePath.AtName("ip"), rule.IP)...)
+		}
+	}
+	if config.ManagedRulesets != nil && config.ManagedRulesets.OWASP != nil {
+		owaspPath := path.Root("managed_rulesets").AtName("owasp")
+		resp.Diagnostics.Append(validateCRSRuleOverrides(owaspPath, config.ManagedRulesets.OWASP)...)
+	}
+}
+
+// ModifyPlan works out the action each staged rule will be deployed with, so
+// that a pending promotion shows up in the plan.
+func (r *firewallConfigResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan FirewallConfig
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() || plan.Rules == nil {
+		return
+	}
+
+	prior := map[string]*FirewallRule{}
+	if !req.State.Raw.IsNull() {
+		var state FirewallConfig
+		diags := req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if state.Rules != nil {
+			for i := range state.Rules.Rules {
+				prior[state.Rules.Rules[i].Name.ValueString()] = &state.Rules.Rules[i]
+			}
+		}
+	}
+
+	now := time.Now()
+	for i := range plan.Rules.Rules {
+		rule := &plan.Rules.Rules[i]
+		var priorRule *FirewallRule
+		if !rule.Name.IsUnknown() {
+			priorRule = prior[rule.Name.ValueString()]
+		}
+		rulePath := path.Root("rules").AtName("rule").AtListIndex(i)
+		resp.Diagnostics.Append(setFirewallRuleRolloutPlan(ctx, &resp.Plan, rulePath, rule, priorRule, now)...)
+	}
+}
+
 func (r *firewallConfigResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan FirewallConfig
 	diags := req.Plan.Get(ctx, &plan)
@@ -1540,7 +1851,20 @@ func (r *firewallConfigResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
-	out, err := r.client.PutFirewallConfig(ctx, conf)
+	teamID := r.client.TeamID(plan.TeamID.ValueString())
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, plan.ProjectID.ValueString()))
+	defer unlock()
+
+	var out client.FirewallConfig
+	if firewallConfigManagesAllRules(plan) {
+		out, err = r.client.PutFirewallConfig(ctx, conf)
+	} else {
+		out, err = r.patchFirewallConfig(ctx, FirewallConfig{
+			ProjectID:       plan.ProjectID,
+			TeamID:          types.StringValue(teamID),
+			ManagedRulesets: plan.ManagedRulesets,
+		}, plan)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("failed to create firewall config", err.Error())
 		return
@@ -1596,44 +1920,30 @@ func (r *firewallConfigResource) Update(ctx context.Context, req resource.Update
 		return
 	}
 
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(r.client.TeamID(state.TeamID.ValueString()), state.ProjectID.ValueString()))
+	defer unlock()
+
 	canPatchRules, err := onlyFirewallRulesChanged(state, plan)
 	if err != nil {
-		diags.AddError("failed to compare firewall config updates", err.Error())
-		resp.Diagnostics.Append(diags...)
+		resp.Diagnostics.AddError("failed to compare firewall config updates", err.Error())
 		return
 	}
 
 	var out client.FirewallConfig
-	if canPatchRules {
+	switch {
+	case !firewallConfigManagesAllRules(plan):
+		out, err = r.patchFirewallConfig(ctx, state, plan)
+	case canPatchRules:
 		out, err = r.updateFirewallRules(ctx, state, plan)
+	default:
+		var conf client.FirewallConfig
+		conf, err = plan.toClient()
 		if err != nil {
-			diags.AddError("failed to update firewall config", err.Error())
-		}
-
-		resp.Diagnostics.Append(diags...)
-		if resp.Diagnostics.HasError() {
+			resp.Diagnostics.AddError("failed to convert plan to client", err.Error())
 			return
 		}
-
-		cfg, err := fromClient(out, plan)
-		if err != nil {
-			diags.AddError("failed to read updated firewall config", err.Error())
-			resp.Diagnostics.Append(diags...)
-			return
-		}
-
-		diags = resp.State.Set(ctx, cfg)
-		resp.Diagnostics.Append(diags...)
-		return
+		out, err = r.client.PutFirewallConfig(ctx, conf)
 	}
-
-	conf, err := plan.toClient()
-	if err != nil {
-		resp.Diagnostics.AddError("failed to convert plan to client", err.Error())
-		return
-	}
-
-	out, err = r.client.PutFirewallConfig(ctx, conf)
 	if err != nil {
 		resp.Diagnostics.AddError("failed to update firewall config", err.Error())
 		return
@@ -1647,6 +1957,7 @@ func (r *firewallConfigResource) Update(ctx context.Context, req resource.Update
 	diags = resp.State.Set(ctx, cfg)
 	resp.Diagnostics.Append(diags...)
 }
+
 func (r *firewallConfigResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 	var state FirewallConfig
 	diags := req.State.Get(ctx, &state)
@@ -1661,7 +1972,28 @@ func (r *firewallConfigResource) Delete(ctx context.Context, req resource.Delete
 		TeamID:    state.TeamID.ValueString(),
 	}
 
-	_, err := r.client.PutFirewallConfig(ctx, conf)
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(r.client.TeamID(state.TeamID.ValueString()), state.ProjectID.ValueString()))
+	defer unlock()
+
+	var err error
+	if firewallConfigManagesAllRules(state) {
+		_, err = r.client.PutFirewallConfig(ctx, conf)
+	} else {
+		// Only undo what this config manages. The firewall stays enabled, so
+		// rules owned by other resources keep applying.
+		empty := FirewallConfig{
+			ProjectID: state.ProjectID,
+			TeamID:    state.TeamID,
+			Enabled:   types.BoolNull(),
+		}
+		if state.Rules != nil {
+			empty.Rules = &FirewallRules{}
+		}
+		if state.IPRules != nil {
+			empty.IPRules = &IPRules{}
+		}
+		_, err = r.patchFirewallConfig(ctx, state, empty)
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("failed to delete firewall config", err.Error())
 		return
@@ -1672,6 +2004,27 @@ func (r *firewallConfigResource) Delete(ctx context.Context, req resource.Delete
 	})
 }
 
+// firewallConfigImportRef is the state an imported firewall config is read
+// against. An active OWASP ruleset, with its tuning and rule overrides, is
+// brought into state rather than dropped, and so are any custom rules and IP
+// rules.
+func firewallConfigImportRef(out client.FirewallConfig, projectID string) FirewallConfig {
+	ref := FirewallConfig{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(out.TeamID), // use output teamID if not provided on import
+	}
+	if owasp, ok := out.ManagedRulesets["owasp"]; ok && owasp.Active {
+		ref.ManagedRulesets = &FirewallManagedRulesets{OWASP: &CRSRule{}}
+	}
+	if len(out.Rules) > 0 {
+		ref.Rules = &FirewallRules{}
+	}
+	if len(out.IPRules) > 0 {
+		ref.IPRules = &IPRules{}
+	}
+	return ref
+}
+
 func (r *firewallConfigResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
 	teamID, projectID, ok := splitInto1Or2(req.ID)
 	if !ok {
@@ -1686,10 +2039,7 @@ func (r *firewallConfigResource) ImportState(ctx context.Context, req resource.I
 		resp.Diagnostics.AddError("Error importing Firewall Config", err.Error())
 		return
 	}
-	conf, err := fromClient(out, FirewallConfig{
-		ProjectID: types.StringValue(projectID),
-		TeamID:    types.StringValue(out.TeamID), // use output teamID if not provided on import
-	})
+	conf, err := fromClient(out, firewallConfigImportRef(out, projectID))
 	if err != nil {
 		resp.Diagnostics.AddError("failed to read firewall config", err.Error())
 		return
@@ -947,3 +947,61 @@ resource "vercel_firewall_config" "existence" {
 }
 `, name)
 }
+
+func TestAcc_FirewallConfigOWASPOverrides(t *testing.T) {
+	name := acctest.RandString(16)
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallConfigOWASPOverrides(name, 2, "false")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_config.overrides", "managed_rulesets.owasp.paranoia_level", "2"),
+					resource.TestCheckResourceAttr("vercel_firewall_config.overrides", "managed_rulesets.owasp.inbound_anomaly_threshold", "7"),
+					resource.TestCheckNoResourceAttr("vercel_firewall_config.overrides", "managed_rulesets.owasp.outbound_anomaly_threshold"),
+					resource.TestCheckResourceAttr("vercel_firewall_config.overrides", "managed_rulesets.owasp.rule_overrides.942100.active", "false"),
+					resource.TestCheckResourceAttr("vercel_firewall_config.overrides", "managed_rulesets.owasp.rule_overrides.942200.action", "log"),
+				),
+			},
+			{
+				ImportState:       true,
+				ImportStateVerify: true,
+				ResourceName:      "vercel_firewall_config.overrides",
+				ImportStateIdFunc: getFirewallImportID("vercel_firewall_config.overrides"),
+			},
+			{
+				Config: cfg(testAccFirewallConfigOWASPOverrides(name, 3, "true")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_config.overrides", "managed_rulesets.owasp.paranoia_level", "3"),
+					resource.TestCheckResourceAttr("vercel_firewall_config.overrides", "managed_rulesets.owasp.rule_overrides.942100.active", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallConfigOWASPOverrides(name string, paranoiaLevel int, active string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "overrides" {
+    name = "test-acc-%[1]s-overrides"
+}
+
+resource "vercel_firewall_config" "overrides" {
+    project_id = vercel_project.overrides.id
+
+    managed_rulesets {
+        owasp {
+            sqli = { action = "deny" }
+
+            paranoia_level            = %[2]d
+            inbound_anomaly_threshold = 7
+
+            rule_overrides = {
+                "942100" = { active = %[3]s }
+                "942200" = { action = "log" }
+            }
+        }
+    }
+}
+`, name, paranoiaLevel, active)
+}
@@ -2,12 +2,20 @@ package vercel
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/defaults"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/vercel/terraform-provider-vercel/v5/client"
 )
@@ -418,3 +426,491 @@ func testResourceFirewallRule(id, name, path, action string) FirewallRule {
 		},
 	}
 }
+
+func firewallTestCondition(conditionType, op, key, value string, values ...string) Condition {
+	c := Condition{
+		Type:   types.StringValue(conditionType),
+		Op:     types.StringValue(op),
+		Neg:    types.BoolValue(false),
+		Key:    types.StringNull(),
+		Value:  types.StringNull(),
+		Values: types.ListNull(types.StringType),
+	}
+	if key != "" {
+		c.Key = types.StringValue(key)
+	}
+	if value != "" {
+		c.Value = types.StringValue(value)
+	}
+	if values != nil {
+		elements := make([]attr.Value, len(values))
+		for i, v := range values {
+			elements[i] = types.StringValue(v)
+		}
+		c.Values = types.ListValueMust(types.StringType, elements)
+	}
+	return c
+}
+
+func firewallTestRule(action Mitigate, conditions ...Condition) FirewallRule {
+	return FirewallRule{
+		ID:              types.StringNull(),
+		Name:            types.StringValue("test rule"),
+		Description:     types.StringNull(),
+		Active:          types.BoolNull(),
+		ConditionGroup:  []ConditionGroup{{Conditions: conditions}},
+		Action:          action,
+		StagedRollout:   types.ObjectNull(firewallRuleStagedRolloutAttrTypes),
+		EffectiveAction: types.StringNull(),
+		StagedAt:        types.StringNull(),
+	}
+}
+
+func firewallTestAction(action string) Mitigate {
+	return Mitigate{
+		Action:         types.StringValue(action),
+		RateLimit:      types.ObjectNull(ratelimitType.AttrTypes),
+		Redirect:       types.ObjectNull(redirectType.AttrTypes),
+		ActionDuration: types.StringNull(),
+	}
+}
+
+func firewallTestRateLimit(algo string, window, limit int64, keys ...string) Mitigate {
+	keyValues := make([]attr.Value, len(keys))
+	for i, k := range keys {
+		keyValues[i] = types.StringValue(k)
+	}
+	m := firewallTestAction("rate_limit")
+	m.RateLimit = types.ObjectValueMust(ratelimitType.AttrTypes, map[string]attr.Value{
+		"algo":   types.StringValue(algo),
+		"window": types.Int64Value(window),
+		"limit":  types.Int64Value(limit),
+		"keys":   types.ListValueMust(types.StringType, keyValues),
+		"action": types.StringValue("deny"),
+	})
+	return m
+}
+
+func firewallDiagnosticPaths(diags diag.Diagnostics) []string {
+	var paths []string
+	for _, d := range diags {
+		if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+			paths = append(paths, withPath.Path().String())
+		}
+	}
+	return paths
+}
+
+func TestValidateFirewallRule(t *testing.T) {
+	deny := firewallTestAction("deny")
+	conditionPath := "rules.rule[0].condition_group[0].conditions[0]"
+
+	tests := []struct {
+		name         string
+		rule         FirewallRule
+		wantPaths    []string
+		wantWarnings []string
+	}{
+		{
+			name: "valid path prefix",
+			rule: firewallTestRule(deny, firewallTestCondition("path", "pre", "", "/api")),
+		},
+		{
+			name: "valid regex",
+			rule: firewallTestRule(deny, firewallTestCondition("path", "re", "", `^/api/v[0-9]+/`)),
+		},
+		{
+			name:         "invalid regex",
+			rule:         firewallTestRule(deny, firewallTestCondition("path", "re", "", "^/api/(")),
+			wantWarnings: []string{conditionPath + ".value"},
+		},
+		{
+			name:         "regex lookahead RE2 does not support",
+			rule:         firewallTestRule(deny, firewallTestCondition("path", "re", "", "^/(?!api/)")),
+			wantWarnings: []string{conditionPath + ".value"},
+		},
+		{
+			name: "header existence with key",
+			rule: firewallTestRule(deny, firewallTestCondition("header", "ex", "Authorization", "")),
+		},
+		{
+			name:      "header without key",
+			rule:      firewallTestRule(deny, firewallTestCondition("header", "eq", "", "secret")),
+			wantPaths: []string{conditionPath + ".key"},
+		},
+		{
+			name:      "cookie without key",
+			rule:      firewallTestRule(deny, firewallTestCondition("cookie", "nex", "", "")),
+			wantPaths: []string{conditionPath + ".key"},
+		},
+		{
+			name:      "query without key",
+			rule:      firewallTestRule(deny, firewallTestCondition("query", "eq", "", "1")),
+			wantPaths: []string{conditionPath + ".key"},
+		},
+		{
+			name: "values with inc",
+			rule: firewallTestRule(deny, firewallTestCondition("user_agent", "inc", "", "", "badbot", "worsebot")),
+		},
+		{
+			name:      "values with eq",
+			rule:      firewallTestRule(deny, firewallTestCondition("user_agent", "eq", "", "", "badbot")),
+			wantPaths: []string{conditionPath + ".values"},
+		},
+		{
+			name:      "value with ninc",
+			rule:      firewallTestRule(deny, firewallTestCondition("user_agent", "ninc", "", "badbot")),
+			wantPaths: []string{conditionPath + ".values"},
+		},
+		{
+			name:      "missing value",
+			rule:      firewallTestRule(deny, firewallTestCondition("path", "suf", "", "")),
+			wantPaths: []string{conditionPath + ".value"},
+		},
+		{
+			name: "ip address and cidr",
+			rule: firewallTestRule(deny,
+				firewallTestCondition("ip_address", "eq", "", "2001:db8::1"),
+				firewallTestCondition("ip_address", "inc", "", "", "192.0.2.1", "198.51.100.0/24"),
+			),
+		},
+		{
+			name:      "invalid ip address",
+			rule:      firewallTestRule(deny, firewallTestCondition("ip_address", "neq", "", "192.0.2.300")),
+			wantPaths: []string{conditionPath + ".value"},
+		},
+		{
+			name:      "invalid cidr in list",
+			rule:      firewallTestRule(deny, firewallTestCondition("ip_address", "inc", "", "", "192.0.2.1", "198.51.100.0/33")),
+			wantPaths: []string{conditionPath + ".values[1]"},
+		},
+		{
+			name: "ip prefix is not checked",
+			rule: firewallTestRule(deny, firewallTestCondition("ip_address", "pre", "", "192.0.")),
+		},
+		{
+			name: "valid rate limit",
+			rule: firewallTestRule(firewallTestRateLimit("fixed_window", 60, 100, "ip"), firewallTestCondition("path", "pre", "", "/api")),
+		},
+		{
+			name: "rate limit out of bounds",
+			rule: firewallTestRule(firewallTestRateLimit("leaky_bucket", 5, 0), firewallTestCondition("path", "pre", "", "/api")),
+			wantPaths: []string{
+				"rules.rule[0].action.rate_limit.algo",
+				"rules.rule[0].action.rate_limit.window",
+				"rules.rule[0].action.rate_limit.limit",
+				"rules.rule[0].action.rate_limit.keys",
+			},
+		},
+		{
+			name:      "rate limit window too long",
+			rule:      firewallTestRule(firewallTestRateLimit("token_bucket", 7200, 100, "ip"), firewallTestCondition("path", "pre", "", "/api")),
+			wantPaths: []string{"rules.rule[0].action.rate_limit.window"},
+		},
+		{
+			name:      "rate_limit action without rate_limit",
+			rule:      firewallTestRule(firewallTestAction("rate_limit"), firewallTestCondition("path", "pre", "", "/api")),
+			wantPaths: []string{"rules.rule[0].action.rate_limit"},
+		},
+		{
+			name:      "redirect action without redirect",
+			rule:      firewallTestRule(firewallTestAction("redirect"), firewallTestCondition("path", "pre", "", "/old")),
+			wantPaths: []string{"rules.rule[0].action.redirect"},
+		},
+		{
+			name: "unknown values are skipped",
+			rule: firewallTestRule(deny, Condition{
+				Type:   types.StringValue("ip_address"),
+				Op:     types.StringValue("eq"),
+				Key:    types.StringNull(),
+				Value:  types.StringUnknown(),
+				Values: types.ListNull(types.StringType),
+			}),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rulePath := path.Root("rules").AtName("rule").AtListIndex(0)
+			diags := validateFirewallRule(context.Background(), rulePath, tt.rule)
+			if got := firewallDiagnosticPaths(diags.Errors()); fmt.Sprint(got) != fmt.Sprint(tt.wantPaths) {
+				t.Errorf("error paths = %v, want %v\n%v", got, tt.wantPaths, diags)
+			}
+			if got := firewallDiagnosticPaths(diags.Warnings()); fmt.Sprint(got) != fmt.Sprint(tt.wantWarnings) {
+				t.Errorf("warning paths = %v, want %v\n%v", got, tt.wantWarnings, diags)
+			}
+		})
+	}
+}
+
+func TestFirewallConfigValidateConfig(t *testing.T) {
+	ctx := context.Background()
+	res := &firewallConfigResource{}
+
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	config := FirewallConfig{
+		ID:        types.StringNull(),
+		ProjectID: types.StringValue("prj_123"),
+		TeamID:    types.StringNull(),
+		Enabled:   types.BoolValue(true),
+		Rules: &FirewallRules{Rules: []FirewallRule{
+			firewallTestRule(firewallTestAction("deny"), firewallTestCondition("path", "pre", "", "/api")),
+			firewallTestRule(firewallTestAction("deny"), firewallTestCondition("header", "re", "", "[")),
+		}},
+		IPRules: &IPRules{Rules: []IPRule{
+			{ID: types.StringNull(), Hostname: types.StringValue("example.com"), IP: types.StringValue("10.0.0.0/8"), Notes: types.StringNull(), Action: types.StringValue("deny")},
+			{ID: types.StringNull(), Hostname: types.StringValue("example.com"), IP: types.StringValue("not-an-ip"), Notes: types.StringNull(), Action: types.StringValue("deny")},
+		}},
+	}
+
+	raw := tfsdk.Plan{Schema: schemaResp.Schema}
+	diags := raw.Set(ctx, config)
+	if diags.HasError() {
+		t.Fatalf("raw.Set() returned diagnostics: %v", diags)
+	}
+
+	resp := &resource.ValidateConfigResponse{}
+	res.ValidateConfig(ctx, resource.ValidateConfigRequest{
+		Config: tfsdk.Config{Raw: raw.Raw, Schema: schemaResp.Schema},
+	}, resp)
+
+	want := []string{
+		"rules.rule[1].condition_group[0].conditions[0].key",
+		"rules.rule[1].condition_group[0].conditions[0].value",
+		"ip_rules.rule[1].ip",
+	}
+	if got := firewallDiagnosticPaths(resp.Diagnostics); fmt.Sprint(got) != fmt.Sprint(want) {
+		t.Errorf("diagnostic paths = %v, want %v\n%v", got, want, resp.Diagnostics)
+	}
+}
+
+func TestFirewallConfigOWASPSettingsRoundTrip(t *testing.T) {
+	cfg := FirewallConfig{
+		ProjectID: types.StringValue("prj_123"),
+		TeamID:    types.StringValue("team_123"),
+		Enabled:   types.BoolValue(true),
+		ManagedRulesets: &FirewallManagedRulesets{
+			OWASP: &CRSRule{
+				SQLI:                    &CRSRuleConfig{Active: types.BoolNull(), Action: types.StringValue("deny")},
+				ParanoiaLevel:           types.Int64Value(2),
+				InboundAnomalyThreshold: types.Int64Value(10),
+				RuleOverrides: map[string]CRSRuleOverride{
+					"942100": {Active: types.BoolValue(false), Action: types.StringNull()},
+					"942200": {Active: types.BoolNull(), Action: types.StringValue("log")},
+				},
+			},
+		},
+	}
+
+	clientCfg, err := cfg.toClient()
+	if err != nil {
+		t.Fatalf("unexpected error converting config to client: %v", err)
+	}
+	owasp := clientCfg.ManagedRulesets["owasp"]
+	if !owasp.Active || owasp.ParanoiaLevel == nil || *owasp.ParanoiaLevel != 2 {
+		t.Fatalf("expected active owasp ruleset with paranoia level 2, got %+v", owasp)
+	}
+	if owasp.AnomalyThresholds == nil || *owasp.AnomalyThresholds.Inbound != 10 || owasp.AnomalyThresholds.Outbound != nil {
+		t.Fatalf("expected only the inbound anomaly threshold to be set, got %+v", owasp.AnomalyThresholds)
+	}
+	if o := owasp.RuleOverrides["942100"]; o.Active == nil || *o.Active || o.Action != "" {
+		t.Fatalf("expected rule 942100 to be turned off, got %+v", o)
+	}
+	if o := owasp.RuleOverrides["942200"]; o.Active != nil || o.Action != "log" {
+		t.Fatalf("expected rule 942200 to log, got %+v", o)
+	}
+
+	// The API fills in the default outbound threshold, which must not show
+	// up as a diff against the unset attribute.
+	outbound := int64(firewallCRSDefaultOutboundAnomaly)
+	owasp.AnomalyThresholds.Outbound = &outbound
+	clientCfg.ManagedRulesets["owasp"] = owasp
+	clientCfg.CRS = defaultCRSMap()
+	clientCfg.CRS["sqli"] = client.CoreRuleSet{Active: true, Action: "deny"}
+
+	got, err := fromClient(clientCfg, cfg)
+	if err != nil {
+		t.Fatalf("unexpected error reading config: %v", err)
+	}
+	if !reflect.DeepEqual(got.ManagedRulesets.OWASP, cfg.ManagedRulesets.OWASP) {
+		t.Errorf("owasp ruleset = %+v, want %+v", got.ManagedRulesets.OWASP, cfg.ManagedRulesets.OWASP)
+	}
+}
+
+func TestFirewallConfigImportIncludesOWASPOverrides(t *testing.T) {
+	paranoia := int64(3)
+	active := false
+	out := client.FirewallConfig{
+		ProjectID: "prj_123",
+		TeamID:    "team_123",
+		Enabled:   true,
+		ManagedRulesets: map[string]client.ManagedRule{
+			"owasp": {
+				Active:        true,
+				ParanoiaLevel: &paranoia,
+				RuleOverrides: map[string]client.ManagedRuleOverride{
+					"941100": {Active: &active},
+				},
+			},
+		},
+		CRS: defaultCRSMap(),
+	}
+	out.CRS["xss"] = client.CoreRuleSet{Active: true, Action: "deny"}
+
+	got, err := fromClient(out, firewallConfigImportRef(out, "prj_123"))
+	if err != nil {
+		t.Fatalf("unexpected error reading config: %v", err)
+	}
+	if got.ManagedRulesets == nil || got.ManagedRulesets.OWASP == nil {
+		t.Fatalf("expected the owasp ruleset to be imported")
+	}
+	owasp := got.ManagedRulesets.OWASP
+	if owasp.XSS == nil || owasp.XSS.Action.ValueString() != "deny" {
+		t.Errorf("expected xss group to be imported, got %+v", owasp.XSS)
+	}
+	if owasp.SQLI != nil {
+		t.Errorf("expected inactive sqli group to be left out, got %+v", owasp.SQLI)
+	}
+	if owasp.ParanoiaLevel.ValueInt64() != 3 {
+		t.Errorf("expected paranoia level 3, got %s", owasp.ParanoiaLevel)
+	}
+	if o, ok := owasp.RuleOverrides["941100"]; !ok || o.Active.ValueBool() || !o.Action.IsNull() {
+		t.Errorf("expected rule 941100 override to be imported, got %+v", owasp.RuleOverrides)
+	}
+}
+
+func TestValidateCRSRuleOverrides(t *testing.T) {
+	owasp := &CRSRule{
+		XSS: &CRSRuleConfig{Active: types.BoolValue(false), Action: types.StringValue("deny")},
+		RuleOverrides: map[string]CRSRuleOverride{
+			"941100":   {Active: types.BoolValue(false)},
+			"942100":   {Action: types.StringValue("log")},
+			"920100":   {Active: types.BoolValue(false)},
+			"sqli-100": {Active: types.BoolValue(false)},
+		},
+	}
+
+	diags := validateCRSRuleOverrides(path.Root("managed_rulesets").AtName("owasp"), owasp)
+	if diags.ErrorsCount() != 1 || diags.WarningsCount() != 1 {
+		t.Fatalf("expected one error and one warning, got %v", diags)
+	}
+	errPath := diags.Errors()[0].(diag.DiagnosticWithPath).Path().String()
+	if want := `managed_rulesets.owasp.rule_overrides["sqli-100"]`; errPath != want {
+		t.Errorf("error path = %s, want %s", errPath, want)
+	}
+	warnPath := diags.Warnings()[0].(diag.DiagnosticWithPath).Path().String()
+	if want := `managed_rulesets.owasp.rule_overrides["941100"]`; warnPath != want {
+		t.Errorf("warning path = %s, want %s", warnPath, want)
+	}
+}
+
+func TestFromClientSkipsUnmanagedRuleLists(t *testing.T) {
+	out := client.FirewallConfig{
+		ProjectID: "prj_123",
+		TeamID:    "team_123",
+		Enabled:   true,
+		Rules:     []client.FirewallRule{testClientFirewallRule("rule_a", "alpha", "/alpha", "deny")},
+		IPRules:   []client.IPRule{{ID: "ip_a", Hostname: "example.com", IP: "1.2.3.4", Action: "deny"}},
+	}
+
+	got, err := fromClient(out, FirewallConfig{
+		ProjectID: types.StringValue("prj_123"),
+		Enabled:   types.BoolValue(true),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error reading config: %v", err)
+	}
+	if got.Rules != nil || got.IPRules != nil {
+		t.Fatalf("expected rules owned by other resources to be left out, got %+v %+v", got.Rules, got.IPRules)
+	}
+
+	got, err = fromClient(client.FirewallConfig{ProjectID: "prj_123", TeamID: "team_123"}, FirewallConfig{
+		ProjectID: types.StringValue("prj_123"),
+		Enabled:   types.BoolValue(true),
+		Rules:     &FirewallRules{},
+		IPRules:   &IPRules{},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error reading config: %v", err)
+	}
+	if got.Rules == nil || got.IPRules == nil {
+		t.Fatalf("expected empty managed rule lists to stay set")
+	}
+}
+
+func TestPatchFirewallConfigLeavesUnmanagedRules(t *testing.T) {
+	var actions []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch {
+		case r.Method == http.MethodGet && r.URL.Path == "/v1/security/firewall/config/active":
+			fmt.Fprintln(w, `{
+				"firewallEnabled": true,
+				"managedRules": {"bot_protection": {"active": true, "action": "challenge"}},
+				"rules": [{"id": "rule_a", "name": "alpha", "active": true, "conditionGroup": [], "action": {"mitigate": {"action": "deny"}}}],
+				"ips": [{"id": "ip_a", "hostname": "example.com", "ip": "1.2.3.4", "action": "deny"}]
+			}`)
+		case r.Method == http.MethodPatch && r.URL.Path == "/v1/security/firewall/config":
+			var body struct {
+				Action string             `json:"action"`
+				ID     string             `json:"id"`
+				Value  client.ManagedRule `json:"value"`
+			}
+			if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+				t.Errorf("unexpected request body: %v", err)
+			}
+			actions = append(actions, fmt.Sprintf("%s %s active=%t", body.Action, body.ID, body.Value.Active))
+			fmt.Fprintln(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(server.Close)
+
+	state := FirewallConfig{
+		ProjectID: types.StringValue("prj_123"),
+		TeamID:    types.StringValue("team_123"),
+		Enabled:   types.BoolValue(true),
+		ManagedRulesets: &FirewallManagedRulesets{
+			BotProtection: &BotProtectionConfig{Active: types.BoolValue(true), Action: types.StringValue("challenge")},
+		},
+	}
+	plan := FirewallConfig{
+		ProjectID: types.StringValue("prj_123"),
+		TeamID:    types.StringValue("team_123"),
+		Enabled:   types.BoolValue(true),
+		ManagedRulesets: &FirewallManagedRulesets{
+			AiBots: &AiBotsConfig{Active: types.BoolValue(true), Action: types.StringValue("deny")},
+		},
+	}
+
+	testResource := &firewallConfigResource{client: client.New("INVALID").WithBaseURL(server.URL)}
+	if _, err := testResource.patchFirewallConfig(context.Background(), state, plan); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []string{
+		"managedRules.update ai_bots active=true",
+		"managedRules.update bot_protection active=false",
+	}
+	if !reflect.DeepEqual(actions, want) {
+		t.Fatalf("PATCH actions = %v, want %v", actions, want)
+	}
+}
+
+func TestDiffFirewallIPRules(t *testing.T) {
+	a := client.IPRule{ID: "ip_a", Hostname: "example.com", IP: "1.1.1.1", Action: "deny"}
+	b := client.IPRule{ID: "ip_b", Hostname: "example.com", IP: "2.2.2.2", Action: "deny"}
+	c := client.IPRule{Hostname: "example.com", IP: "3.3.3.3", Action: "deny"}
+
+	removals, inserts := diffFirewallIPRules([]client.IPRule{a, b}, []client.IPRule{{Hostname: a.Hostname, IP: a.IP, Action: a.Action}, c, {Hostname: b.Hostname, IP: b.IP, Action: b.Action}})
+	if len(removals) != 1 || removals[0].ID != "ip_b" {
+		t.Errorf("removals = %+v, want ip_b", removals)
+	}
+	if len(inserts) != 2 || inserts[0].IP != "3.3.3.3" || inserts[1].IP != "2.2.2.2" {
+		t.Errorf("inserts = %+v, want 3.3.3.3 then 2.2.2.2", inserts)
+	}
+}
@@ -0,0 +1,383 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                   = &firewallIPRuleResource{}
+	_ resource.ResourceWithConfigure      = &firewallIPRuleResource{}
+	_ resource.ResourceWithImportState    = &firewallIPRuleResource{}
+	_ resource.ResourceWithValidateConfig = &firewallIPRuleResource{}
+)
+
+func newFirewallIPRuleResource() resource.Resource {
+	return &firewallIPRuleResource{}
+}
+
+type firewallIPRuleResource struct {
+	client *client.Client
+}
+
+type firewallIPRuleModel struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	Hostname  types.String `tfsdk:"hostname"`
+	IP        types.String `tfsdk:"ip"`
+	Notes     types.String `tfsdk:"notes"`
+	Action    types.String `tfsdk:"action"`
+}
+
+func (m firewallIPRuleModel) toClient() client.IPRule {
+	return client.IPRule{
+		Hostname: m.Hostname.ValueString(),
+		IP:       m.IP.ValueString(),
+		Notes:    m.Notes.ValueString(),
+		Action:   m.Action.ValueString(),
+	}
+}
+
+func firewallIPRuleModelFromClient(rule client.IPRule, ref firewallIPRuleModel) firewallIPRuleModel {
+	model := firewallIPRuleModel{
+		ID:        types.StringValue(rule.ID),
+		ProjectID: ref.ProjectID,
+		TeamID:    ref.TeamID,
+		Hostname:  types.StringValue(rule.Hostname),
+		IP:        types.StringValue(rule.IP),
+		Notes:     types.StringValue(rule.Notes),
+		Action:    types.StringValue(rule.Action),
+	}
+	// notes don't have to be set
+	if rule.Notes == "" && ref.Notes.IsNull() {
+		model.Notes = types.StringNull()
+	}
+	return model
+}
+
+func (r *firewallIPRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_ip_rule"
+}
+
+func (r *firewallIPRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *firewallIPRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Firewall IP Rule resource.
+
+This resource manages a single IP blocking rule in a project's firewall, leaving every other IP rule untouched.
+
+~> Do not use this resource for a project whose ` + "`vercel_firewall_config`" + ` also configures ` + "`ip_rules`" + `. The firewall config manages the whole IP rule list and removes rules it does not know about.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the IP rule.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the project the IP rule belongs to.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the project belongs to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"hostname": schema.StringAttribute{
+				Description: "Hosts to apply this rule to",
+				Required:    true,
+			},
+			"ip": schema.StringAttribute{
+				Description: "IP or CIDR to block",
+				Required:    true,
+			},
+			"notes": schema.StringAttribute{
+				Optional: true,
+			},
+			"action": schema.StringAttribute{
+				Description: "The action to take for matching requests. Must be one of `bypass`, `log`, `challenge` or `deny`.",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("bypass", "log", "challenge", "deny"),
+				},
+			},
+		},
+	}
+}
+
+// findInsertedFirewallIPRule mirrors findInsertedFirewallRule for IP rules.
+func findInsertedFirewallIPRule(before, after []client.IPRule, inserted client.IPRule) (client.IPRule, error) {
+	existing := map[string]struct{}{}
+	for _, rule := range before {
+		existing[rule.ID] = struct{}{}
+	}
+
+	var candidates []client.IPRule
+	for _, rule := range after {
+		if _, ok := existing[rule.ID]; !ok {
+			candidates = append(candidates, rule)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+	for _, rule := range candidates {
+		if rule.Hostname == inserted.Hostname && rule.IP == inserted.IP {
+			return rule, nil
+		}
+	}
+	return client.IPRule{}, fmt.Errorf("could not find firewall IP rule for %s on %s after inserting it", inserted.IP, inserted.Hostname)
+}
+
+func firewallIPRuleIndex(rules []client.IPRule, id string) int {
+	for i, rule := range rules {
+		if rule.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// ValidateConfig checks that the rule's ip is an IP address or CIDR range.
+func (r *firewallIPRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config firewallIPRuleModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateFirewallIPString(path.Root("ip"), config.IP)...)
+}
+
+func (r *firewallIPRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan firewallIPRuleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TeamID = types.StringValue(r.client.TeamID(plan.TeamID.ValueString()))
+	projectID, teamID := plan.ProjectID.ValueString(), plan.TeamID.ValueString()
+	rule := plan.toClient()
+
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, projectID))
+	defer unlock()
+
+	before, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError("Error creating Firewall IP Rule", "Could not read Firewall Config, unexpected error: "+err.Error())
+		return
+	}
+
+	err = r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    "ip.insert",
+		ID:        nil,
+		Value:     rule,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Firewall IP Rule", "Could not insert Firewall IP Rule, unexpected error: "+err.Error())
+		return
+	}
+
+	after, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Firewall IP Rule", "Could not read Firewall Config, unexpected error: "+err.Error())
+		return
+	}
+	created, err := findInsertedFirewallIPRule(before.IPRules, after.IPRules, rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Firewall IP Rule", err.Error())
+		return
+	}
+
+	result := firewallIPRuleModelFromClient(created, plan)
+	tflog.Info(ctx, "created firewall ip rule", map[string]any{
+		"project_id": projectID,
+		"team_id":    teamID,
+		"rule_id":    result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallIPRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state firewallIPRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conf, err := r.client.GetFirewallConfig(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Firewall IP Rule",
+			fmt.Sprintf("Could not read Firewall IP Rule %s %s %s, unexpected error: %s", state.TeamID.ValueString(), state.ProjectID.ValueString(), state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	i := firewallIPRuleIndex(conf.IPRules, state.ID.ValueString())
+	if i == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result := firewallIPRuleModelFromClient(conf.IPRules[i], state)
+	result.TeamID = types.StringValue(conf.TeamID)
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallIPRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state firewallIPRuleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, teamID := plan.ProjectID.ValueString(), plan.TeamID.ValueString()
+
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, projectID))
+	defer unlock()
+
+	err := r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    "ip.update",
+		ID:        state.ID.ValueString(),
+		Value:     plan.toClient(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Firewall IP Rule",
+			fmt.Sprintf("Could not update Firewall IP Rule %s %s %s, unexpected error: %s", teamID, projectID, state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	conf, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Firewall IP Rule", "Could not read Firewall Config, unexpected error: "+err.Error())
+		return
+	}
+	i := firewallIPRuleIndex(conf.IPRules, state.ID.ValueString())
+	if i == -1 {
+		resp.Diagnostics.AddError("Error updating Firewall IP Rule", fmt.Sprintf("Could not find Firewall IP Rule %s after updating it.", state.ID.ValueString()))
+		return
+	}
+
+	diags = resp.State.Set(ctx, firewallIPRuleModelFromClient(conf.IPRules[i], plan))
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallIPRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state firewallIPRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, teamID := state.ProjectID.ValueString(), state.TeamID.ValueString()
+
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, projectID))
+	defer unlock()
+
+	err := r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    "ip.remove",
+		ID:        state.ID.ValueString(),
+		Value:     nil,
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Firewall IP Rule",
+			fmt.Sprintf("Could not delete Firewall IP Rule %s %s %s, unexpected error: %s", teamID, projectID, state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted firewall ip rule", map[string]any{
+		"project_id": projectID,
+		"team_id":    teamID,
+		"rule_id":    state.ID.ValueString(),
+	})
+}
+
+func (r *firewallIPRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, ruleID, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing Firewall IP Rule",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/rule_id\" or \"project_id/rule_id\"", req.ID),
+		)
+		return
+	}
+
+	conf, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Firewall IP Rule",
+			fmt.Sprintf("Could not read Firewall Config %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+	i := firewallIPRuleIndex(conf.IPRules, ruleID)
+	if i == -1 {
+		resp.Diagnostics.AddError(
+			"Error importing Firewall IP Rule",
+			fmt.Sprintf("Could not find Firewall IP Rule %s in project %s.", ruleID, projectID),
+		)
+		return
+	}
+
+	diags := resp.State.Set(ctx, firewallIPRuleModelFromClient(conf.IPRules[i], firewallIPRuleModel{
+		ProjectID: types.StringValue(projectID),
+		TeamID:    types.StringValue(conf.TeamID),
+		Notes:     types.StringNull(),
+	}))
+	resp.Diagnostics.Append(diags...)
+}
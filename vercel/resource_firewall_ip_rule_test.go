@@ -0,0 +1,56 @@
+package vercel_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_FirewallIPRuleResource(t *testing.T) {
+	name := strings.ToLower(acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallIPRuleResourceConfig(name, "deny")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_firewall_ip_rule.test", "id"),
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.test", "ip", "203.0.113.0/24"),
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.test", "action", "deny"),
+					resource.TestCheckNoResourceAttr("vercel_firewall_ip_rule.test", "notes"),
+				),
+			},
+			{
+				Config: cfg(testAccFirewallIPRuleResourceConfig(name, "challenge")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_ip_rule.test", "action", "challenge"),
+				),
+			},
+			{
+				ResourceName:      "vercel_firewall_ip_rule.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getFirewallRuleImportID("vercel_firewall_ip_rule.test"),
+			},
+		},
+	})
+}
+
+func testAccFirewallIPRuleResourceConfig(name, action string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s-ip-rules"
+}
+
+resource "vercel_firewall_ip_rule" "test" {
+  project_id = vercel_project.test.id
+  hostname   = "%[1]s.vercel.app"
+  ip         = "203.0.113.0/24"
+  action     = "%[2]s"
+}
+`, name, action)
+}
@@ -0,0 +1,564 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                   = &firewallRuleResource{}
+	_ resource.ResourceWithConfigure      = &firewallRuleResource{}
+	_ resource.ResourceWithImportState    = &firewallRuleResource{}
+	_ resource.ResourceWithModifyPlan     = &firewallRuleResource{}
+	_ resource.ResourceWithValidateConfig = &firewallRuleResource{}
+)
+
+func newFirewallRuleResource() resource.Resource {
+	return &firewallRuleResource{}
+}
+
+type firewallRuleResource struct {
+	client *client.Client
+}
+
+type firewallRuleModel struct {
+	ID              types.String     `tfsdk:"id"`
+	ProjectID       types.String     `tfsdk:"project_id"`
+	TeamID          types.String     `tfsdk:"team_id"`
+	Name            types.String     `tfsdk:"name"`
+	Description     types.String     `tfsdk:"description"`
+	Active          types.Bool       `tfsdk:"active"`
+	Priority        types.Int64      `tfsdk:"priority"`
+	ConditionGroup  []ConditionGroup `tfsdk:"condition_group"`
+	Action          Mitigate         `tfsdk:"action"`
+	StagedRollout   types.Object     `tfsdk:"staged_rollout"`
+	EffectiveAction types.String     `tfsdk:"effective_action"`
+	StagedAt        types.String     `tfsdk:"staged_at"`
+}
+
+func (m firewallRuleModel) firewallRule() FirewallRule {
+	return FirewallRule{
+		ID:              m.ID,
+		Name:            m.Name,
+		Description:     m.Description,
+		Active:          m.Active,
+		ConditionGroup:  m.ConditionGroup,
+		Action:          m.Action,
+		StagedRollout:   m.StagedRollout,
+		EffectiveAction: m.EffectiveAction,
+		StagedAt:        m.StagedAt,
+	}
+}
+
+func (m firewallRuleModel) toClient() (client.FirewallRule, error) {
+	rules, err := firewallRulesToClient(&FirewallRules{Rules: []FirewallRule{m.firewallRule()}})
+	if err != nil {
+		return client.FirewallRule{}, err
+	}
+	rule := rules[0]
+	rule.ID = ""
+	return rule, nil
+}
+
+// firewallRulePriority is the priority of the rule at index in a list of total
+// rules. A configured priority past the end of the list places the rule last,
+// so it is kept while the rule is still last rather than shown as drift.
+func firewallRulePriority(index, total int, prior types.Int64) types.Int64 {
+	if index == total-1 && !prior.IsNull() && !prior.IsUnknown() && prior.ValueInt64() > int64(index) {
+		return prior
+	}
+	return types.Int64Value(int64(index))
+}
+
+// firewallRuleModelFromClient reads the rule at index i of rules. Its priority
+// is its position in the list, so rules moved outside Terraform show a diff.
+func firewallRuleModelFromClient(rules []client.FirewallRule, i int, ref firewallRuleModel) (firewallRuleModel, error) {
+	converted, err := fromFirewallRule(rules[i], ref.firewallRule())
+	if err != nil {
+		return firewallRuleModel{}, err
+	}
+	return firewallRuleModel{
+		ID:              converted.ID,
+		ProjectID:       ref.ProjectID,
+		TeamID:          ref.TeamID,
+		Name:            converted.Name,
+		Description:     converted.Description,
+		Active:          converted.Active,
+		Priority:        firewallRulePriority(i, len(rules), ref.Priority),
+		ConditionGroup:  converted.ConditionGroup,
+		Action:          converted.Action,
+		StagedRollout:   converted.StagedRollout,
+		EffectiveAction: converted.EffectiveAction,
+		StagedAt:        converted.StagedAt,
+	}, nil
+}
+
+func (r *firewallRuleResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_rule"
+}
+
+func (r *firewallRuleResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *firewallRuleResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Firewall Rule resource.
+
+This resource manages a single custom rule in a project's firewall, leaving every other rule untouched. Use it when several teams or configurations each own some of the rules on a shared project.
+
+Rules are evaluated in order. ` + "`priority`" + ` is the position of the rule from the top of the list, starting at 0, and is applied when the rule is created or the priority changes. When ` + "`priority`" + ` is unset, the rule is added to the end of the list and its current position is recorded in state. When it is set and the rule has since moved, for example because a rule was added above it, the next plan moves it back.
+
+~> Do not use this resource for a project whose ` + "`vercel_firewall_config`" + ` also configures ` + "`rules`" + `. The firewall config manages the whole rule list and removes rules it does not know about.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the firewall rule.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the project the rule belongs to.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the project belongs to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name to identify the rule",
+				Required:    true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(4, 160),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtMost(260),
+				},
+			},
+			"active": schema.BoolAttribute{
+				Description: "Rule is active or disabled. Defaults to `true`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+			},
+			"priority": schema.Int64Attribute{
+				Description: "The position of the rule in the project's rule list, where 0 is evaluated first. Positions past the end of the list place the rule last. When unset, the rule is added to the end of the list.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"action":           firewallRuleActionAttribute(),
+			"condition_group":  firewallRuleConditionGroupAttribute(),
+			"staged_rollout":   firewallRuleStagedRolloutAttribute(),
+			"effective_action": firewallRuleEffectiveActionAttribute(),
+			"staged_at":        firewallRuleStagedAtAttribute(),
+		},
+	}
+}
+
+// findInsertedFirewallRule returns the rule that appears in after but not in
+// before. The config is locked while rules are inserted, so there is normally
+// exactly one; if the config was changed outside Terraform at the same time,
+// the rule whose body matches the inserted one wins.
+func findInsertedFirewallRule(before, after []client.FirewallRule, inserted client.FirewallRule) (client.FirewallRule, error) {
+	existing := map[string]struct{}{}
+	for _, rule := range before {
+		existing[rule.ID] = struct{}{}
+	}
+
+	var candidates []client.FirewallRule
+	for _, rule := range after {
+		if _, ok := existing[rule.ID]; !ok {
+			candidates = append(candidates, rule)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0], nil
+	}
+
+	want, err := firewallRuleFingerprint(inserted)
+	if err != nil {
+		return client.FirewallRule{}, err
+	}
+	for _, rule := range candidates {
+		got, err := firewallRuleFingerprint(rule)
+		if err != nil {
+			return client.FirewallRule{}, err
+		}
+		if got == want {
+			return rule, nil
+		}
+	}
+	return client.FirewallRule{}, fmt.Errorf("could not find firewall rule %q after inserting it", inserted.Name)
+}
+
+func firewallRuleIndex(rules []client.FirewallRule, id string) int {
+	for i, rule := range rules {
+		if rule.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// moveFirewallRule moves a rule to the position requested by priority,
+// clamped to the end of the list, and returns the refreshed config.
+func (r *firewallRuleResource) moveFirewallRule(ctx context.Context, conf client.FirewallConfig, projectID, teamID, ruleID string, priority int64) (client.FirewallConfig, error) {
+	current := firewallRuleIndex(conf.Rules, ruleID)
+	if current == -1 {
+		return conf, fmt.Errorf("could not find firewall rule %q", ruleID)
+	}
+	desired := int(min(priority, int64(len(conf.Rules)-1)))
+	if current == desired {
+		return conf, nil
+	}
+
+	err := r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    "rules.priority",
+		ID:        ruleID,
+		Value:     desired,
+	})
+	if err != nil {
+		return conf, err
+	}
+	return r.client.GetFirewallConfig(ctx, projectID, teamID)
+}
+
+// ValidateConfig checks the rule for mistakes the API would otherwise only
+// report when it is created or updated.
+func (r *firewallRuleResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config firewallRuleModel
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(validateFirewallRule(ctx, path.Empty(), config.firewallRule())...)
+}
+
+// ModifyPlan works out the action a staged rule will be deployed with, so
+// that a pending promotion shows up in the plan.
+func (r *firewallRuleResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan firewallRuleModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+
+	var prior *FirewallRule
+	if !req.State.Raw.IsNull() {
+		var state firewallRuleModel
+		diags := req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		rule := state.firewallRule()
+		prior = &rule
+	}
+
+	rule := plan.firewallRule()
+	resp.Diagnostics.Append(setFirewallRuleRolloutPlan(ctx, &resp.Plan, path.Empty(), &rule, prior, time.Now())...)
+}
+
+func (r *firewallRuleResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan firewallRuleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TeamID = types.StringValue(r.client.TeamID(plan.TeamID.ValueString()))
+	projectID, teamID := plan.ProjectID.ValueString(), plan.TeamID.ValueString()
+
+	rule, err := plan.toClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Firewall Rule", "Could not convert Firewall Rule, unexpected error: "+err.Error())
+		return
+	}
+
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, projectID))
+	defer unlock()
+
+	before, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil && !client.NotFound(err) {
+		resp.Diagnostics.AddError("Error creating Firewall Rule", "Could not read Firewall Config, unexpected error: "+err.Error())
+		return
+	}
+
+	err = r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    "rules.insert",
+		ID:        nil,
+		Value:     rule,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Firewall Rule", "Could not insert Firewall Rule, unexpected error: "+err.Error())
+		return
+	}
+
+	after, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Firewall Rule", "Could not read Firewall Config, unexpected error: "+err.Error())
+		return
+	}
+	created, err := findInsertedFirewallRule(before.Rules, after.Rules, rule)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Firewall Rule", err.Error())
+		return
+	}
+
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() {
+		after, err = r.moveFirewallRule(ctx, after, projectID, teamID, created.ID, plan.Priority.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating Firewall Rule", "Could not set Firewall Rule priority, unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	i := firewallRuleIndex(after.Rules, created.ID)
+	if i == -1 {
+		resp.Diagnostics.AddError("Error creating Firewall Rule", fmt.Sprintf("Could not find Firewall Rule %s after creating it.", created.ID))
+		return
+	}
+	result, err := firewallRuleModelFromClient(after.Rules, i, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating Firewall Rule", "Could not read created Firewall Rule, unexpected error: "+err.Error())
+		return
+	}
+
+	tflog.Info(ctx, "created firewall rule", map[string]any{
+		"project_id": projectID,
+		"team_id":    teamID,
+		"rule_id":    result.ID.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallRuleResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state firewallRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	conf, err := r.client.GetFirewallConfig(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading Firewall Rule",
+			fmt.Sprintf("Could not read Firewall Rule %s %s %s, unexpected error: %s", state.TeamID.ValueString(), state.ProjectID.ValueString(), state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	i := firewallRuleIndex(conf.Rules, state.ID.ValueString())
+	if i == -1 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	result, err := firewallRuleModelFromClient(conf.Rules, i, state)
+	if err != nil {
+		resp.Diagnostics.AddError("Error reading Firewall Rule", "Could not read Firewall Rule, unexpected error: "+err.Error())
+		return
+	}
+	result.TeamID = types.StringValue(conf.TeamID)
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallRuleResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state firewallRuleModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, teamID := plan.ProjectID.ValueString(), plan.TeamID.ValueString()
+
+	rule, err := plan.toClient()
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Firewall Rule", "Could not convert Firewall Rule, unexpected error: "+err.Error())
+		return
+	}
+
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, projectID))
+	defer unlock()
+
+	err = r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    "rules.update",
+		ID:        state.ID.ValueString(),
+		Value:     rule,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating Firewall Rule",
+			fmt.Sprintf("Could not update Firewall Rule %s %s %s, unexpected error: %s", teamID, projectID, state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	conf, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Firewall Rule", "Could not read Firewall Config, unexpected error: "+err.Error())
+		return
+	}
+	if !plan.Priority.IsNull() && !plan.Priority.IsUnknown() && !plan.Priority.Equal(state.Priority) {
+		conf, err = r.moveFirewallRule(ctx, conf, projectID, teamID, state.ID.ValueString(), plan.Priority.ValueInt64())
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating Firewall Rule", "Could not set Firewall Rule priority, unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	i := firewallRuleIndex(conf.Rules, state.ID.ValueString())
+	if i == -1 {
+		resp.Diagnostics.AddError("Error updating Firewall Rule", fmt.Sprintf("Could not find Firewall Rule %s after updating it.", state.ID.ValueString()))
+		return
+	}
+	result, err := firewallRuleModelFromClient(conf.Rules, i, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating Firewall Rule", "Could not read updated Firewall Rule, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallRuleResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state firewallRuleModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projectID, teamID := state.ProjectID.ValueString(), state.TeamID.ValueString()
+
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, projectID))
+	defer unlock()
+
+	err := r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+		ProjectID: projectID,
+		TeamID:    teamID,
+		Action:    "rules.remove",
+		ID:        state.ID.ValueString(),
+		Value:     nil,
+	})
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting Firewall Rule",
+			fmt.Sprintf("Could not delete Firewall Rule %s %s %s, unexpected error: %s", teamID, projectID, state.ID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted firewall rule", map[string]any{
+		"project_id": projectID,
+		"team_id":    teamID,
+		"rule_id":    state.ID.ValueString(),
+	})
+}
+
+func (r *firewallRuleResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, ruleID, ok := splitInto2Or3(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing Firewall Rule",
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/rule_id\" or \"project_id/rule_id\"", req.ID),
+		)
+		return
+	}
+
+	conf, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing Firewall Rule",
+			fmt.Sprintf("Could not read Firewall Config %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+	i := firewallRuleIndex(conf.Rules, ruleID)
+	if i == -1 {
+		resp.Diagnostics.AddError(
+			"Error importing Firewall Rule",
+			fmt.Sprintf("Could not find Firewall Rule %s in project %s.", ruleID, projectID),
+		)
+		return
+	}
+
+	result, err := firewallRuleModelFromClient(conf.Rules, i, firewallRuleModel{
+		ProjectID:   types.StringValue(projectID),
+		TeamID:      types.StringValue(conf.TeamID),
+		Description: types.StringNull(),
+		Active:      types.BoolValue(conf.Rules[i].Active),
+		Priority:    types.Int64Null(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing Firewall Rule", "Could not read Firewall Rule, unexpected error: "+err.Error())
+		return
+	}
+
+	diags := resp.State.Set(ctx, result)
+	resp.Diagnostics.Append(diags...)
+}
@@ -0,0 +1,158 @@
+package vercel_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func getFirewallRuleImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return "", fmt.Errorf("no ID is set")
+		}
+
+		if rs.Primary.Attributes["team_id"] == "" {
+			return fmt.Sprintf("%s/%s", rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+		}
+		return fmt.Sprintf("%s/%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["project_id"], rs.Primary.ID), nil
+	}
+}
+
+func TestAcc_FirewallRuleResource(t *testing.T) {
+	name := strings.ToLower(acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallRuleResourceConfig(name, "deny", 1)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("vercel_firewall_rule.api", "id"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.api", "action.action", "deny"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.api", "active", "true"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.api", "priority", "1"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.bots", "priority", "0"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.bots", "condition_group.0.conditions.0.values.#", "2"),
+				),
+			},
+			{
+				Config: cfg(testAccFirewallRuleResourceConfig(name, "challenge", 0)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_rule.api", "action.action", "challenge"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.api", "priority", "0"),
+				),
+			},
+			{
+				ResourceName:            "vercel_firewall_rule.api",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       getFirewallRuleImportID("vercel_firewall_rule.api"),
+				ImportStateVerifyIgnore: []string{"priority"},
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleResourceConfig(name, action string, priority int) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s-rules"
+}
+
+resource "vercel_firewall_rule" "bots" {
+  project_id = vercel_project.test.id
+  name       = "block bad bots"
+  priority   = 0
+  action = {
+    action = "deny"
+  }
+  condition_group = [{
+    conditions = [{
+      type   = "user_agent"
+      op     = "inc"
+      values = ["badbot", "worsebot"]
+    }]
+  }]
+}
+
+resource "vercel_firewall_rule" "api" {
+  project_id = vercel_project.test.id
+  name       = "protect api"
+  priority   = %[3]d
+  action = {
+    action = "%[2]s"
+  }
+  condition_group = [{
+    conditions = [{
+      type  = "path"
+      op    = "pre"
+      value = "/api"
+    }]
+  }]
+
+  depends_on = [vercel_firewall_rule.bots]
+}
+`, name, action, priority)
+}
+
+func TestAcc_FirewallRuleStagedRollout(t *testing.T) {
+	name := strings.ToLower(acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallRuleStagedRolloutConfig(name, false)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_rule.staged", "action.action", "deny"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.staged", "effective_action", "log"),
+					resource.TestCheckResourceAttrSet("vercel_firewall_rule.staged", "staged_at"),
+				),
+			},
+			{
+				Config: cfg(testAccFirewallRuleStagedRolloutConfig(name, true)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_rule.staged", "action.action", "deny"),
+					resource.TestCheckResourceAttr("vercel_firewall_rule.staged", "effective_action", "deny"),
+				),
+			},
+		},
+	})
+}
+
+func testAccFirewallRuleStagedRolloutConfig(name string, promote bool) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "test" {
+  name = "test-acc-%[1]s-staged"
+}
+
+resource "vercel_firewall_rule" "staged" {
+  project_id = vercel_project.test.id
+  name       = "stage admin block"
+  staged_rollout = {
+    review_period = "72h"
+    promote       = %[2]t
+  }
+  action = {
+    action = "deny"
+  }
+  condition_group = [{
+    conditions = [{
+      type  = "path"
+      op    = "pre"
+      value = "/admin"
+    }]
+  }]
+}
+`, name, promote)
+}
@@ -0,0 +1,236 @@
+package vercel
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestFindInsertedFirewallRule(t *testing.T) {
+	before := []client.FirewallRule{{ID: "rule_a", Name: "Existing"}}
+	inserted := client.FirewallRule{Name: "Block bots", Action: client.Action{Mitigate: client.Mitigate{Action: "deny"}}}
+
+	rule, err := findInsertedFirewallRule(before, []client.FirewallRule{
+		{ID: "rule_b", Name: "Block bots", Action: inserted.Action},
+		{ID: "rule_a", Name: "Existing"},
+	}, inserted)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rule.ID != "rule_b" {
+		t.Errorf("rule ID = %q, want rule_b", rule.ID)
+	}
+
+	// A concurrent change outside Terraform adds a second rule; the body decides.
+	rule, err = findInsertedFirewallRule(before, []client.FirewallRule{
+		{ID: "rule_a", Name: "Existing"},
+		{ID: "rule_c", Name: "Someone else"},
+		{ID: "rule_b", Name: "Block bots", Action: inserted.Action},
+	}, inserted)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rule.ID != "rule_b" {
+		t.Errorf("rule ID = %q, want rule_b", rule.ID)
+	}
+
+	if _, err := findInsertedFirewallRule(before, before, inserted); err == nil {
+		t.Error("expected an error when no rule was inserted")
+	}
+}
+
+func TestFindInsertedFirewallIPRule(t *testing.T) {
+	before := []client.IPRule{{ID: "ip_a", Hostname: "example.com", IP: "10.0.0.1"}}
+	inserted := client.IPRule{Hostname: "example.com", IP: "10.0.0.0/24", Action: "deny"}
+
+	rule, err := findInsertedFirewallIPRule(before, []client.IPRule{
+		before[0],
+		{ID: "ip_c", Hostname: "other.com", IP: "10.0.0.0/24"},
+		{ID: "ip_b", Hostname: "example.com", IP: "10.0.0.0/24"},
+	}, inserted)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if rule.ID != "ip_b" {
+		t.Errorf("rule ID = %q, want ip_b", rule.ID)
+	}
+}
+
+func stagedFirewallRule(action string, reviewPeriod types.String, promote types.Bool) FirewallRule {
+	return FirewallRule{
+		Name: types.StringValue("Block bots"),
+		Action: Mitigate{
+			Action:    types.StringValue(action),
+			RateLimit: types.ObjectNull(ratelimitType.AttrTypes),
+			Redirect:  types.ObjectNull(redirectType.AttrTypes),
+		},
+		StagedRollout: types.ObjectValueMust(firewallRuleStagedRolloutAttrTypes, map[string]attr.Value{
+			"review_period": reviewPeriod,
+			"promote":       promote,
+		}),
+	}
+}
+
+func TestFirewallRuleEffectiveAction(t *testing.T) {
+	ctx := context.Background()
+	stagedAt := types.StringValue("2026-01-01T00:00:00Z")
+	staged := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	unstaged := stagedFirewallRule("deny", types.StringNull(), types.BoolNull())
+	unstaged.StagedRollout = types.ObjectNull(firewallRuleStagedRolloutAttrTypes)
+
+	tests := []struct {
+		name string
+		rule FirewallRule
+		now  time.Time
+		want types.String
+	}{
+		{"not staged", unstaged, staged, types.StringValue("deny")},
+		{"log is never staged", stagedFirewallRule("log", types.StringValue("72h"), types.BoolNull()), staged, types.StringValue("log")},
+		{"within review period", stagedFirewallRule("deny", types.StringValue("72h"), types.BoolNull()), staged.Add(71 * time.Hour), types.StringValue("log")},
+		{"review period passed", stagedFirewallRule("deny", types.StringValue("72h"), types.BoolNull()), staged.Add(72 * time.Hour), types.StringValue("deny")},
+		{"no review period", stagedFirewallRule("deny", types.StringNull(), types.BoolNull()), staged.Add(1000 * time.Hour), types.StringValue("log")},
+		{"promoted explicitly", stagedFirewallRule("challenge", types.StringValue("72h"), types.BoolValue(true)), staged, types.StringValue("challenge")},
+		{"unknown promote", stagedFirewallRule("deny", types.StringValue("72h"), types.BoolUnknown()), staged, types.StringUnknown()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := firewallRuleEffectiveAction(ctx, tt.rule, stagedAt, tt.now)
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+			if !got.Equal(tt.want) {
+				t.Errorf("effective action = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPlanFirewallRuleRollout(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	// A new staged rule is staged now and deployed as log.
+	rule := stagedFirewallRule("deny", types.StringValue("72h"), types.BoolNull())
+	warning, diags := planFirewallRuleRollout(ctx, &rule, nil, now)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if rule.StagedAt.ValueString() != "2026-01-04T00:00:00Z" || rule.EffectiveAction.ValueString() != "log" || warning != "" {
+		t.Errorf("new rule planned as staged_at=%s effective_action=%s warning=%q", rule.StagedAt, rule.EffectiveAction, warning)
+	}
+
+	// Once the review period has passed, the plan promotes the rule and warns.
+	prior := stagedFirewallRule("deny", types.StringValue("72h"), types.BoolNull())
+	prior.StagedAt = types.StringValue("2026-01-01T00:00:00Z")
+	prior.EffectiveAction = types.StringValue("log")
+	rule = stagedFirewallRule("deny", types.StringValue("72h"), types.BoolNull())
+	warning, diags = planFirewallRuleRollout(ctx, &rule, &prior, now)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !rule.StagedAt.Equal(prior.StagedAt) || rule.EffectiveAction.ValueString() != "deny" || warning == "" {
+		t.Errorf("due rule planned as staged_at=%s effective_action=%s warning=%q", rule.StagedAt, rule.EffectiveAction, warning)
+	}
+
+	// A promoted rule stays promoted when the review period is extended.
+	prior.EffectiveAction = types.StringValue("deny")
+	rule = stagedFirewallRule("deny", types.StringValue("720h"), types.BoolNull())
+	warning, diags = planFirewallRuleRollout(ctx, &rule, &prior, now)
+	if diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if rule.EffectiveAction.ValueString() != "deny" || warning != "" {
+		t.Errorf("promoted rule planned as effective_action=%s warning=%q", rule.EffectiveAction, warning)
+	}
+
+	// Removing staged_rollout clears staged_at.
+	rule = stagedFirewallRule("deny", types.StringNull(), types.BoolNull())
+	rule.StagedRollout = types.ObjectNull(firewallRuleStagedRolloutAttrTypes)
+	if _, diags = planFirewallRuleRollout(ctx, &rule, &prior, now); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if !rule.StagedAt.IsNull() || rule.EffectiveAction.ValueString() != "deny" {
+		t.Errorf("unstaged rule planned as staged_at=%s effective_action=%s", rule.StagedAt, rule.EffectiveAction)
+	}
+}
+
+func TestFirewallRuleStagedRoundTrip(t *testing.T) {
+	rule := stagedFirewallRule("deny", types.StringValue("72h"), types.BoolNull())
+	rule.StagedAt = types.StringValue("2026-01-01T00:00:00Z")
+	rule.EffectiveAction = types.StringValue("log")
+
+	mit, err := rule.Mitigate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mit.Action != "log" {
+		t.Fatalf("deployed action = %q, want log", mit.Action)
+	}
+
+	// Reading the log rule back keeps the intended action, so there is no drift.
+	read, err := fromFirewallRule(client.FirewallRule{
+		ID:     "rule_a",
+		Name:   "Block bots",
+		Active: true,
+		Action: client.Action{Mitigate: mit},
+	}, rule)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if read.Action.Action.ValueString() != "deny" || read.EffectiveAction.ValueString() != "log" {
+		t.Errorf("read action=%s effective_action=%s, want deny and log", read.Action.Action, read.EffectiveAction)
+	}
+	if !read.StagedAt.Equal(rule.StagedAt) || !read.StagedRollout.Equal(rule.StagedRollout) {
+		t.Error("staged_rollout and staged_at were not preserved")
+	}
+
+	// A promoted rule is deployed with its intended action.
+	rule.EffectiveAction = types.StringValue("deny")
+	mit, err = rule.Mitigate()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if mit.Action != "deny" {
+		t.Errorf("deployed action = %q, want deny", mit.Action)
+	}
+}
+
+func TestFirewallRulePriority(t *testing.T) {
+	rules := []client.FirewallRule{
+		{ID: "rule_a", Name: "First", Action: client.Action{Mitigate: client.Mitigate{Action: "deny"}}},
+		{ID: "rule_b", Name: "Second", Action: client.Action{Mitigate: client.Mitigate{Action: "deny"}}},
+		{ID: "rule_c", Name: "Third", Action: client.Action{Mitigate: client.Mitigate{Action: "deny"}}},
+	}
+
+	for _, tc := range []struct {
+		name  string
+		index int
+		prior types.Int64
+		want  int64
+	}{
+		{name: "unset", index: 1, prior: types.Int64Null(), want: 1},
+		{name: "moved down", index: 2, prior: types.Int64Value(0), want: 2},
+		{name: "past the end", index: 2, prior: types.Int64Value(10), want: 10},
+		{name: "past the end but not last", index: 1, prior: types.Int64Value(10), want: 1},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			model, err := firewallRuleModelFromClient(rules, tc.index, firewallRuleModel{
+				Description: types.StringNull(),
+				Active:      types.BoolNull(),
+				Priority:    tc.prior,
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := model.Priority.ValueInt64(); got != tc.want {
+				t.Errorf("priority = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,692 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                   = &firewallRulesetResource{}
+	_ resource.ResourceWithConfigure      = &firewallRulesetResource{}
+	_ resource.ResourceWithModifyPlan     = &firewallRulesetResource{}
+	_ resource.ResourceWithValidateConfig = &firewallRulesetResource{}
+)
+
+func newFirewallRulesetResource() resource.Resource {
+	return &firewallRulesetResource{}
+}
+
+type firewallRulesetResource struct {
+	client *client.Client
+}
+
+type firewallRulesetModel struct {
+	ID         types.String          `tfsdk:"id"`
+	TeamID     types.String          `tfsdk:"team_id"`
+	Name       types.String          `tfsdk:"name"`
+	Rules      []firewallRulesetRule `tfsdk:"rules"`
+	ProjectIDs types.Set             `tfsdk:"project_ids"`
+	Projects   types.Map             `tfsdk:"projects"`
+}
+
+type firewallRulesetRule struct {
+	Name           types.String     `tfsdk:"name"`
+	Description    types.String     `tfsdk:"description"`
+	Active         types.Bool       `tfsdk:"active"`
+	ConditionGroup []ConditionGroup `tfsdk:"condition_group"`
+	Action         Mitigate         `tfsdk:"action"`
+}
+
+func (r firewallRulesetRule) firewallRule() FirewallRule {
+	return FirewallRule{
+		Name:            r.Name,
+		Description:     r.Description,
+		Active:          r.Active,
+		ConditionGroup:  r.ConditionGroup,
+		Action:          r.Action,
+		StagedRollout:   types.ObjectNull(firewallRuleStagedRolloutAttrTypes),
+		EffectiveAction: types.StringNull(),
+		StagedAt:        types.StringNull(),
+	}
+}
+
+var firewallRulesetProjectAttrTypes = map[string]attr.Type{
+	"rule_ids": types.MapType{ElemType: types.StringType},
+	"in_sync":  types.BoolType,
+}
+
+// firewallRulesetProject is the state of the ruleset in a single project: the
+// ID of the deployed copy of each rule, keyed by rule name, and whether those
+// rules still match the ruleset.
+type firewallRulesetProject struct {
+	RuleIDs map[string]string `tfsdk:"rule_ids"`
+	InSync  types.Bool        `tfsdk:"in_sync"`
+}
+
+func (m firewallRulesetModel) projectIDs(ctx context.Context) ([]string, diag.Diagnostics) {
+	var ids []string
+	diags := m.ProjectIDs.ElementsAs(ctx, &ids, false)
+	slices.Sort(ids)
+	return ids, diags
+}
+
+func (m firewallRulesetModel) projects(ctx context.Context) (map[string]firewallRulesetProject, diag.Diagnostics) {
+	projects := map[string]firewallRulesetProject{}
+	if m.Projects.IsNull() || m.Projects.IsUnknown() {
+		return projects, nil
+	}
+	diags := m.Projects.ElementsAs(ctx, &projects, false)
+	return projects, diags
+}
+
+func firewallRulesetProjectsValue(ctx context.Context, projects map[string]firewallRulesetProject) (types.Map, diag.Diagnostics) {
+	return types.MapValueFrom(ctx, types.ObjectType{AttrTypes: firewallRulesetProjectAttrTypes}, projects)
+}
+
+func (r *firewallRulesetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_firewall_ruleset"
+}
+
+func (r *firewallRulesetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *firewallRulesetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Firewall Ruleset resource.
+
+A ruleset is a list of named custom firewall rules that is kept in sync across many projects. Each project gets its own copy of every rule, added to the end of its rule list, and any other rules in the project are left untouched. Changes to the ruleset are applied to every project.
+
+If a copy of a rule is changed or removed outside Terraform, the project is reported as out of sync in ` + "`projects`" + `, the plan shows a warning for it, and the next apply restores the rule.
+
+~> Do not attach a ruleset to a project whose ` + "`vercel_firewall_config`" + ` also configures ` + "`rules`" + `. The firewall config manages the whole rule list and removes rules it does not know about.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the ruleset, made up of the team ID and the ruleset name.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the projects belong to. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"name": schema.StringAttribute{
+				Required:      true,
+				Description:   "The name of the ruleset.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The rules to deploy to every project. Rules new to a project are added after its existing firewall rules, and the order of this list does not change the order of the project's rules. Rule names must be unique within the ruleset.",
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							Description: "Name to identify the rule",
+							Required:    true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(4, 160),
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional: true,
+							Validators: []validator.String{
+								stringvalidator.LengthAtMost(260),
+							},
+						},
+						"active": schema.BoolAttribute{
+							Description: "Rule is active or disabled",
+							Optional:    true,
+						},
+						"action":          firewallRuleActionAttribute(),
+						"condition_group": firewallRuleConditionGroupAttribute(),
+					},
+				},
+			},
+			"project_ids": schema.SetAttribute{
+				Required:    true,
+				ElementType: types.StringType,
+				Description: "The IDs of the projects to deploy the ruleset to.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"projects": schema.MapNestedAttribute{
+				Computed:    true,
+				Description: "The state of the ruleset in each project, keyed by project ID.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"rule_ids": schema.MapAttribute{
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "The ID of the project's copy of each rule, keyed by rule name.",
+						},
+						"in_sync": schema.BoolAttribute{
+							Computed:    true,
+							Description: "Whether the project's copies of the rules match the ruleset. This is `false` when a rule was changed or removed outside Terraform.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *firewallRulesetResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config firewallRulesetModel
+	// Values that are not known yet cannot be read into the model; they are
+	// checked again once they are known.
+	if diags := req.Config.Get(ctx, &config); diags.HasError() {
+		return
+	}
+
+	names := map[string]struct{}{}
+	for i, rule := range config.Rules {
+		rulePath := path.Root("rules").AtListIndex(i)
+		if !rule.Name.IsUnknown() {
+			if _, ok := names[rule.Name.ValueString()]; ok {
+				resp.Diagnostics.AddAttributeError(
+					rulePath.AtName("name"),
+					"Duplicate firewall ruleset rule name",
+					fmt.Sprintf("The rule name %q is used more than once. Rule names must be unique within a ruleset.", rule.Name.ValueString()),
+				)
+			}
+			names[rule.Name.ValueString()] = struct{}{}
+		}
+		resp.Diagnostics.Append(validateFirewallRule(ctx, rulePath, rule.firewallRule())...)
+	}
+}
+
+// ModifyPlan reports projects whose rules drifted from the ruleset, and plans
+// to bring them back in sync. The prior rule IDs are kept only while the set of
+// rule names and projects stays the same.
+func (r *firewallRulesetResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state firewallRulesetModel
+	if diags := req.Plan.Get(ctx, &plan); diags.HasError() {
+		return
+	}
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projects, diags := state.projects(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	for _, projectID := range slices.Sorted(maps.Keys(projects)) {
+		if !projects[projectID].InSync.ValueBool() {
+			resp.Diagnostics.AddAttributeWarning(
+				path.Root("projects").AtMapKey(projectID),
+				"Firewall ruleset drift",
+				fmt.Sprintf("The rules of firewall ruleset %q were changed outside Terraform in project %s. They will be restored on the next apply.", state.Name.ValueString(), projectID),
+			)
+		}
+	}
+
+	if plan.Projects.IsUnknown() || plan.ProjectIDs.IsUnknown() {
+		return
+	}
+	projectIDs, diags := plan.projectIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	// Inserting a rule gives it a new ID, so the rule IDs are only known in
+	// advance when every project keeps exactly the rules it already has.
+	if !slices.Equal(projectIDs, slices.Sorted(maps.Keys(projects))) || !firewallRulesetKeepsRuleIDs(plan.Rules, projects) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("projects"), types.MapUnknown(types.ObjectType{AttrTypes: firewallRulesetProjectAttrTypes}))...)
+		return
+	}
+	for projectID, project := range projects {
+		project.InSync = types.BoolValue(true)
+		projects[projectID] = project
+	}
+	planned, diags := firewallRulesetProjectsValue(ctx, projects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("projects"), planned)...)
+}
+
+// firewallRulesetKeepsRuleIDs reports whether syncing the rules leaves the
+// rule IDs of every project unchanged: each project is in sync and already
+// has a rule for every planned name, and no other.
+func firewallRulesetKeepsRuleIDs(rules []firewallRulesetRule, projects map[string]firewallRulesetProject) bool {
+	names := make([]string, 0, len(rules))
+	for _, rule := range rules {
+		if rule.Name.IsUnknown() {
+			return false
+		}
+		names = append(names, rule.Name.ValueString())
+	}
+	slices.Sort(names)
+	for _, project := range projects {
+		if !project.InSync.ValueBool() || !slices.Equal(names, slices.Sorted(maps.Keys(project.RuleIDs))) {
+			return false
+		}
+	}
+	return true
+}
+
+type firewallRulesetUpdate struct {
+	id   string
+	rule client.FirewallRule
+}
+
+// firewallRulesetSync describes the changes needed to bring a project's rules
+// in line with a ruleset.
+type firewallRulesetSync struct {
+	removals []string
+	updates  []firewallRulesetUpdate
+	inserts  []client.FirewallRule
+	// ruleIDs holds the IDs of the rules that are kept, keyed by rule name.
+	ruleIDs map[string]string
+}
+
+func (s firewallRulesetSync) inSync() bool {
+	return len(s.removals) == 0 && len(s.updates) == 0 && len(s.inserts) == 0
+}
+
+// firewallRulesetRuleMatches reports whether a deployed rule still matches the
+// rule in the ruleset. The deployed rule is read the same way as for
+// vercel_firewall_rule, so that values the API fills in do not count as drift.
+func firewallRulesetRuleMatches(deployed client.FirewallRule, desired FirewallRule) (bool, error) {
+	read, err := fromFirewallRule(deployed, desired)
+	if err != nil {
+		return false, err
+	}
+	rules, err := firewallRulesToClient(&FirewallRules{Rules: []FirewallRule{read, desired}})
+	if err != nil {
+		return false, err
+	}
+	got, err := firewallRuleFingerprint(rules[0])
+	if err != nil {
+		return false, err
+	}
+	want, err := firewallRuleFingerprint(rules[1])
+	if err != nil {
+		return false, err
+	}
+	return got == want, nil
+}
+
+// planFirewallRulesetSync compares the rules deployed in a project with the
+// ruleset. ruleIDs holds the IDs of the rules the ruleset deployed before.
+func planFirewallRulesetSync(current []client.FirewallRule, rules []firewallRulesetRule, ruleIDs map[string]string) (firewallRulesetSync, error) {
+	sync := firewallRulesetSync{ruleIDs: map[string]string{}}
+
+	desired := map[string]struct{}{}
+	for _, rule := range rules {
+		desired[rule.Name.ValueString()] = struct{}{}
+	}
+	for _, name := range slices.Sorted(maps.Keys(ruleIDs)) {
+		if _, ok := desired[name]; !ok && firewallRuleIndex(current, ruleIDs[name]) != -1 {
+			sync.removals = append(sync.removals, ruleIDs[name])
+		}
+	}
+
+	for _, rule := range rules {
+		name := rule.Name.ValueString()
+		converted, err := firewallRulesToClient(&FirewallRules{Rules: []FirewallRule{rule.firewallRule()}})
+		if err != nil {
+			return sync, err
+		}
+		clientRule := converted[0]
+
+		id, ok := ruleIDs[name]
+		i := -1
+		if ok {
+			i = firewallRuleIndex(current, id)
+		}
+		if i == -1 {
+			sync.inserts = append(sync.inserts, clientRule)
+			continue
+		}
+
+		sync.ruleIDs[name] = id
+		matches, err := firewallRulesetRuleMatches(current[i], rule.firewallRule())
+		if err != nil {
+			return sync, err
+		}
+		if !matches {
+			sync.updates = append(sync.updates, firewallRulesetUpdate{id: id, rule: clientRule})
+		}
+	}
+	return sync, nil
+}
+
+func (r *firewallRulesetResource) getFirewallConfig(ctx context.Context, projectID, teamID string) (client.FirewallConfig, error) {
+	conf, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if client.NotFound(err) {
+		return client.FirewallConfig{ProjectID: projectID, TeamID: teamID}, nil
+	}
+	return conf, err
+}
+
+// syncProject deploys the ruleset to a project and returns the IDs of the
+// project's copies of the rules.
+func (r *firewallRulesetResource) syncProject(ctx context.Context, teamID, projectID string, rules []firewallRulesetRule, ruleIDs map[string]string) (map[string]string, error) {
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, projectID))
+	defer unlock()
+
+	before, err := r.getFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		return ruleIDs, err
+	}
+	sync, err := planFirewallRulesetSync(before.Rules, rules, ruleIDs)
+	if err != nil {
+		return ruleIDs, err
+	}
+
+	for _, id := range sync.removals {
+		err = r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+			ProjectID: projectID,
+			TeamID:    teamID,
+			Action:    "rules.remove",
+			ID:        id,
+			Value:     nil,
+		})
+		if err != nil && !client.NotFound(err) {
+			return ruleIDs, fmt.Errorf("could not remove rule %s: %w", id, err)
+		}
+	}
+	for _, update := range sync.updates {
+		err = r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+			ProjectID: projectID,
+			TeamID:    teamID,
+			Action:    "rules.update",
+			ID:        update.id,
+			Value:     update.rule,
+		})
+		if err != nil {
+			return ruleIDs, fmt.Errorf("could not update rule %q: %w", update.rule.Name, err)
+		}
+	}
+	if len(sync.inserts) == 0 {
+		return sync.ruleIDs, nil
+	}
+
+	for _, rule := range sync.inserts {
+		err = r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+			ProjectID: projectID,
+			TeamID:    teamID,
+			Action:    "rules.insert",
+			ID:        nil,
+			Value:     rule,
+		})
+		if err != nil {
+			return sync.ruleIDs, fmt.Errorf("could not insert rule %q: %w", rule.Name, err)
+		}
+	}
+	after, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if err != nil {
+		return sync.ruleIDs, err
+	}
+	for _, rule := range sync.inserts {
+		var named []client.FirewallRule
+		for _, candidate := range after.Rules {
+			if candidate.Name == rule.Name {
+				named = append(named, candidate)
+			}
+		}
+		inserted, err := findInsertedFirewallRule(before.Rules, named, rule)
+		if err != nil {
+			return sync.ruleIDs, err
+		}
+		sync.ruleIDs[rule.Name] = inserted.ID
+	}
+	return sync.ruleIDs, nil
+}
+
+// removeProject removes the ruleset's rules from a project.
+func (r *firewallRulesetResource) removeProject(ctx context.Context, teamID, projectID string, ruleIDs map[string]string) error {
+	unlock := firewallConfigLocks.Lock(firewallConfigLockKey(teamID, projectID))
+	defer unlock()
+
+	conf, err := r.client.GetFirewallConfig(ctx, projectID, teamID)
+	if client.NotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	for _, name := range slices.Sorted(maps.Keys(ruleIDs)) {
+		id := ruleIDs[name]
+		if firewallRuleIndex(conf.Rules, id) == -1 {
+			continue
+		}
+		err = r.client.UpdateFirewallConfig(ctx, client.UpdateFirewallConfigRequest{
+			ProjectID: projectID,
+			TeamID:    teamID,
+			Action:    "rules.remove",
+			ID:        id,
+			Value:     nil,
+		})
+		if err != nil && !client.NotFound(err) {
+			return fmt.Errorf("could not remove rule %q: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// apply brings every project in the plan in line with the ruleset and removes
+// the ruleset from projects that are no longer attached. plan.Projects is set
+// to the projects that were synced, even if a later project fails, so that the
+// state still records the rules that were deployed.
+func (r *firewallRulesetResource) apply(ctx context.Context, plan *firewallRulesetModel, existing map[string]firewallRulesetProject) diag.Diagnostics {
+	projectIDs, diags := plan.projectIDs(ctx)
+	if diags.HasError() {
+		return diags
+	}
+	teamID := plan.TeamID.ValueString()
+
+	synced := map[string]firewallRulesetProject{}
+	maps.Copy(synced, existing)
+	var err error
+	for _, projectID := range slices.Sorted(maps.Keys(existing)) {
+		if slices.Contains(projectIDs, projectID) {
+			continue
+		}
+		if err = r.removeProject(ctx, teamID, projectID, existing[projectID].RuleIDs); err != nil {
+			diags.AddError(
+				"Error removing Firewall Ruleset",
+				fmt.Sprintf("Could not remove Firewall Ruleset %s from project %s, unexpected error: %s", plan.Name.ValueString(), projectID, err),
+			)
+			break
+		}
+		delete(synced, projectID)
+	}
+	if err == nil {
+		for _, projectID := range projectIDs {
+			ruleIDs, err := r.syncProject(ctx, teamID, projectID, plan.Rules, existing[projectID].RuleIDs)
+			if ruleIDs == nil {
+				ruleIDs = map[string]string{}
+			}
+			synced[projectID] = firewallRulesetProject{RuleIDs: ruleIDs, InSync: types.BoolValue(err == nil)}
+			if err != nil {
+				diags.AddError(
+					"Error applying Firewall Ruleset",
+					fmt.Sprintf("Could not apply Firewall Ruleset %s to project %s, unexpected error: %s", plan.Name.ValueString(), projectID, err),
+				)
+				break
+			}
+		}
+	}
+
+	projects, projectDiags := firewallRulesetProjectsValue(ctx, synced)
+	diags.Append(projectDiags...)
+	plan.Projects = projects
+	return diags
+}
+
+func (r *firewallRulesetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan firewallRulesetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.TeamID = types.StringValue(r.client.TeamID(plan.TeamID.ValueString()))
+	plan.ID = types.StringValue(plan.TeamID.ValueString() + "/" + plan.Name.ValueString())
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, map[string]firewallRulesetProject{})...)
+
+	tflog.Info(ctx, "created firewall ruleset", map[string]any{
+		"team_id": plan.TeamID.ValueString(),
+		"name":    plan.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallRulesetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state firewallRulesetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projects, diags := state.projects(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for projectID, project := range projects {
+		conf, err := r.getFirewallConfig(ctx, projectID, state.TeamID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading Firewall Ruleset",
+				fmt.Sprintf("Could not read Firewall Config %s %s, unexpected error: %s", state.TeamID.ValueString(), projectID, err),
+			)
+			return
+		}
+		sync, err := planFirewallRulesetSync(conf.Rules, state.Rules, project.RuleIDs)
+		if err != nil {
+			resp.Diagnostics.AddError("Error reading Firewall Ruleset", "Could not compare Firewall Ruleset rules, unexpected error: "+err.Error())
+			return
+		}
+		project.InSync = types.BoolValue(sync.inSync())
+		projects[projectID] = project
+		if !sync.inSync() {
+			tflog.Info(ctx, "firewall ruleset drifted", map[string]any{
+				"project_id": projectID,
+				"team_id":    state.TeamID.ValueString(),
+				"name":       state.Name.ValueString(),
+			})
+		}
+	}
+
+	state.Projects, diags = firewallRulesetProjectsValue(ctx, projects)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	diags = resp.State.Set(ctx, state)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallRulesetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state firewallRulesetModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	existing, diags := state.projects(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.apply(ctx, &plan, existing)...)
+
+	tflog.Info(ctx, "updated firewall ruleset", map[string]any{
+		"team_id": plan.TeamID.ValueString(),
+		"name":    plan.Name.ValueString(),
+	})
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *firewallRulesetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state firewallRulesetModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	projects, diags := state.projects(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, projectID := range slices.Sorted(maps.Keys(projects)) {
+		err := r.removeProject(ctx, state.TeamID.ValueString(), projectID, projects[projectID].RuleIDs)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting Firewall Ruleset",
+				fmt.Sprintf("Could not remove Firewall Ruleset %s from project %s, unexpected error: %s", state.Name.ValueString(), projectID, err),
+			)
+			return
+		}
+	}
+
+	tflog.Info(ctx, "deleted firewall ruleset", map[string]any{
+		"team_id": state.TeamID.ValueString(),
+		"name":    state.Name.ValueString(),
+	})
+}
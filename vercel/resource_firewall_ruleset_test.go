@@ -0,0 +1,137 @@
+package vercel_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAcc_FirewallRulesetResource(t *testing.T) {
+	name := strings.ToLower(acctest.RandString(16))
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccFirewallRulesetResourceConfig(name, "deny", `[vercel_project.a.id, vercel_project.b.id]`, "")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_ruleset.test", "projects.%", "2"),
+					testCheckFirewallRulesetInSync("vercel_firewall_ruleset.test"),
+					resource.TestCheckResourceAttrSet("vercel_firewall_ruleset.test", "id"),
+				),
+			},
+			{
+				Config: cfg(testAccFirewallRulesetResourceConfig(name, "deny", `[vercel_project.a.id, vercel_project.b.id]`, testAccFirewallRulesetExtraRule)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_ruleset.test", "projects.%", "2"),
+					resource.TestCheckResourceAttr("vercel_firewall_ruleset.test", "rules.#", "3"),
+					resource.TestCheckResourceAttrPair("vercel_firewall_ruleset.test", "projects.%", "vercel_firewall_ruleset.test", "project_ids.#"),
+					testCheckFirewallRulesetRuleIDs("vercel_firewall_ruleset.test", 3),
+					testCheckFirewallRulesetInSync("vercel_firewall_ruleset.test"),
+				),
+			},
+			{
+				Config: cfg(testAccFirewallRulesetResourceConfig(name, "challenge", `[vercel_project.b.id]`, "")),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("vercel_firewall_ruleset.test", "projects.%", "1"),
+					resource.TestCheckResourceAttr("vercel_firewall_ruleset.test", "rules.0.action.action", "challenge"),
+					testCheckFirewallRulesetInSync("vercel_firewall_ruleset.test"),
+				),
+			},
+		},
+	})
+}
+
+func testCheckFirewallRulesetInSync(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "projects.") && strings.HasSuffix(key, ".in_sync") && value != "true" {
+				return fmt.Errorf("%s is %s, want true", key, value)
+			}
+		}
+		return nil
+	}
+}
+
+func testCheckFirewallRulesetRuleIDs(n string, want int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+		for key, value := range rs.Primary.Attributes {
+			if strings.HasPrefix(key, "projects.") && strings.HasSuffix(key, ".rule_ids.%") && value != fmt.Sprint(want) {
+				return fmt.Errorf("%s is %s, want %d", key, value, want)
+			}
+		}
+		return nil
+	}
+}
+
+const testAccFirewallRulesetExtraRule = `
+    {
+      name = "block wp-admin"
+      action = {
+        action = "deny"
+      }
+      condition_group = [{
+        conditions = [{
+          type  = "path"
+          op    = "pre"
+          value = "/wp-admin"
+        }]
+      }]
+    },`
+
+func testAccFirewallRulesetResourceConfig(name, action, projectIDs, extraRules string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "a" {
+  name = "test-acc-%[1]s-ruleset-a"
+}
+
+resource "vercel_project" "b" {
+  name = "test-acc-%[1]s-ruleset-b"
+}
+
+resource "vercel_firewall_ruleset" "test" {
+  name        = "test-acc-%[1]s"
+  project_ids = %[3]s
+  rules = [
+    {
+      name = "block admin"
+      action = {
+        action = "%[2]s"
+      }
+      condition_group = [{
+        conditions = [{
+          type  = "path"
+          op    = "pre"
+          value = "/admin"
+        }]
+      }]
+    },
+    {
+      name = "block bad bots"
+      action = {
+        action = "deny"
+      }
+      condition_group = [{
+        conditions = [{
+          type   = "user_agent"
+          op     = "inc"
+          values = ["badbot", "worsebot"]
+        }]
+      }]
+    },%[4]s
+  ]
+}
+`, name, action, projectIDs, extraRules)
+}
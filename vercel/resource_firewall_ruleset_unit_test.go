@@ -0,0 +1,256 @@
+package vercel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func firewallTestRulesetRule(name, value string) firewallRulesetRule {
+	return firewallRulesetRule{
+		Name:           types.StringValue(name),
+		Description:    types.StringNull(),
+		Active:         types.BoolNull(),
+		ConditionGroup: []ConditionGroup{{Conditions: []Condition{firewallTestCondition("path", "pre", "", value)}}},
+		Action:         firewallTestAction("deny"),
+	}
+}
+
+func firewallTestDeployedRule(t *testing.T, id string, rule firewallRulesetRule) client.FirewallRule {
+	t.Helper()
+	converted, err := firewallRulesToClient(&FirewallRules{Rules: []FirewallRule{rule.firewallRule()}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	converted[0].ID = id
+	return converted[0]
+}
+
+func TestPlanFirewallRulesetSync(t *testing.T) {
+	admin := firewallTestRulesetRule("block admin", "/admin")
+	internal := firewallTestRulesetRule("block internal", "/internal")
+	ruleIDs := map[string]string{"block admin": "rule_a", "block internal": "rule_b"}
+	other := client.FirewallRule{ID: "rule_other", Name: "someone else's rule"}
+
+	// Everything deployed and unchanged.
+	current := []client.FirewallRule{
+		firewallTestDeployedRule(t, "rule_a", admin),
+		other,
+		firewallTestDeployedRule(t, "rule_b", internal),
+	}
+	sync, err := planFirewallRulesetSync(current, []firewallRulesetRule{admin, internal}, ruleIDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !sync.inSync() {
+		t.Errorf("expected the project to be in sync, got %+v", sync)
+	}
+
+	// One rule was edited and the other deleted outside Terraform.
+	edited := firewallTestDeployedRule(t, "rule_a", firewallTestRulesetRule("block admin", "/wp-admin"))
+	sync, err = planFirewallRulesetSync([]client.FirewallRule{edited, other}, []firewallRulesetRule{admin, internal}, ruleIDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if sync.inSync() {
+		t.Fatal("expected drift to be detected")
+	}
+	if len(sync.updates) != 1 || sync.updates[0].id != "rule_a" {
+		t.Errorf("updates = %+v, want rule_a", sync.updates)
+	}
+	if len(sync.inserts) != 1 || sync.inserts[0].Name != "block internal" || sync.inserts[0].ID != "" {
+		t.Errorf("inserts = %+v, want block internal", sync.inserts)
+	}
+	if len(sync.removals) != 0 {
+		t.Errorf("removals = %v, want none", sync.removals)
+	}
+	if len(sync.ruleIDs) != 1 || sync.ruleIDs["block admin"] != "rule_a" {
+		t.Errorf("ruleIDs = %v, want only block admin", sync.ruleIDs)
+	}
+
+	// A rule removed from the ruleset is removed from the project; other
+	// rules are never touched.
+	sync, err = planFirewallRulesetSync(current, []firewallRulesetRule{admin}, ruleIDs)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sync.removals) != 1 || sync.removals[0] != "rule_b" || len(sync.updates) != 0 || len(sync.inserts) != 0 {
+		t.Errorf("sync = %+v, want only the removal of rule_b", sync)
+	}
+
+	// A new project gets every rule.
+	sync, err = planFirewallRulesetSync([]client.FirewallRule{other}, []firewallRulesetRule{admin, internal}, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(sync.inserts) != 2 || len(sync.removals) != 0 || len(sync.updates) != 0 {
+		t.Errorf("sync = %+v, want two inserts", sync)
+	}
+}
+
+func TestFirewallRulesetRuleMatchesIgnoresAPIDefaults(t *testing.T) {
+	rule := firewallTestRulesetRule("block admin", "/admin")
+	deployed := firewallTestDeployedRule(t, "rule_a", rule)
+	// The API reports the rule as active even though the config leaves it unset.
+	deployed.Active = true
+
+	matches, err := firewallRulesetRuleMatches(deployed, rule.firewallRule())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !matches {
+		t.Error("expected the deployed rule to match")
+	}
+
+	deployed.Active = false
+	matches, err = firewallRulesetRuleMatches(deployed, rule.firewallRule())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if matches {
+		t.Error("expected a disabled rule to be reported as drift")
+	}
+}
+
+func TestFirewallRulesetModifyPlanReportsDriftPerProject(t *testing.T) {
+	ctx := context.Background()
+	res := &firewallRulesetResource{}
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	projects, diags := firewallRulesetProjectsValue(ctx, map[string]firewallRulesetProject{
+		"prj_a": {RuleIDs: map[string]string{"block admin": "rule_a"}, InSync: types.BoolValue(true)},
+		"prj_b": {RuleIDs: map[string]string{"block admin": "rule_b"}, InSync: types.BoolValue(false)},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	state := firewallRulesetModel{
+		ID:         types.StringValue("team_123/shared"),
+		TeamID:     types.StringValue("team_123"),
+		Name:       types.StringValue("shared"),
+		Rules:      []firewallRulesetRule{firewallTestRulesetRule("block admin", "/admin")},
+		ProjectIDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("prj_a"), types.StringValue("prj_b")}),
+		Projects:   projects,
+	}
+
+	stateValue := tfsdk.State{Schema: schemaResp.Schema}
+	if diags := stateValue.Set(ctx, state); diags.HasError() {
+		t.Fatalf("stateValue.Set() returned diagnostics: %v", diags)
+	}
+	planValue := tfsdk.Plan{Schema: schemaResp.Schema, Raw: stateValue.Raw}
+	resp := &resource.ModifyPlanResponse{Plan: planValue}
+	res.ModifyPlan(ctx, resource.ModifyPlanRequest{State: stateValue, Plan: planValue}, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("ModifyPlan() returned diagnostics: %v", resp.Diagnostics)
+	}
+	warnings := resp.Diagnostics.Warnings()
+	if len(warnings) != 1 {
+		t.Fatalf("got %d warnings, want 1: %v", len(warnings), warnings)
+	}
+	if got := warnings[0].(diag.DiagnosticWithPath).Path().String(); got != `projects["prj_b"]` {
+		t.Errorf("warning path = %s, want projects[\"prj_b\"]", got)
+	}
+
+	var planned firewallRulesetModel
+	if diags := resp.Plan.Get(ctx, &planned); diags.HasError() {
+		t.Fatalf("resp.Plan.Get() returned diagnostics: %v", diags)
+	}
+	if !planned.Projects.IsUnknown() {
+		t.Errorf("projects should be unknown while prj_b is out of sync, got %s", planned.Projects)
+	}
+}
+
+func TestFirewallRulesetModifyPlanKeepsRuleIDs(t *testing.T) {
+	ctx := context.Background()
+	res := &firewallRulesetResource{}
+	schemaResp := &resource.SchemaResponse{}
+	res.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	projects, diags := firewallRulesetProjectsValue(ctx, map[string]firewallRulesetProject{
+		"prj_a": {RuleIDs: map[string]string{"block admin": "rule_a1", "block bots": "rule_a2"}, InSync: types.BoolValue(true)},
+		"prj_b": {RuleIDs: map[string]string{"block admin": "rule_b1", "block bots": "rule_b2"}, InSync: types.BoolValue(true)},
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	state := firewallRulesetModel{
+		ID:     types.StringValue("team_123/shared"),
+		TeamID: types.StringValue("team_123"),
+		Name:   types.StringValue("shared"),
+		Rules: []firewallRulesetRule{
+			firewallTestRulesetRule("block admin", "/admin"),
+			firewallTestRulesetRule("block bots", "/bots"),
+		},
+		ProjectIDs: types.SetValueMust(types.StringType, []attr.Value{types.StringValue("prj_a"), types.StringValue("prj_b")}),
+		Projects:   projects,
+	}
+
+	for name, tt := range map[string]struct {
+		rules       []firewallRulesetRule
+		wantUnknown bool
+	}{
+		"rule changed": {
+			rules: []firewallRulesetRule{
+				firewallTestRulesetRule("block bots", "/robots"),
+				firewallTestRulesetRule("block admin", "/admin"),
+			},
+		},
+		"rule added": {
+			rules: []firewallRulesetRule{
+				firewallTestRulesetRule("block admin", "/admin"),
+				firewallTestRulesetRule("block bots", "/bots"),
+				firewallTestRulesetRule("block api", "/api"),
+			},
+			wantUnknown: true,
+		},
+		"rule removed": {
+			rules:       []firewallRulesetRule{firewallTestRulesetRule("block admin", "/admin")},
+			wantUnknown: true,
+		},
+		"rule renamed": {
+			rules: []firewallRulesetRule{
+				firewallTestRulesetRule("block admin", "/admin"),
+				firewallTestRulesetRule("block robots", "/bots"),
+			},
+			wantUnknown: true,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			stateValue := tfsdk.State{Schema: schemaResp.Schema}
+			if diags := stateValue.Set(ctx, state); diags.HasError() {
+				t.Fatalf("stateValue.Set() returned diagnostics: %v", diags)
+			}
+			planModel := state
+			planModel.Rules = tt.rules
+			planValue := tfsdk.Plan{Schema: schemaResp.Schema}
+			if diags := planValue.Set(ctx, planModel); diags.HasError() {
+				t.Fatalf("planValue.Set() returned diagnostics: %v", diags)
+			}
+			resp := &resource.ModifyPlanResponse{Plan: planValue}
+			res.ModifyPlan(ctx, resource.ModifyPlanRequest{State: stateValue, Plan: planValue}, resp)
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("ModifyPlan() returned diagnostics: %v", resp.Diagnostics)
+			}
+
+			var planned firewallRulesetModel
+			if diags := resp.Plan.Get(ctx, &planned); diags.HasError() {
+				t.Fatalf("resp.Plan.Get() returned diagnostics: %v", diags)
+			}
+			if got := planned.Projects.IsUnknown(); got != tt.wantUnknown {
+				t.Fatalf("projects unknown = %t, want %t", got, tt.wantUnknown)
+			}
+			if !tt.wantUnknown && !planned.Projects.Equal(projects) {
+				t.Errorf("projects = %s, want %s", planned.Projects, projects)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// TeamResourceIdentity is the identity Terraform stores for resources that are
+// addressed by a single ID within a team.
+type TeamResourceIdentity struct {
+	TeamID types.String `tfsdk:"team_id"`
+	ID     types.String `tfsdk:"id"`
+}
+
+func teamResourceIdentitySchema(idDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"team_id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The ID of the team the resource belongs to. If omitted, the team configured on the provider is used.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       idDescription,
+			},
+		},
+	}
+}
+
+// ProjectResourceIdentity is the identity Terraform stores for resources that are
+// addressed by an ID within a project.
+type ProjectResourceIdentity struct {
+	TeamID    types.String `tfsdk:"team_id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	ID        types.String `tfsdk:"id"`
+}
+
+func projectResourceIdentitySchema(idDescription string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"team_id": identityschema.StringAttribute{
+				OptionalForImport: true,
+				Description:       "The ID of the team the project belongs to. If omitted, the team configured on the provider is used.",
+			},
+			"project_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "The ID of the project the resource belongs to.",
+			},
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       idDescription,
+			},
+		},
+	}
+}
+
+// setIdentity stores the identity of a resource, if the operation supports one.
+func setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, value any) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+	return identity.Set(ctx, value)
+}
+
+// importIDFromRequest returns the ID an import was requested with. When the import
+// uses an `identity` block rather than an ID, an equivalent ID is built by joining the
+// given identity attributes with `/`, skipping an unset team_id, so that both styles
+// of import share the same parsing logic.
+func importIDFromRequest(ctx context.Context, req resource.ImportStateRequest, attributes ...string) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if req.ID != "" {
+		return req.ID, diags
+	}
+	if req.Identity == nil || req.Identity.Raw.IsNull() {
+		diags.AddError(
+			"Missing import identifier",
+			"Either an import ID or an identity must be specified when importing this resource.",
+		)
+		return "", diags
+	}
+
+	var parts []string
+	for _, attribute := range attributes {
+		var value types.String
+		diags.Append(req.Identity.GetAttribute(ctx, path.Root(attribute), &value)...)
+		if diags.HasError() {
+			return "", diags
+		}
+		if value.ValueString() == "" {
+			if attribute == "team_id" {
+				continue
+			}
+			diags.AddAttributeError(
+				path.Root(attribute),
+				"Invalid import identity",
+				fmt.Sprintf("The identity attribute %q must be set when importing this resource.", attribute),
+			)
+			return "", diags
+		}
+		parts = append(parts, value.ValueString())
+	}
+	return strings.Join(parts, "/"), diags
+}
@@ -0,0 +1,121 @@
+package vercel
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func projectIdentity(t *testing.T, teamID, projectID, id any) *tfsdk.ResourceIdentity {
+	t.Helper()
+
+	ctx := context.Background()
+	identitySchema := projectResourceIdentitySchema("")
+	return &tfsdk.ResourceIdentity{
+		Schema: identitySchema,
+		Raw: tftypes.NewValue(identitySchema.Type().TerraformType(ctx), map[string]tftypes.Value{
+			"team_id":    tftypes.NewValue(tftypes.String, teamID),
+			"project_id": tftypes.NewValue(tftypes.String, projectID),
+			"id":         tftypes.NewValue(tftypes.String, id),
+		}),
+	}
+}
+
+func TestImportIDFromRequestPrefersID(t *testing.T) {
+	got, diags := importIDFromRequest(context.Background(), resource.ImportStateRequest{
+		ID:       "team_123/prj_123/env_123",
+		Identity: projectIdentity(t, "team_456", "prj_456", "env_456"),
+	}, "team_id", "project_id", "id")
+	if diags.HasError() {
+		t.Fatalf("importIDFromRequest() returned diagnostics: %v", diags)
+	}
+	if want := "team_123/prj_123/env_123"; got != want {
+		t.Fatalf("importIDFromRequest() = %q, want %q", got, want)
+	}
+}
+
+func TestImportIDFromRequestBuildsIDFromIdentity(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity *tfsdk.ResourceIdentity
+		want     string
+	}{
+		{
+			name:     "with team",
+			identity: projectIdentity(t, "team_123", "prj_123", "env_123"),
+			want:     "team_123/prj_123/env_123",
+		},
+		{
+			name:     "without team",
+			identity: projectIdentity(t, nil, "prj_123", "env_123"),
+			want:     "prj_123/env_123",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, diags := importIDFromRequest(context.Background(), resource.ImportStateRequest{
+				Identity: tt.identity,
+			}, "team_id", "project_id", "id")
+			if diags.HasError() {
+				t.Fatalf("importIDFromRequest() returned diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Fatalf("importIDFromRequest() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestImportIDFromRequestRequiresIdentityAttributes(t *testing.T) {
+	_, diags := importIDFromRequest(context.Background(), resource.ImportStateRequest{
+		Identity: projectIdentity(t, "team_123", nil, "env_123"),
+	}, "team_id", "project_id", "id")
+	if !diags.HasError() {
+		t.Fatal("importIDFromRequest() returned no diagnostics for a missing project_id")
+	}
+
+	_, diags = importIDFromRequest(context.Background(), resource.ImportStateRequest{}, "team_id", "id")
+	if !diags.HasError() {
+		t.Fatal("importIDFromRequest() returned no diagnostics without an ID or identity")
+	}
+}
+
+func TestProviderListResourcesMatchIdentityResources(t *testing.T) {
+	server, err := providerserver.NewProtocol6WithError(New())()
+	if err != nil {
+		t.Fatalf("providerserver.NewProtocol6WithError() error = %v", err)
+	}
+
+	resp, err := server.GetProviderSchema(context.Background(), &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("GetProviderSchema() error = %v", err)
+	}
+	for _, d := range resp.Diagnostics {
+		if d.Severity == tfprotov6.DiagnosticSeverityError {
+			t.Fatalf("GetProviderSchema() returned error diagnostic: %s: %s", d.Summary, d.Detail)
+		}
+	}
+
+	identities, err := server.GetResourceIdentitySchemas(context.Background(), &tfprotov6.GetResourceIdentitySchemasRequest{})
+	if err != nil {
+		t.Fatalf("GetResourceIdentitySchemas() error = %v", err)
+	}
+
+	for name := range resp.ListResourceSchemas {
+		if _, ok := resp.ResourceSchemas[name]; !ok {
+			t.Errorf("list resource %q has no matching managed resource", name)
+		}
+		if _, ok := identities.IdentitySchemas[name]; !ok {
+			t.Errorf("list resource %q has no resource identity schema", name)
+		}
+	}
+	if len(resp.ListResourceSchemas) == 0 {
+		t.Fatal("GetProviderSchema() returned no list resource schemas")
+	}
+}
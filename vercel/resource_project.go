@@ -36,6 +36,7 @@ var (
 	_ resource.ResourceWithImportState      = &projectResource{}
 	_ resource.ResourceWithModifyPlan       = &projectResource{}
 	_ resource.ResourceWithConfigValidators = &projectResource{}
+	_ resource.ResourceWithIdentity         = &projectResource{}
 )
 
 func newProjectResource() resource.Resource {
@@ -766,6 +767,11 @@ func (r *projectResource) ConfigValidators(ctx context.Context) []resource.Confi
 	}
 }
 
+// IdentitySchema returns the identity schema for a project resource.
+func (r *projectResource) IdentitySchema(_ context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = teamResourceIdentitySchema("The ID of the project.")
+}
+
 // Project reflects the state terraform stores internally for a project.
 type Project struct {
 	BuildCommand                      types.String `tfsdk:"build_command"`
@@ -827,6 +833,13 @@ func (g *GitComments) toUpdateProjectRequest() *client.GitComments {
 	}
 }
 
+func (p Project) identity() TeamResourceIdentity {
+	return TeamResourceIdentity{
+		TeamID: p.TeamID,
+		ID:     p.ID,
+	}
+}
+
 func (p Project) RequiresUpdateAfterCreation() bool {
 	return (!p.PasswordProtection.IsNull() && !p.PasswordProtection.IsUnknown()) ||
 		(!p.TrustedIps.IsNull() && !p.TrustedIps.IsUnknown()) ||
@@ -2319,6 +2332,16 @@ func (r *projectResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	if !req.State.Raw.IsNull() {
+		var state Project
+		diags = req.State.Get(ctx, &state)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		resp.Diagnostics.Append(warnOnUnmanagedDeployHooks(ctx, plan, state)...)
+	}
+
 	environment, err := plan.environment(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -2470,6 +2493,8 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 
 	// Deploy hooks
 	planGit, dgr := plan.gitRepository(ctx)
@@ -2592,6 +2617,8 @@ func (r *projectResource) Create(ctx context.Context, req resource.CreateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read will read a project from the vercel API and provide terraform with information about it.
@@ -2647,6 +2674,8 @@ func (r *projectResource) Read(ctx context.Context, req resource.ReadRequest, re
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // containsEnvVar is a helper function for working out whether a specific environment variable
@@ -2680,6 +2709,51 @@ func diffEnvVars(oldVars, newVars []EnvironmentItem) (toCreate, toRemove []Envir
 	return toCreate, toRemove
 }
 
+// warnOnUnmanagedDeployHooks warns when `git_repository.deploy_hooks` is configured but the project
+// has deploy hooks that are not part of that configuration. Inline deploy hooks are authoritative, so
+// any other hooks - for instance ones managed by a `vercel_deploy_hook` resource - will be removed.
+func warnOnUnmanagedDeployHooks(ctx context.Context, plan, state Project) diag.Diagnostics {
+	planGit, diags := plan.gitRepository(ctx)
+	if diags.HasError() || planGit == nil || planGit.DeployHooks.IsNull() || planGit.DeployHooks.IsUnknown() {
+		return diags
+	}
+	stateGit, diags := state.gitRepository(ctx)
+	if diags.HasError() || stateGit == nil || stateGit.DeployHooks.IsNull() {
+		return diags
+	}
+
+	var planned, existing []DeployHook
+	diags.Append(planGit.DeployHooks.ElementsAs(ctx, &planned, false)...)
+	diags.Append(stateGit.DeployHooks.ElementsAs(ctx, &existing, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, h := range existing {
+		configured := false
+		for _, p := range planned {
+			if p.Name.Equal(h.Name) && p.Ref.Equal(h.Ref) {
+				configured = true
+				break
+			}
+		}
+		if configured {
+			continue
+		}
+		diags.AddAttributeWarning(
+			path.Root("git_repository").AtName("deploy_hooks"),
+			"Deploy hook will be removed",
+			fmt.Sprintf(
+				"The project has a deploy hook %q (ref %q) that is not configured in `git_repository.deploy_hooks`, so it will be deleted. "+
+					"If this hook is managed by a `vercel_deploy_hook` resource, remove `deploy_hooks` from the project and manage every hook with `vercel_deploy_hook` instead.",
+				h.Name.ValueString(),
+				h.Ref.ValueString(),
+			),
+		)
+	}
+	return diags
+}
+
 func containsDeployHook(hooks []DeployHook, h DeployHook) bool {
 	for _, hook := range hooks {
 		if hook.ID == h.ID {
@@ -3007,6 +3081,8 @@ func (r *projectResource) Update(ctx context.Context, req resource.UpdateRequest
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete a project and any associated environment variables from within terraform.
@@ -3045,11 +3121,17 @@ func (r *projectResource) Delete(ctx context.Context, req resource.DeleteRequest
 // ImportState takes an identifier and reads all the project information from the Vercel API.
 // Note that environment variables are also read. The results are then stored in terraform state.
 func (r *projectResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	teamID, projectID, ok := splitInto1Or2(req.ID)
+	importID, diags := importIDFromRequest(ctx, req, "team_id", "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, projectID, ok := splitInto1Or2(importID)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Error importing project",
-			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id\" or \"project_id\"", req.ID),
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id\" or \"project_id\"", importID),
 		)
 		return
 	}
@@ -3088,9 +3170,11 @@ func (r *projectResource) ImportState(ctx context.Context, req resource.ImportSt
 		"project_id": result.ID.ValueString(),
 	})
 
-	diags := resp.State.Set(ctx, result)
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
@@ -26,8 +26,10 @@ const (
 )
 
 var (
-	_ resource.Resource              = &projectDomainResource{}
-	_ resource.ResourceWithConfigure = &projectDomainResource{}
+	_ resource.Resource                = &projectDomainResource{}
+	_ resource.ResourceWithConfigure   = &projectDomainResource{}
+	_ resource.ResourceWithImportState = &projectDomainResource{}
+	_ resource.ResourceWithIdentity    = &projectDomainResource{}
 )
 
 func newProjectDomainResource() resource.Resource {
@@ -161,6 +163,11 @@ By default, Project Domains will be automatically applied to any ` + "`productio
 	}
 }
 
+// IdentitySchema returns the identity schema for a project domain resource.
+func (r *projectDomainResource) IdentitySchema(_ context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectResourceIdentitySchema("The domain name associated with the project.")
+}
+
 // ProjectDomainVerification mirrors a single verification challenge returned by the Vercel API.
 type ProjectDomainVerification struct {
 	Type   types.String `tfsdk:"type"`
@@ -194,6 +201,14 @@ type ProjectDomain struct {
 	Misconfigured       types.Bool   `tfsdk:"misconfigured"`
 }
 
+func (p ProjectDomain) identity() ProjectResourceIdentity {
+	return ProjectResourceIdentity{
+		TeamID:    p.TeamID,
+		ProjectID: p.ProjectID,
+		ID:        p.ID,
+	}
+}
+
 func convertResponseToProjectDomain(response client.ProjectDomainResponse, domainConfig client.DomainConfigResponse) ProjectDomain {
 	verification := make([]attr.Value, 0, len(response.Verification))
 	for _, v := range response.Verification {
@@ -331,6 +346,8 @@ func (r *projectDomainResource) Create(ctx context.Context, req resource.CreateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read will read a project domain from the vercel API and provide terraform with information about it.
@@ -386,6 +403,8 @@ func (r *projectDomainResource) Read(ctx context.Context, req resource.ReadReque
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update will update a project domain via the vercel API.
@@ -458,6 +477,8 @@ func (r *projectDomainResource) Update(ctx context.Context, req resource.UpdateR
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete will remove a project domain via the Vercel API.
@@ -498,11 +519,17 @@ func (r *projectDomainResource) Delete(ctx context.Context, req resource.DeleteR
 // ImportState takes an identifier and reads all the project domain information from the Vercel API.
 // Note that environment variables are also read. The results are then stored in terraform state.
 func (r *projectDomainResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	teamID, projectID, domain, ok := splitInto2Or3(req.ID)
+	importID, diags := importIDFromRequest(ctx, req, "team_id", "project_id", "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, projectID, domain, ok := splitInto2Or3(importID)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Error importing project domain",
-			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/domain\" or \"project_id/domain\"", req.ID),
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/domain\" or \"project_id/domain\"", importID),
 		)
 		return
 	}
@@ -540,11 +567,13 @@ func (r *projectDomainResource) ImportState(ctx context.Context, req resource.Im
 		"team_id":    result.TeamID.ValueString(),
 	})
 
-	diags := resp.State.Set(ctx, result)
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 func (r *projectDomainResource) waitForProjectDomainReady(ctx context.Context, projectID, domain, teamID string) (client.ProjectDomainResponse, client.DomainConfigResponse, error) {
@@ -27,6 +27,7 @@ var (
 	_ resource.ResourceWithConfigure   = &projectEnvironmentVariableResource{}
 	_ resource.ResourceWithImportState = &projectEnvironmentVariableResource{}
 	_ resource.ResourceWithModifyPlan  = &projectEnvironmentVariableResource{}
+	_ resource.ResourceWithIdentity    = &projectEnvironmentVariableResource{}
 )
 
 func newProjectEnvironmentVariableResource() resource.Resource {
@@ -181,6 +182,11 @@ At this time you cannot use a Vercel Project resource with in-line ` + "`environ
 	}
 }
 
+// IdentitySchema returns the identity schema for a project environment variable resource.
+func (r *projectEnvironmentVariableResource) IdentitySchema(_ context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = projectResourceIdentitySchema("The ID of the environment variable.")
+}
+
 // ProjectEnvironmentVariable reflects the state terraform stores internally for a project environment variable.
 type ProjectEnvironmentVariable struct {
 	Target               types.Set    `tfsdk:"target"`
@@ -197,6 +203,14 @@ type ProjectEnvironmentVariable struct {
 	Comment              types.String `tfsdk:"comment"`
 }
 
+func (e ProjectEnvironmentVariable) identity() ProjectResourceIdentity {
+	return ProjectResourceIdentity{
+		TeamID:    e.TeamID,
+		ProjectID: e.ProjectID,
+		ID:        e.ID,
+	}
+}
+
 func (e ProjectEnvironmentVariable) isExplicitlyNonSensitive() bool {
 	return !e.Sensitive.IsNull() && !e.Sensitive.IsUnknown() && !e.Sensitive.ValueBool()
 }
@@ -408,6 +422,17 @@ func convertResponseToProjectEnvironmentVariable(response client.EnvironmentVari
 	}
 }
 
+// convertImportedProjectEnvironmentVariable populates terraform state for a project environment
+// variable that has no prior plan or state, such as when it is imported.
+func convertImportedProjectEnvironmentVariable(response client.EnvironmentVariable, projectID string) ProjectEnvironmentVariable {
+	value := types.StringNull()
+	if response.Type != "sensitive" {
+		value = types.StringValue(response.Value)
+	}
+
+	return convertResponseToProjectEnvironmentVariable(response, types.StringValue(projectID), value, types.Int64Null())
+}
+
 // Create will create a new project environment variable for a Vercel project.
 // This is called automatically by the provider when a new resource should be created.
 func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -467,6 +492,8 @@ func (r *projectEnvironmentVariableResource) Create(ctx context.Context, req res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read will read an environment variable of a Vercel project by requesting it from the Vercel API, and will update terraform
@@ -509,6 +536,8 @@ func (r *projectEnvironmentVariableResource) Read(ctx context.Context, req resou
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the project environment variable of a Vercel project state.
@@ -564,6 +593,8 @@ func (r *projectEnvironmentVariableResource) Update(ctx context.Context, req res
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes a Vercel project environment variable.
@@ -601,11 +632,17 @@ func (r *projectEnvironmentVariableResource) Delete(ctx context.Context, req res
 // ImportState takes an identifier and reads all the project environment variable information from the Vercel API.
 // The results are then stored in terraform state.
 func (r *projectEnvironmentVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	teamID, projectID, envID, ok := splitInto2Or3(req.ID)
+	importID, diags := importIDFromRequest(ctx, req, "team_id", "project_id", "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, projectID, envID, ok := splitInto2Or3(importID)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Error importing project environment variable",
-			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/env_id\" or \"project_id/env_id\"", req.ID),
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/project_id/env_id\" or \"project_id/env_id\"", importID),
 		)
 		return
 	}
@@ -624,21 +661,18 @@ func (r *projectEnvironmentVariableResource) ImportState(ctx context.Context, re
 		return
 	}
 
-	value := types.StringNull()
-	if out.Type != "sensitive" {
-		value = types.StringValue(out.Value)
-	}
-
-	result := convertResponseToProjectEnvironmentVariable(out, types.StringValue(projectID), value, types.Int64Null())
+	result := convertImportedProjectEnvironmentVariable(out, projectID)
 	tflog.Info(ctx, "imported project environment variable", map[string]any{
 		"team_id":    result.TeamID.ValueString(),
 		"project_id": result.ProjectID.ValueString(),
 		"env_id":     result.ID.ValueString(),
 	})
 
-	diags := resp.State.Set(ctx, result)
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
@@ -59,19 +59,35 @@ Provides a Project Route resource.
 
 This resource manages one live project-level routing rule for a Vercel project. Each mutation stages a new routing-rules version and promotes it immediately so Terraform state reflects production traffic behavior.
 
-Reads and imports intentionally target the live version and ignore unpublished staged drafts created outside Terraform.
+Reads and imports target the live version, unless ` + "`promote`" + ` is ` + "`false`" + `, in which case they target the staged draft.
+
+Set ` + "`promote`" + ` to ` + "`false`" + ` to only stage changes, including deletions, without publishing them. The rule is then read from the staged version, and ` + "`version_id`" + ` and ` + "`preview_alias`" + ` identify the draft so it can be reviewed and published with ` + "`vercel_project_routes_promotion`" + `.
 
 Position is applied when the rule is created or replaced. Use before and after with reference_route_id when you need deterministic ordering across multiple Terraform-managed rules.
 
 The Vercel API does not return placement metadata for an individual rule. Terraform preserves the configured position on normal reads, but imported routes start with no position in state.
 
-~> This resource refuses to mutate a project while it has an unpublished staged routing-rules version. Publish, restore, or discard the draft first.
+~> Unless ` + "`promote`" + ` is ` + "`false`" + `, this resource refuses to mutate a project while it has an unpublished staged routing-rules version. Publish, restore, or discard the draft first. The draft this resource staged itself is the exception: switching ` + "`promote`" + ` back to ` + "`true`" + ` publishes it along with the change.
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
 				Computed:    true,
 				Description: "The Vercel route ID.",
 			},
+			"promote": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to promote each change to production. When `false`, changes are only staged and can be published with `vercel_project_routes_promotion`. Defaults to `true`.",
+			},
+			"version_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the routing-rules version the rule was read from. This is the staged version when `promote` is `false` and changes have not been published yet.",
+			},
+			"preview_alias": schema.StringAttribute{
+				Computed:    true,
+				Description: "The preview alias of the staged routing-rules version, if any, for testing changes before they are published.",
+			},
 			"project_id": schema.StringAttribute{
 				Required:      true,
 				Description:   "The ID of the project to manage a routing rule for.",
@@ -142,157 +158,161 @@ The Vercel API does not return placement metadata for an individual rule. Terraf
 					},
 				},
 			},
-			"route": schema.SingleNestedAttribute{
+			"route": projectRouteDefinitionAttribute(),
+		},
+	}
+}
+
+func projectRouteDefinitionAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Required:    true,
+		Description: "The routing rule definition.",
+		Validators: []validator.Object{
+			projectRouteDefinitionValidator{},
+		},
+		Attributes: map[string]schema.Attribute{
+			"src": schema.StringAttribute{
 				Required:    true,
-				Description: "The routing rule definition.",
-				Validators: []validator.Object{
-					projectRouteDefinitionValidator{},
+				Description: "The source pattern to match.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
 				},
-				Attributes: map[string]schema.Attribute{
-					"src": schema.StringAttribute{
-						Required:    true,
-						Description: "The source pattern to match.",
-						Validators: []validator.String{
-							stringvalidator.LengthAtLeast(1),
+			},
+			"dest": schema.StringAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The destination for rewrites or redirects.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"headers": schema.MapAttribute{
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				Description: "Headers to set for the matched request.",
+			},
+			"case_sensitive": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the `src` matcher is case-sensitive.",
+			},
+			"status": schema.Int64Attribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "The HTTP status code to set for redirects or status-only rules.",
+				Validators: []validator.Int64{
+					int64validator.Between(100, 999),
+				},
+			},
+			"has": schema.ListNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Conditions that must be present for the rule to match.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The condition type. One of `host`, `header`, `cookie`, or `query`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("host", "header", "cookie", "query"),
+							},
 						},
-					},
-					"dest": schema.StringAttribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "The destination for rewrites or redirects.",
-						Validators: []validator.String{
-							stringvalidator.LengthAtLeast(1),
+						"key": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The key to match for `header`, `cookie`, or `query` conditions.",
+						},
+						"value": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The value to match.",
 						},
 					},
-					"headers": schema.MapAttribute{
-						Optional:    true,
-						Computed:    true,
-						ElementType: types.StringType,
-						Description: "Headers to set for the matched request.",
-					},
-					"case_sensitive": schema.BoolAttribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "Whether the `src` matcher is case-sensitive.",
+					Validators: []validator.Object{
+						projectRouteConditionValidator{},
 					},
-					"status": schema.Int64Attribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "The HTTP status code to set for redirects or status-only rules.",
-						Validators: []validator.Int64{
-							int64validator.Between(100, 999),
+				},
+			},
+			"missing": schema.ListNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Conditions that must be absent for the rule to match.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The condition type. One of `host`, `header`, `cookie`, or `query`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("host", "header", "cookie", "query"),
+							},
+						},
+						"key": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The key to match for `header`, `cookie`, or `query` conditions.",
+						},
+						"value": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The value to match.",
 						},
 					},
-					"has": schema.ListNestedAttribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "Conditions that must be present for the rule to match.",
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"type": schema.StringAttribute{
-									Required:    true,
-									Description: "The condition type. One of `host`, `header`, `cookie`, or `query`.",
-									Validators: []validator.String{
-										stringvalidator.OneOf("host", "header", "cookie", "query"),
-									},
-								},
-								"key": schema.StringAttribute{
-									Optional:    true,
-									Computed:    true,
-									Description: "The key to match for `header`, `cookie`, or `query` conditions.",
-								},
-								"value": schema.StringAttribute{
-									Optional:    true,
-									Computed:    true,
-									Description: "The value to match.",
-								},
-							},
-							Validators: []validator.Object{
-								projectRouteConditionValidator{},
+					Validators: []validator.Object{
+						projectRouteConditionValidator{},
+					},
+				},
+			},
+			"transforms": schema.ListNestedAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Transforms applied to the request or response when the rule matches.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"type": schema.StringAttribute{
+							Required:    true,
+							Description: "The transform target. One of `request.headers`, `request.query`, or `response.headers`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("request.headers", "request.query", "response.headers"),
 							},
 						},
-					},
-					"missing": schema.ListNestedAttribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "Conditions that must be absent for the rule to match.",
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"type": schema.StringAttribute{
-									Required:    true,
-									Description: "The condition type. One of `host`, `header`, `cookie`, or `query`.",
-									Validators: []validator.String{
-										stringvalidator.OneOf("host", "header", "cookie", "query"),
-									},
-								},
-								"key": schema.StringAttribute{
-									Optional:    true,
-									Computed:    true,
-									Description: "The key to match for `header`, `cookie`, or `query` conditions.",
-								},
-								"value": schema.StringAttribute{
-									Optional:    true,
-									Computed:    true,
-									Description: "The value to match.",
-								},
+						"op": schema.StringAttribute{
+							Required:    true,
+							Description: "The transform operation. One of `append`, `set`, or `delete`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("append", "set", "delete"),
 							},
-							Validators: []validator.Object{
-								projectRouteConditionValidator{},
+						},
+						"target": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "A JSON document describing the transform target. Prefer `jsonencode(...)` when setting this.",
+							Validators: []validator.String{
+								validateJSON(),
 							},
 						},
-					},
-					"transforms": schema.ListNestedAttribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "Transforms applied to the request or response when the rule matches.",
-						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"type": schema.StringAttribute{
-									Required:    true,
-									Description: "The transform target. One of `request.headers`, `request.query`, or `response.headers`.",
-									Validators: []validator.String{
-										stringvalidator.OneOf("request.headers", "request.query", "response.headers"),
-									},
-								},
-								"op": schema.StringAttribute{
-									Required:    true,
-									Description: "The transform operation. One of `append`, `set`, or `delete`.",
-									Validators: []validator.String{
-										stringvalidator.OneOf("append", "set", "delete"),
-									},
-								},
-								"target": schema.StringAttribute{
-									Optional:    true,
-									Computed:    true,
-									Description: "A JSON document describing the transform target. Prefer `jsonencode(...)` when setting this.",
-									Validators: []validator.String{
-										validateJSON(),
-									},
-								},
-								"args": schema.StringAttribute{
-									Optional:    true,
-									Computed:    true,
-									Description: "A JSON document containing transform arguments. Prefer `jsonencode(...)` when setting this.",
-									Validators: []validator.String{
-										validateJSON(),
-									},
-								},
-								"env": schema.ListAttribute{
-									Optional:    true,
-									Computed:    true,
-									ElementType: types.StringType,
-									Description: "Environment names that gate this transform.",
-								},
+						"args": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "A JSON document containing transform arguments. Prefer `jsonencode(...)` when setting this.",
+							Validators: []validator.String{
+								validateJSON(),
 							},
 						},
-					},
-					"respect_origin_cache_control": schema.BoolAttribute{
-						Optional:    true,
-						Computed:    true,
-						Description: "Whether the rule should respect cache control headers from the origin.",
+						"env": schema.ListAttribute{
+							Optional:    true,
+							Computed:    true,
+							ElementType: types.StringType,
+							Description: "Environment names that gate this transform.",
+						},
 					},
 				},
 			},
+			"respect_origin_cache_control": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Description: "Whether the rule should respect cache control headers from the origin.",
+			},
 		},
 	}
 }
@@ -441,9 +461,11 @@ func (r *projectRouteResource) Create(ctx context.Context, req resource.CreateRe
 	unlock := projectRouteLocks.Lock(projectRoutesResourceID(r.client.TeamID(plan.TeamID.ValueString()), plan.ProjectID.ValueString()))
 	defer unlock()
 
-	if err := r.ensureNoStagedProjectRoutes(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Error creating project route", err.Error())
-		return
+	if plan.promote() {
+		if err := ensureNoStagedProjectRoutes(ctx, r.client, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), "", "vercel_project_route"); err != nil {
+			resp.Diagnostics.AddError("Error creating project route", err.Error())
+			return
+		}
 	}
 
 	routeInput, diags := plan.projectRoute().toClientInput(ctx)
@@ -473,15 +495,17 @@ func (r *projectRouteResource) Create(ctx context.Context, req resource.CreateRe
 		return
 	}
 
-	if err := r.promoteProjectRouteVersion(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), result.Version.ID); err != nil {
-		resp.Diagnostics.AddError(
-			"Error creating project route",
-			"Could not promote project route, unexpected error: "+err.Error(),
-		)
-		return
+	if plan.promote() {
+		if err := promoteProjectRouteVersion(ctx, r.client, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), result.Version.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating project route",
+				"Could not promote project route, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	state, diags, err := readProjectRoute(ctx, r.client, result.Route.ID, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), plan.projectRoute(), plan.Position)
+	state, diags, err := readProjectRoute(ctx, r.client, result.Route.ID, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), plan.projectRoute(), plan.Position, plan.promote())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -510,7 +534,7 @@ func (r *projectRouteResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	result, diags, err := readProjectRoute(ctx, r.client, state.ID.ValueString(), state.ProjectID.ValueString(), state.TeamID.ValueString(), state.projectRoute(), state.Position)
+	result, diags, err := readProjectRoute(ctx, r.client, state.ID.ValueString(), state.ProjectID.ValueString(), state.TeamID.ValueString(), state.projectRoute(), state.Position, state.promote())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -560,9 +584,11 @@ func (r *projectRouteResource) Update(ctx context.Context, req resource.UpdateRe
 	unlock := projectRouteLocks.Lock(projectRoutesResourceID(r.client.TeamID(plan.TeamID.ValueString()), plan.ProjectID.ValueString()))
 	defer unlock()
 
-	if err := r.ensureNoStagedProjectRoutes(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Error updating project route", err.Error())
-		return
+	if plan.promote() {
+		if err := ensureNoStagedProjectRoutes(ctx, r.client, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), state.stagedVersionID(), "vercel_project_route"); err != nil {
+			resp.Diagnostics.AddError("Error updating project route", err.Error())
+			return
+		}
 	}
 
 	routeInput, diags := plan.projectRoute().toClientInput(ctx)
@@ -589,15 +615,17 @@ func (r *projectRouteResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
-	if err := r.promoteProjectRouteVersion(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), result.Version.ID); err != nil {
-		resp.Diagnostics.AddError(
-			"Error updating project route",
-			"Could not promote project route, unexpected error: "+err.Error(),
-		)
-		return
+	if plan.promote() {
+		if err := promoteProjectRouteVersion(ctx, r.client, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), result.Version.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating project route",
+				"Could not promote project route, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
-	newState, diags, err := readProjectRoute(ctx, r.client, state.ID.ValueString(), plan.ProjectID.ValueString(), plan.TeamID.ValueString(), plan.projectRoute(), plan.Position)
+	newState, diags, err := readProjectRoute(ctx, r.client, state.ID.ValueString(), plan.ProjectID.ValueString(), plan.TeamID.ValueString(), plan.projectRoute(), plan.Position, plan.promote())
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -633,9 +661,11 @@ func (r *projectRouteResource) Delete(ctx context.Context, req resource.DeleteRe
 	unlock := projectRouteLocks.Lock(projectRoutesResourceID(r.client.TeamID(state.TeamID.ValueString()), state.ProjectID.ValueString()))
 	defer unlock()
 
-	if err := r.ensureNoStagedProjectRoutes(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString()); err != nil {
-		resp.Diagnostics.AddError("Error deleting project route", err.Error())
-		return
+	if state.promote() {
+		if err := ensureNoStagedProjectRoutes(ctx, r.client, state.ProjectID.ValueString(), state.TeamID.ValueString(), "", "vercel_project_route"); err != nil {
+			resp.Diagnostics.AddError("Error deleting project route", err.Error())
+			return
+		}
 	}
 
 	result, err := r.client.DeleteProjectRoutes(ctx, client.DeleteProjectRoutesRequest{
@@ -654,12 +684,14 @@ func (r *projectRouteResource) Delete(ctx context.Context, req resource.DeleteRe
 		return
 	}
 
-	if err := r.promoteProjectRouteVersion(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), result.Version.ID); err != nil {
-		resp.Diagnostics.AddError(
-			"Error deleting project route",
-			"Could not promote deleted project route version, unexpected error: "+err.Error(),
-		)
-		return
+	if state.promote() {
+		if err := promoteProjectRouteVersion(ctx, r.client, state.ProjectID.ValueString(), state.TeamID.ValueString(), result.Version.ID); err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting project route",
+				"Could not promote deleted project route version, unexpected error: "+err.Error(),
+			)
+			return
+		}
 	}
 
 	tflog.Info(ctx, "deleted project route", map[string]any{
@@ -679,7 +711,7 @@ func (r *projectRouteResource) ImportState(ctx context.Context, req resource.Imp
 		return
 	}
 
-	result, diags, err := readProjectRoute(ctx, r.client, routeID, projectID, teamID, ProjectRoute{}, ProjectRoutePosition{})
+	result, diags, err := readProjectRoute(ctx, r.client, routeID, projectID, teamID, ProjectRoute{}, ProjectRoutePosition{}, true)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -702,23 +734,27 @@ func (r *projectRouteResource) ImportState(ctx context.Context, req resource.Imp
 	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
 }
 
-func (r *projectRouteResource) ensureNoStagedProjectRoutes(ctx context.Context, projectID, teamID string) error {
-	versions, err := r.client.GetProjectRouteVersions(ctx, projectID, teamID)
+// ensureNoStagedProjectRoutes refuses to continue while the project has an
+// unpublished draft, as promoting our own changes would publish it too. The
+// draft ownDraftID is allowed: it was staged by the resource itself with
+// promote = false, so publishing it is what switching promote on asks for.
+func ensureNoStagedProjectRoutes(ctx context.Context, c *client.Client, projectID, teamID, ownDraftID, resourceType string) error {
+	versions, err := c.GetProjectRouteVersions(ctx, projectID, teamID)
 	if err != nil {
 		return err
 	}
 
 	for _, version := range versions {
-		if version.IsStaging {
-			return fmt.Errorf("project %s has an unpublished staged routing-rules version (%s). Publish, restore, or discard it before managing `vercel_project_route` resources", projectID, version.ID)
+		if version.IsStaging && (ownDraftID == "" || version.ID != ownDraftID) {
+			return fmt.Errorf("project %s has an unpublished staged routing-rules version (%s). Publish, restore, or discard it before managing `%s` resources", projectID, version.ID, resourceType)
 		}
 	}
 
 	return nil
 }
 
-func (r *projectRouteResource) promoteProjectRouteVersion(ctx context.Context, projectID, teamID, versionID string) error {
-	_, err := r.client.UpdateProjectRoutingRuleVersion(ctx, client.UpdateProjectRoutingRuleVersionRequest{
+func promoteProjectRouteVersion(ctx context.Context, c *client.Client, projectID, teamID, versionID string) error {
+	_, err := c.UpdateProjectRoutingRuleVersion(ctx, client.UpdateProjectRoutingRuleVersionRequest{
 		TeamID:    teamID,
 		ProjectID: projectID,
 		ID:        versionID,
@@ -0,0 +1,681 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource                = &projectRoutesResource{}
+	_ resource.ResourceWithConfigure   = &projectRoutesResource{}
+	_ resource.ResourceWithImportState = &projectRoutesResource{}
+	_ resource.ResourceWithModifyPlan  = &projectRoutesResource{}
+)
+
+func newProjectRoutesResource() resource.Resource {
+	return &projectRoutesResource{}
+}
+
+type projectRoutesResource struct {
+	client *client.Client
+}
+
+type ProjectRoutesResourceModel struct {
+	ID                   types.String `tfsdk:"id"`
+	ProjectID            types.String `tfsdk:"project_id"`
+	TeamID               types.String `tfsdk:"team_id"`
+	IgnoreUnmanagedRules types.Bool   `tfsdk:"ignore_unmanaged_rules"`
+	Promote              types.Bool   `tfsdk:"promote"`
+	VersionID            types.String `tfsdk:"version_id"`
+	PreviewAlias         types.String `tfsdk:"preview_alias"`
+	Rules                types.List   `tfsdk:"rules"`
+}
+
+func (m ProjectRoutesResourceModel) promote() bool {
+	return m.Promote.IsNull() || m.Promote.IsUnknown() || m.Promote.ValueBool()
+}
+
+// stagedVersionID is the draft the resource staged with promote = false, or ""
+// if its changes were promoted.
+func (m ProjectRoutesResourceModel) stagedVersionID() string {
+	if m.promote() {
+		return ""
+	}
+	return m.VersionID.ValueString()
+}
+
+func (m ProjectRoutesResourceModel) projectRoutes(ctx context.Context) ([]ProjectRoute, diag.Diagnostics) {
+	if m.Rules.IsNull() || m.Rules.IsUnknown() {
+		return nil, nil
+	}
+
+	var rules []ProjectRoute
+	diags := m.Rules.ElementsAs(ctx, &rules, false)
+	return rules, diags
+}
+
+// managedRouteIDs returns the IDs of the rules Terraform created or adopted.
+func (m ProjectRoutesResourceModel) managedRouteIDs(ctx context.Context) (map[string]bool, diag.Diagnostics) {
+	rules, diags := m.projectRoutes(ctx)
+	ids := map[string]bool{}
+	for _, rule := range rules {
+		if !rule.ID.IsNull() && !rule.ID.IsUnknown() && rule.ID.ValueString() != "" {
+			ids[rule.ID.ValueString()] = true
+		}
+	}
+	return ids, diags
+}
+
+func (r *projectRoutesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_routes"
+}
+
+func (r *projectRoutesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectRoutesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Provides a Project Routes resource.
+
+This resource manages the full, ordered list of live project-level routing rules for a Vercel project. Every apply stages all additions, edits, deletions, and reorders as a single routing-rules version and promotes it once.
+
+Rules are matched to the rules already in state by ` + "`name`" + `, so names must be unique. Renaming a rule replaces it with a new rule ID.
+
+By default the resource is authoritative: rules added outside Terraform are reported as drift and removed on the next apply. Set ` + "`ignore_unmanaged_rules`" + ` to leave those rules in place. They are kept after the managed rule they currently follow.
+
+~> Do not use this resource together with ` + "`vercel_project_route`" + ` for the same project unless ` + "`ignore_unmanaged_rules`" + ` is set, or the two resources will remove each other's rules.
+
+Set ` + "`promote`" + ` to ` + "`false`" + ` to only stage the routing table without publishing it. The rules are then read from the staged version, and ` + "`version_id`" + ` and ` + "`preview_alias`" + ` identify the draft so it can be reviewed and published with ` + "`vercel_project_routes_promotion`" + `. Each apply replaces the staged draft.
+
+~> Unless ` + "`promote`" + ` is ` + "`false`" + `, this resource refuses to mutate a project while it has an unpublished staged routing-rules version. Publish, restore, or discard the draft first. The draft this resource staged itself is the exception: switching ` + "`promote`" + ` back to ` + "`true`" + ` publishes it along with the change.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The unique identifier for this resource. Format: team_id/project_id or project_id for personal accounts.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the project to manage routing rules for.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"ignore_unmanaged_rules": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+				Description: "When true, routing rules that were not created by this resource are left in place and are not reported as drift. Defaults to `false`, which removes them.",
+			},
+			"promote": schema.BoolAttribute{
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(true),
+				Description: "Whether to promote the staged routing table to production. When `false`, the routing table is only staged and can be published with `vercel_project_routes_promotion`. Defaults to `true`.",
+			},
+			"version_id": schema.StringAttribute{
+				Computed:    true,
+				Description: "The ID of the routing-rules version the rules were read from. This is the staged version when `promote` is `false` and the routing table has not been published yet.",
+			},
+			"preview_alias": schema.StringAttribute{
+				Computed:    true,
+				Description: "The preview alias of the staged routing-rules version, if any, for testing the routing table before it is published.",
+			},
+			"rules": schema.ListNestedAttribute{
+				Required:    true,
+				Description: "The ordered list of routing rules. Rules are evaluated in this order.",
+				Validators: []validator.List{
+					projectRoutesUniqueNamesValidator{},
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed:    true,
+							Description: "The Vercel route ID.",
+						},
+						"name": schema.StringAttribute{
+							Required:    true,
+							Description: "A human-readable name for the rule. Must be unique within the list.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 256),
+							},
+						},
+						"description": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "An optional description of the rule.",
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(0, 1024),
+							},
+						},
+						"enabled": schema.BoolAttribute{
+							Optional:    true,
+							Computed:    true,
+							Default:     booldefault.StaticBool(true),
+							Description: "Whether the rule is enabled.",
+						},
+						"src_syntax": schema.StringAttribute{
+							Optional:    true,
+							Computed:    true,
+							Description: "The source pattern syntax. You can usually omit this and let Vercel infer it from `route.src`.",
+							Validators: []validator.String{
+								stringvalidator.OneOf("equals", "path-to-regexp", "regex"),
+							},
+						},
+						"route_type": schema.StringAttribute{
+							Computed:    true,
+							Description: "The computed route type returned by Vercel. One of `rewrite`, `redirect`, `set_status`, or `transform`.",
+						},
+						"route": projectRouteDefinitionAttribute(),
+					},
+				},
+			},
+		},
+	}
+}
+
+type projectRoutesUniqueNamesValidator struct{}
+
+func (v projectRoutesUniqueNamesValidator) Description(ctx context.Context) string {
+	return "Rule names must be unique"
+}
+
+func (v projectRoutesUniqueNamesValidator) MarkdownDescription(ctx context.Context) string {
+	return "Rule names must be unique"
+}
+
+func (v projectRoutesUniqueNamesValidator) ValidateList(ctx context.Context, req validator.ListRequest, resp *validator.ListResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	seen := map[string]bool{}
+	for i, element := range req.ConfigValue.Elements() {
+		rule, ok := element.(types.Object)
+		if !ok || rule.IsNull() || rule.IsUnknown() {
+			continue
+		}
+		name, ok := rule.Attributes()["name"].(types.String)
+		if !ok || name.IsNull() || name.IsUnknown() {
+			continue
+		}
+		if seen[name.ValueString()] {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtListIndex(i).AtName("name"),
+				"Duplicate rule name",
+				fmt.Sprintf("The rule name %q is used more than once. Rules are matched by name, so each name must be unique.", name.ValueString()),
+			)
+			continue
+		}
+		seen[name.ValueString()] = true
+	}
+}
+
+// ModifyPlan carries the IDs of existing rules over by name, so the plan shows
+// which rules are edited or moved and which are created.
+func (r *projectRoutesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state ProjectRoutesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() || plan.Rules.IsUnknown() {
+		return
+	}
+
+	// Rules that are not fully known yet, such as ones built from other
+	// resources' outputs, are left for the framework to plan.
+	planRules, diags := plan.projectRoutes(ctx)
+	if diags.HasError() {
+		return
+	}
+	stateRules, diags := state.projectRoutes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	idsByName := projectRouteIDsByName(stateRules)
+	for i, rule := range planRules {
+		if rule.Name.IsUnknown() {
+			continue
+		}
+		if id, ok := idsByName[rule.Name.ValueString()]; ok {
+			planRules[i].ID = types.StringValue(id)
+		} else {
+			planRules[i].ID = types.StringUnknown()
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("rules"), projectRoutesListValue(planRules))...)
+}
+
+func projectRouteIDsByName(rules []ProjectRoute) map[string]string {
+	ids := map[string]string{}
+	for _, rule := range rules {
+		if rule.ID.IsNull() || rule.ID.IsUnknown() || rule.ID.ValueString() == "" {
+			continue
+		}
+		ids[rule.Name.ValueString()] = rule.ID.ValueString()
+	}
+	return ids
+}
+
+// projectRouteInputFromAPI converts a live rule back into the shape used to
+// stage it, so rules outside Terraform's control can be kept unchanged.
+func projectRouteInputFromAPI(rule client.ProjectRoutingRule) client.ProjectRoutingRuleInput {
+	route := rule.Route
+	if rule.RawSrc != nil && *rule.RawSrc != "" {
+		route.Src = *rule.RawSrc
+	}
+	if rule.RawDest != nil {
+		route.Dest = rule.RawDest
+	}
+
+	return client.ProjectRoutingRuleInput{
+		ID:          rule.ID,
+		Name:        rule.Name,
+		Description: rule.Description,
+		Enabled:     rule.Enabled,
+		SrcSyntax:   rule.SrcSyntax,
+		Route:       route,
+	}
+}
+
+// mergeUnmanagedProjectRoutes adds the live rules that Terraform does not
+// manage back into the desired list. Each one is kept after the managed rule
+// that precedes it in the live list, or at the start if there is none.
+func mergeUnmanagedProjectRoutes(live []client.ProjectRoutingRule, desired []client.ProjectRoutingRuleInput, managed map[string]bool) []client.ProjectRoutingRuleInput {
+	kept := map[string]bool{}
+	for _, rule := range desired {
+		if rule.ID != "" {
+			kept[rule.ID] = true
+		}
+	}
+
+	anchor := ""
+	unmanagedByAnchor := map[string][]client.ProjectRoutingRuleInput{}
+	for _, rule := range live {
+		if managed[rule.ID] {
+			if kept[rule.ID] {
+				anchor = rule.ID
+			}
+			continue
+		}
+		unmanagedByAnchor[anchor] = append(unmanagedByAnchor[anchor], projectRouteInputFromAPI(rule))
+	}
+
+	merged := make([]client.ProjectRoutingRuleInput, 0, len(live)+len(desired))
+	merged = append(merged, unmanagedByAnchor[""]...)
+	for _, rule := range desired {
+		merged = append(merged, rule)
+		if rule.ID != "" {
+			merged = append(merged, unmanagedByAnchor[rule.ID]...)
+		}
+	}
+
+	return merged
+}
+
+// filterManagedProjectRoutes drops the live rules that Terraform does not
+// manage when ignore_unmanaged_rules is set.
+func filterManagedProjectRoutes(response client.ProjectRoutingRulesResponse, ignoreUnmanaged bool, managed map[string]bool) client.ProjectRoutingRulesResponse {
+	if !ignoreUnmanaged {
+		return response
+	}
+
+	routes := make([]client.ProjectRoutingRule, 0, len(response.Routes))
+	for _, rule := range response.Routes {
+		if managed[rule.ID] {
+			routes = append(routes, rule)
+		}
+	}
+	response.Routes = routes
+	return response
+}
+
+func projectRoutesResourceModelFromResponse(ctx context.Context, response client.ProjectRoutingRulesResponse, projectID, teamID string, ignoreUnmanaged, promote bool, managed map[string]bool, preferredRules []ProjectRoute) (ProjectRoutesResourceModel, diag.Diagnostics) {
+	routes, diags := convertResponseToProjectRoutes(ctx, filterManagedProjectRoutes(response, ignoreUnmanaged, managed), projectID, teamID, preferredRules)
+	if diags.HasError() {
+		return ProjectRoutesResourceModel{}, diags
+	}
+
+	return ProjectRoutesResourceModel{
+		ID:                   routes.ID,
+		ProjectID:            routes.ProjectID,
+		TeamID:               routes.TeamID,
+		IgnoreUnmanagedRules: types.BoolValue(ignoreUnmanaged),
+		Promote:              types.BoolValue(promote),
+		VersionID:            projectRouteVersionID(response.Version),
+		PreviewAlias:         stringValueOrNull(response.Version.Alias),
+		Rules:                routes.Rules,
+	}, nil
+}
+
+// applyProjectRoutes stages the desired rules as a single version, promotes it
+// unless promote is false, and returns the resulting rules along with the IDs
+// of the rules Terraform now manages. previouslyManaged holds the rule IDs from
+// the prior state, and ownDraftID the draft it staged, if any.
+func (r *projectRoutesResource) applyProjectRoutes(ctx context.Context, projectID, teamID string, ignoreUnmanaged, promote bool, ownDraftID string, previouslyManaged map[string]bool, idsByName map[string]string, rules []ProjectRoute) (client.ProjectRoutingRulesResponse, map[string]bool, diag.Diagnostics, error) {
+	unlock := projectRouteLocks.Lock(projectRoutesResourceID(r.client.TeamID(teamID), projectID))
+	defer unlock()
+
+	if promote {
+		if err := ensureNoStagedProjectRoutes(ctx, r.client, projectID, teamID, ownDraftID, "vercel_project_routes"); err != nil {
+			return client.ProjectRoutingRulesResponse{}, nil, nil, err
+		}
+	}
+
+	// When only staging, or publishing our own draft, an earlier unpublished
+	// apply may have created rules that only exist in the draft, so the draft
+	// is the starting point.
+	live, err := readProjectRoutingRules(ctx, r.client, projectID, teamID, !promote || ownDraftID != "")
+	if err != nil {
+		return client.ProjectRoutingRulesResponse{}, nil, nil, err
+	}
+	liveIDs := map[string]bool{}
+	for _, rule := range live.Routes {
+		liveIDs[rule.ID] = true
+	}
+
+	desired := make([]client.ProjectRoutingRuleInput, 0, len(rules))
+	for _, rule := range rules {
+		input, diags := rule.toClientInput(ctx)
+		if diags.HasError() {
+			return client.ProjectRoutingRulesResponse{}, nil, diags, nil
+		}
+		// Rules removed outside Terraform are created again rather than
+		// referenced by an ID that no longer exists.
+		if id, ok := idsByName[rule.Name.ValueString()]; ok && liveIDs[id] {
+			input.ID = id
+		}
+		desired = append(desired, input)
+	}
+
+	routes := desired
+	unmanaged := map[string]bool{}
+	if ignoreUnmanaged {
+		routes = mergeUnmanagedProjectRoutes(live.Routes, desired, previouslyManaged)
+		for _, rule := range live.Routes {
+			if !previouslyManaged[rule.ID] {
+				unmanaged[rule.ID] = true
+			}
+		}
+	}
+
+	staged, err := r.client.StageProjectRoutes(ctx, client.StageProjectRoutesRequest{
+		TeamID:    teamID,
+		ProjectID: projectID,
+		Routes:    routes,
+	})
+	if err != nil {
+		return client.ProjectRoutingRulesResponse{}, nil, nil, err
+	}
+
+	if promote {
+		if err := promoteProjectRouteVersion(ctx, r.client, projectID, teamID, staged.Version.ID); err != nil {
+			return client.ProjectRoutingRulesResponse{}, nil, nil, fmt.Errorf("could not promote routing-rules version %s: %w", staged.Version.ID, err)
+		}
+	}
+
+	managed := map[string]bool{}
+	for _, rule := range staged.Routes {
+		if !unmanaged[rule.ID] {
+			managed[rule.ID] = true
+		}
+	}
+
+	response, err := readProjectRoutingRules(ctx, r.client, projectID, teamID, !promote)
+	return response, managed, nil, err
+}
+
+func (r *projectRoutesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectRoutesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.client.GetProject(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error creating project routes",
+			"Could not find project, please make sure both the project_id and team_id match the project and team you wish to configure.",
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project routes",
+			"Error reading project information, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	rules, diags := plan.projectRoutes(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, managed, diags, err := r.applyProjectRoutes(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), plan.IgnoreUnmanagedRules.ValueBool(), plan.promote(), "", map[string]bool{}, map[string]string{}, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating project routes",
+			"Could not create project routes, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	result, diags := projectRoutesResourceModelFromResponse(ctx, response, plan.ProjectID.ValueString(), r.client.TeamID(plan.TeamID.ValueString()), plan.IgnoreUnmanagedRules.ValueBool(), plan.promote(), managed, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "created project routes", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"version_id": result.VersionID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *projectRoutesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectRoutesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := state.projectRoutes(ctx)
+	resp.Diagnostics.Append(diags...)
+	managed, diags := state.managedRouteIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, err := readProjectRoutingRules(ctx, r.client, state.ProjectID.ValueString(), state.TeamID.ValueString(), !state.promote())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project routes",
+			fmt.Sprintf("Could not get project routes %s %s, unexpected error: %s", state.TeamID.ValueString(), state.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	result, diags := projectRoutesResourceModelFromResponse(ctx, response, state.ProjectID.ValueString(), r.client.TeamID(state.TeamID.ValueString()), state.IgnoreUnmanagedRules.ValueBool(), state.promote(), managed, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "read project routes", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"version_id": result.VersionID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *projectRoutesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state ProjectRoutesResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	rules, diags := plan.projectRoutes(ctx)
+	resp.Diagnostics.Append(diags...)
+	stateRules, diags := state.projectRoutes(ctx)
+	resp.Diagnostics.Append(diags...)
+	previouslyManaged, diags := state.managedRouteIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response, managed, diags, err := r.applyProjectRoutes(ctx, plan.ProjectID.ValueString(), plan.TeamID.ValueString(), plan.IgnoreUnmanagedRules.ValueBool(), plan.promote(), state.stagedVersionID(), previouslyManaged, projectRouteIDsByName(stateRules), rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error updating project routes",
+			fmt.Sprintf("Could not update project routes %s %s, unexpected error: %s", plan.TeamID.ValueString(), plan.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	result, diags := projectRoutesResourceModelFromResponse(ctx, response, plan.ProjectID.ValueString(), r.client.TeamID(plan.TeamID.ValueString()), plan.IgnoreUnmanagedRules.ValueBool(), plan.promote(), managed, rules)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "updated project routes", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": result.ProjectID.ValueString(),
+		"version_id": result.VersionID.ValueString(),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+func (r *projectRoutesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectRoutesResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	managed, diags := state.managedRouteIDs(ctx)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, _, err := r.applyProjectRoutes(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString(), state.IgnoreUnmanagedRules.ValueBool(), state.promote(), "", managed, map[string]string{}, nil)
+	if client.NotFound(err) {
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error deleting project routes",
+			fmt.Sprintf("Could not delete project routes %s %s, unexpected error: %s", state.TeamID.ValueString(), state.ProjectID.ValueString(), err),
+		)
+		return
+	}
+
+	tflog.Info(ctx, "deleted project routes", map[string]any{
+		"team_id":    state.TeamID.ValueString(),
+		"project_id": state.ProjectID.ValueString(),
+	})
+}
+
+func (r *projectRoutesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamID, projectID, ok := splitInto1Or2(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Error importing project routes",
+			fmt.Sprintf("Invalid id %q specified. Expected \"team_id/project_id\" or \"project_id\".", req.ID),
+		)
+		return
+	}
+
+	response, err := readLiveProjectRoutingRules(ctx, r.client, projectID, teamID)
+	if client.NotFound(err) {
+		resp.Diagnostics.AddError(
+			"Error importing project routes",
+			fmt.Sprintf("Could not find project %s %s.", teamID, projectID),
+		)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error importing project routes",
+			fmt.Sprintf("Could not get project routes %s %s, unexpected error: %s", teamID, projectID, err),
+		)
+		return
+	}
+
+	result, diags := projectRoutesResourceModelFromResponse(ctx, response, projectID, r.client.TeamID(teamID), false, true, nil, nil)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
@@ -0,0 +1,235 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+var (
+	_ resource.Resource              = &projectRoutesPromotionResource{}
+	_ resource.ResourceWithConfigure = &projectRoutesPromotionResource{}
+)
+
+func newProjectRoutesPromotionResource() resource.Resource {
+	return &projectRoutesPromotionResource{}
+}
+
+type projectRoutesPromotionResource struct {
+	client *client.Client
+}
+
+type ProjectRoutesPromotion struct {
+	ID        types.String `tfsdk:"id"`
+	ProjectID types.String `tfsdk:"project_id"`
+	TeamID    types.String `tfsdk:"team_id"`
+	VersionID types.String `tfsdk:"version_id"`
+	IsLive    types.Bool   `tfsdk:"is_live"`
+}
+
+func (r *projectRoutesPromotionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_project_routes_promotion"
+}
+
+func (r *projectRoutesPromotionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*client.Client)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *client.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = client
+}
+
+func (r *projectRoutesPromotionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: `
+Promotes a project routing-rules version to production.
+
+Use this together with ` + "`vercel_project_routes`" + ` or ` + "`vercel_project_route`" + ` resources that set ` + "`promote = false`" + `, so changes are staged and reviewed, for example through their ` + "`preview_alias`" + `, before they are published. A staged version is promoted, and an earlier version is restored, which allows rolling back.
+
+The version is promoted when the resource is created, or when ` + "`version_id`" + ` changes. Promoting another version outside Terraform is not treated as drift.
+
+Deleting this resource only removes it from Terraform state. The promoted version stays live.
+
+~> Each apply of a staging resource creates a new draft on top of the previous one, so ` + "`version_id`" + ` must be the ` + "`version_id`" + ` of the resource that was applied last. Promoting an earlier draft publishes only part of the staged changes. When staging with several ` + "`vercel_project_route`" + ` resources, chain them with ` + "`depends_on`" + ` so they are applied in a known order, and promote the ` + "`version_id`" + ` of the last one. A single ` + "`vercel_project_routes`" + ` resource stages everything in one version.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:      true,
+				Description:   "The ID of the promoted version.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.UseStateForUnknown()},
+			},
+			"project_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the project the routing rules belong to.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"team_id": schema.StringAttribute{
+				Optional:      true,
+				Computed:      true,
+				Description:   "The ID of the team the project exists under. Required when configuring a team resource if a default team has not been set in the provider.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplaceIfConfigured(), stringplanmodifier.UseNonNullStateForUnknown()},
+			},
+			"version_id": schema.StringAttribute{
+				Required:      true,
+				Description:   "The ID of the routing-rules version to promote, such as the `version_id` of a `vercel_project_routes` resource. To publish staged changes, use the `version_id` of the staging resource that was applied last.",
+				PlanModifiers: []planmodifier.String{stringplanmodifier.RequiresReplace()},
+			},
+			"is_live": schema.BoolAttribute{
+				Computed:    true,
+				Description: "Whether the version is currently live.",
+			},
+		},
+	}
+}
+
+// versionPromotionAction returns the action that makes a routing-rules or bulk
+// redirects version live. Staged versions are promoted and earlier versions
+// are restored.
+func versionPromotionAction(isStaging bool) string {
+	if isStaging {
+		return "promote"
+	}
+	return "restore"
+}
+
+func findProjectRouteVersionByID(versions []client.ProjectRouteVersion, versionID string) (client.ProjectRouteVersion, bool) {
+	for _, version := range versions {
+		if version.ID == versionID {
+			return version, true
+		}
+	}
+	return client.ProjectRouteVersion{}, false
+}
+
+func (r *projectRoutesPromotionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan ProjectRoutesPromotion
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	projectID := plan.ProjectID.ValueString()
+	versionID := plan.VersionID.ValueString()
+
+	unlock := projectRouteLocks.Lock(projectRoutesResourceID(r.client.TeamID(plan.TeamID.ValueString()), projectID))
+	defer unlock()
+
+	versions, err := r.client.GetProjectRouteVersions(ctx, projectID, plan.TeamID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error promoting project routes",
+			fmt.Sprintf("Could not get routing-rules versions of project %s, unexpected error: %s", projectID, err),
+		)
+		return
+	}
+	version, ok := findProjectRouteVersionByID(versions, versionID)
+	if !ok {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("version_id"),
+			"Routing-rules version not found",
+			fmt.Sprintf("Version %s of the routing rules of project %s could not be found.", versionID, projectID),
+		)
+		return
+	}
+
+	if !version.IsLive {
+		_, err = r.client.UpdateProjectRoutingRuleVersion(ctx, client.UpdateProjectRoutingRuleVersionRequest{
+			TeamID:    plan.TeamID.ValueString(),
+			ProjectID: projectID,
+			ID:        versionID,
+			Action:    versionPromotionAction(version.IsStaging),
+		})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error promoting project routes",
+				fmt.Sprintf("Could not promote routing-rules version %s of project %s, unexpected error: %s", versionID, projectID, err),
+			)
+			return
+		}
+	}
+
+	result := ProjectRoutesPromotion{
+		ID:        types.StringValue(versionID),
+		ProjectID: plan.ProjectID,
+		TeamID:    toTeamID(r.client.TeamID(plan.TeamID.ValueString())),
+		VersionID: plan.VersionID,
+		IsLive:    types.BoolValue(true),
+	}
+	tflog.Info(ctx, "promoted project routes version", map[string]any{
+		"team_id":    result.TeamID.ValueString(),
+		"project_id": projectID,
+		"version_id": versionID,
+		"action":     versionPromotionAction(version.IsStaging),
+	})
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, result)...)
+}
+
+// Read refreshes whether the version is still live. The promotion happened
+// once, so a later promotion of another version is not drift.
+func (r *projectRoutesPromotionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state ProjectRoutesPromotion
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	versions, err := r.client.GetProjectRouteVersions(ctx, state.ProjectID.ValueString(), state.TeamID.ValueString())
+	if client.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading project routes promotion",
+			fmt.Sprintf("Could not get routing-rules versions of project %s, unexpected error: %s", state.ProjectID.ValueString(), err),
+		)
+		return
+	}
+	version, ok := findProjectRouteVersionByID(versions, state.VersionID.ValueString())
+	if !ok {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.IsLive = types.BoolValue(version.IsLive)
+	resp.Diagnostics.Append(resp.State.Set(ctx, state)...)
+}
+
+func (r *projectRoutesPromotionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	resp.Diagnostics.AddError(
+		"Updating a Project Routes Promotion is not supported. If you see this error, this is a bug in the provider.",
+		"Updating a Project Routes Promotion is not supported. If you see this error, this is a bug in the provider.",
+	)
+}
+
+// Delete leaves the promoted version live.
+func (r *projectRoutesPromotionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state ProjectRoutesPromotion
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, "removed project routes promotion from state", map[string]any{
+		"project_id": state.ProjectID.ValueString(),
+		"version_id": state.VersionID.ValueString(),
+	})
+}
@@ -0,0 +1,75 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAcc_ProjectRoutesPromotion(t *testing.T) {
+	resourceName := "vercel_project_routes.example"
+	projectResourceName := "vercel_project.example"
+	nameSuffix := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), projectResourceName, testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectRoutesPromotionConfig(nameSuffix, false)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "promote", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.0.id"),
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					testAccProjectRoutesOrder(testClient(t), projectResourceName, testTeam(t)),
+				),
+			},
+			{
+				Config: cfg(testAccProjectRoutesPromotionConfig(nameSuffix, true)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vercel_project_routes_promotion.example", "version_id", resourceName, "version_id"),
+					resource.TestCheckResourceAttr("vercel_project_routes_promotion.example", "is_live", "true"),
+					testAccProjectRoutesOrder(testClient(t), projectResourceName, testTeam(t), "redirect-legacy"),
+				),
+			},
+		},
+	})
+}
+
+func testAccProjectRoutesPromotionConfig(nameSuffix string, promoted bool) string {
+	promotion := ""
+	if promoted {
+		promotion = `
+resource "vercel_project_routes_promotion" "example" {
+	project_id = vercel_project.example.id
+	version_id = vercel_project_routes.example.version_id
+}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-project-routes-promotion-%s"
+}
+
+resource "vercel_project_routes" "example" {
+	project_id = vercel_project.example.id
+	promote    = false
+	rules = [
+		{
+			name = "redirect-legacy"
+			route = {
+				src    = "/legacy/:path*"
+				dest   = "/modern/:path*"
+				status = 308
+			}
+		},
+	]
+}
+%s`, nameSuffix, promotion)
+}
@@ -0,0 +1,49 @@
+package vercel
+
+import (
+	"testing"
+
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func TestFindProjectRouteVersion(t *testing.T) {
+	versions := []client.ProjectRouteVersion{
+		{ID: "ver_old"},
+		{ID: "ver_live", IsLive: true},
+		{ID: "ver_staging", IsStaging: true},
+	}
+
+	if got := findProjectRouteVersion(versions, false).ID; got != "ver_live" {
+		t.Errorf("live version = %q, want ver_live", got)
+	}
+	if got := findProjectRouteVersion(versions, true).ID; got != "ver_staging" {
+		t.Errorf("staged version = %q, want ver_staging", got)
+	}
+	if got := findProjectRouteVersion(versions[:2], true).ID; got != "ver_live" {
+		t.Errorf("staged version without a draft = %q, want ver_live", got)
+	}
+	if got := findProjectRouteVersion(nil, true).ID; got != "" {
+		t.Errorf("version of a project without versions = %q, want none", got)
+	}
+}
+
+func TestFindProjectRouteVersionByID(t *testing.T) {
+	versions := []client.ProjectRouteVersion{{ID: "ver_1"}, {ID: "ver_2", IsLive: true}}
+
+	version, ok := findProjectRouteVersionByID(versions, "ver_2")
+	if !ok || !version.IsLive {
+		t.Errorf("findProjectRouteVersionByID() = %+v, %t", version, ok)
+	}
+	if _, ok := findProjectRouteVersionByID(versions, "ver_3"); ok {
+		t.Error("expected ver_3 not to be found")
+	}
+}
+
+func TestVersionPromotionAction(t *testing.T) {
+	if got := versionPromotionAction(true); got != "promote" {
+		t.Errorf("action for a staged version = %q, want promote", got)
+	}
+	if got := versionPromotionAction(false); got != "restore" {
+		t.Errorf("action for an earlier version = %q, want restore", got)
+	}
+}
@@ -0,0 +1,272 @@
+package vercel_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func testAccCaptureProjectRoutesRuleID(n string, index int, destination *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		id := rs.Primary.Attributes[fmt.Sprintf("rules.%d.id", index)]
+		if id == "" {
+			return fmt.Errorf("no ID is set for rule %d", index)
+		}
+
+		*destination = id
+		return nil
+	}
+}
+
+func testAccProjectRoutesRuleIDMatches(n string, index int, expected *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("not found: %s", n)
+		}
+
+		if id := rs.Primary.Attributes[fmt.Sprintf("rules.%d.id", index)]; id != *expected {
+			return fmt.Errorf("expected rule %d to have ID %q, got %q", index, *expected, id)
+		}
+
+		return nil
+	}
+}
+
+func TestAcc_ProjectRoutesResource(t *testing.T) {
+	resourceName := "vercel_project_routes.example"
+	projectResourceName := "vercel_project.example"
+	nameSuffix := acctest.RandString(16)
+	campaignRouteID := ""
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), projectResourceName, testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectRoutesResourceConfig(nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "version_id"),
+					resource.TestCheckResourceAttr(resourceName, "ignore_unmanaged_rules", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.name", "redirect-legacy"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.name", "rewrite-campaign"),
+					resource.TestCheckResourceAttr(resourceName, "rules.1.route.has.0.value", "eu"),
+					resource.TestCheckResourceAttrSet(resourceName, "rules.1.route_type"),
+					testAccProjectRoutesOrder(testClient(t), projectResourceName, testTeam(t), "redirect-legacy", "rewrite-campaign"),
+					testAccCaptureProjectRoutesRuleID(resourceName, 1, &campaignRouteID),
+				),
+			},
+			{
+				Config: cfg(testAccProjectRoutesResourceConfigUpdated(nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.name", "rewrite-campaign"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.route.dest", "/campaign/eu"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.route.has.0.value", "uk"),
+					resource.TestCheckResourceAttr(resourceName, "rules.2.name", "not-found-admin"),
+					resource.TestCheckResourceAttr(resourceName, "rules.2.route.status", "404"),
+					testAccProjectRoutesRuleIDMatches(resourceName, 0, &campaignRouteID),
+					testAccProjectRoutesOrder(testClient(t), projectResourceName, testTeam(t), "rewrite-campaign", "redirect-legacy", "not-found-admin"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateIdFunc: getProjectRoutesImportID(resourceName),
+			},
+			{
+				Config: cfg(testAccProjectRoutesResourceConfigEmpty(nameSuffix)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "0"),
+					testAccProjectRoutesOrder(testClient(t), projectResourceName, testTeam(t)),
+				),
+			},
+		},
+	})
+}
+
+func TestAcc_ProjectRoutesResourceIgnoreUnmanagedRules(t *testing.T) {
+	resourceName := "vercel_project_routes.example"
+	projectResourceName := "vercel_project.example"
+	nameSuffix := acctest.RandString(16)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		CheckDestroy: resource.ComposeAggregateTestCheckFunc(
+			testAccProjectDestroy(testClient(t), projectResourceName, testTeam(t)),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: cfg(testAccProjectRoutesResourceConfigIgnoreUnmanaged(nameSuffix, `"/campaign"`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ignore_unmanaged_rules", "true"),
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.name", "rewrite-campaign"),
+					testAccProjectRouteExists(testClient(t), "vercel_project_route.unmanaged", testTeam(t)),
+					testAccProjectRoutesOrder(testClient(t), projectResourceName, testTeam(t), "redirect-legacy", "rewrite-campaign"),
+				),
+			},
+			{
+				Config: cfg(testAccProjectRoutesResourceConfigIgnoreUnmanaged(nameSuffix, `"/campaign/eu"`)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rules.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rules.0.route.dest", "/campaign/eu"),
+					testAccProjectRouteExists(testClient(t), "vercel_project_route.unmanaged", testTeam(t)),
+					testAccProjectRoutesOrder(testClient(t), projectResourceName, testTeam(t), "redirect-legacy", "rewrite-campaign"),
+				),
+			},
+		},
+	})
+}
+
+func getProjectRoutesImportID(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s/%s", rs.Primary.Attributes["team_id"], rs.Primary.Attributes["project_id"]), nil
+	}
+}
+
+func testAccProjectRoutesResourceConfig(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-project-routes-%s"
+}
+
+resource "vercel_project_routes" "example" {
+	project_id = vercel_project.example.id
+	rules = [
+		{
+			name = "redirect-legacy"
+			route = {
+				src    = "/legacy/:path*"
+				dest   = "/modern/:path*"
+				status = 308
+			}
+		},
+		{
+			name = "rewrite-campaign"
+			route = {
+				src  = "/promo"
+				dest = "/campaign"
+				has = [
+					{
+						type  = "header"
+						key   = "x-region"
+						value = "eu"
+					}
+				]
+			}
+		},
+	]
+}
+`, nameSuffix)
+}
+
+func testAccProjectRoutesResourceConfigUpdated(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-project-routes-%s"
+}
+
+resource "vercel_project_routes" "example" {
+	project_id = vercel_project.example.id
+	rules = [
+		{
+			name        = "rewrite-campaign"
+			enabled     = false
+			description = "Route promo traffic to the EU landing page"
+			route = {
+				src  = "/promo"
+				dest = "/campaign/eu"
+				has = [
+					{
+						type  = "header"
+						key   = "x-region"
+						value = "uk"
+					}
+				]
+			}
+		},
+		{
+			name = "redirect-legacy"
+			route = {
+				src    = "/legacy/:path*"
+				dest   = "/modern/:path*"
+				status = 308
+			}
+		},
+		{
+			name = "not-found-admin"
+			route = {
+				src    = "/admin"
+				status = 404
+			}
+		},
+	]
+}
+`, nameSuffix)
+}
+
+func testAccProjectRoutesResourceConfigEmpty(nameSuffix string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-project-routes-%s"
+}
+
+resource "vercel_project_routes" "example" {
+	project_id = vercel_project.example.id
+	rules      = []
+}
+`, nameSuffix)
+}
+
+func testAccProjectRoutesResourceConfigIgnoreUnmanaged(nameSuffix, campaignDest string) string {
+	return fmt.Sprintf(`
+resource "vercel_project" "example" {
+	name = "test-acc-project-routes-%[1]s"
+}
+
+resource "vercel_project_route" "unmanaged" {
+	project_id = vercel_project.example.id
+	name       = "redirect-legacy"
+	route = {
+		src    = "/legacy/:path*"
+		dest   = "/modern/:path*"
+		status = 308
+	}
+}
+
+resource "vercel_project_routes" "example" {
+	project_id             = vercel_project.example.id
+	ignore_unmanaged_rules = true
+	rules = [
+		{
+			name = "rewrite-campaign"
+			route = {
+				src  = "/promo"
+				dest = %[2]s
+			}
+		},
+	]
+
+	depends_on = [vercel_project_route.unmanaged]
+}
+`, nameSuffix, campaignDest)
+}
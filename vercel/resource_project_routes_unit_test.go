@@ -0,0 +1,202 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/vercel/terraform-provider-vercel/v5/client"
+)
+
+func projectRouteInputIDs(routes []client.ProjectRoutingRuleInput) []string {
+	ids := make([]string, 0, len(routes))
+	for _, route := range routes {
+		id := route.ID
+		if id == "" {
+			id = "new:" + route.Name
+		}
+		ids = append(ids, id)
+	}
+	return ids
+}
+
+func TestMergeUnmanagedProjectRoutes(t *testing.T) {
+	live := []client.ProjectRoutingRule{
+		{ID: "u1", Name: "unmanaged-first"},
+		{ID: "m1", Name: "one"},
+		{ID: "u2", Name: "unmanaged-after-one"},
+		{ID: "m2", Name: "two"},
+		{ID: "u3", Name: "unmanaged-after-two"},
+		{ID: "m3", Name: "three"},
+	}
+	managed := map[string]bool{"m1": true, "m2": true, "m3": true}
+
+	for _, tt := range []struct {
+		name    string
+		desired []client.ProjectRoutingRuleInput
+		want    []string
+	}{
+		{
+			name:    "unchanged",
+			desired: []client.ProjectRoutingRuleInput{{ID: "m1"}, {ID: "m2"}, {ID: "m3"}},
+			want:    []string{"u1", "m1", "u2", "m2", "u3", "m3"},
+		},
+		{
+			name:    "reordered rules carry the rules that follow them",
+			desired: []client.ProjectRoutingRuleInput{{ID: "m3"}, {ID: "m2"}, {ID: "m1"}},
+			want:    []string{"u1", "m3", "m2", "u3", "m1", "u2"},
+		},
+		{
+			name:    "rules after a deleted rule move to the previous managed rule",
+			desired: []client.ProjectRoutingRuleInput{{ID: "m1"}, {ID: "m3"}},
+			want:    []string{"u1", "m1", "u2", "u3", "m3"},
+		},
+		{
+			name:    "new rules are placed as configured",
+			desired: []client.ProjectRoutingRuleInput{{Name: "zero"}, {ID: "m1"}, {ID: "m2"}, {ID: "m3"}, {Name: "four"}},
+			want:    []string{"u1", "new:zero", "m1", "u2", "m2", "u3", "m3", "new:four"},
+		},
+		{
+			name: "everything deleted keeps only unmanaged rules",
+			want: []string{"u1", "u2", "u3"},
+		},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			got := projectRouteInputIDs(mergeUnmanagedProjectRoutes(live, tt.desired, managed))
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("mergeUnmanagedProjectRoutes() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestMergeUnmanagedProjectRoutesOnCreate(t *testing.T) {
+	live := []client.ProjectRoutingRule{{ID: "u1"}, {ID: "u2"}}
+	desired := []client.ProjectRoutingRuleInput{{Name: "one"}}
+
+	got := projectRouteInputIDs(mergeUnmanagedProjectRoutes(live, desired, map[string]bool{}))
+	want := []string{"u1", "u2", "new:one"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("mergeUnmanagedProjectRoutes() = %v, want %v", got, want)
+	}
+}
+
+func TestProjectRouteInputFromAPI(t *testing.T) {
+	rawSrc := "/blog/:slug"
+	rawDest := "/posts/:slug"
+	dest := "/posts/$1"
+	syntax := "path-to-regexp"
+
+	got := projectRouteInputFromAPI(client.ProjectRoutingRule{
+		ID:        "rt_1",
+		Name:      "blog",
+		SrcSyntax: &syntax,
+		RawSrc:    &rawSrc,
+		RawDest:   &rawDest,
+		Route: client.ProjectRouteDefinition{
+			Src:  "^/blog/([^/]+)$",
+			Dest: &dest,
+		},
+	})
+	if got.ID != "rt_1" || got.Name != "blog" || got.SrcSyntax != &syntax {
+		t.Errorf("unexpected rule %+v", got)
+	}
+	if got.Route.Src != rawSrc || got.Route.Dest == nil || *got.Route.Dest != rawDest {
+		t.Errorf("route = %+v, want the raw source and destination", got.Route)
+	}
+}
+
+func TestFilterManagedProjectRoutes(t *testing.T) {
+	response := client.ProjectRoutingRulesResponse{
+		Routes: []client.ProjectRoutingRule{{ID: "u1"}, {ID: "m1"}, {ID: "u2"}},
+	}
+	managed := map[string]bool{"m1": true}
+
+	if got := filterManagedProjectRoutes(response, false, managed); len(got.Routes) != 3 {
+		t.Errorf("expected all rules to be kept, got %d", len(got.Routes))
+	}
+	got := filterManagedProjectRoutes(response, true, managed)
+	if len(got.Routes) != 1 || got.Routes[0].ID != "m1" {
+		t.Errorf("expected only m1, got %+v", got.Routes)
+	}
+}
+
+func TestProjectRoutesUniqueNamesValidator(t *testing.T) {
+	rule := func(name string) attr.Value {
+		return ProjectRoute{
+			ID:          types.StringUnknown(),
+			Name:        types.StringValue(name),
+			Description: types.StringNull(),
+			Enabled:     types.BoolValue(true),
+			SrcSyntax:   types.StringNull(),
+			RouteType:   types.StringUnknown(),
+			Route: ProjectRouteDefinition{
+				Src:                       types.StringValue("/" + name),
+				Dest:                      types.StringValue("/dest"),
+				Headers:                   types.MapNull(types.StringType),
+				CaseSensitive:             types.BoolNull(),
+				Status:                    types.Int64Null(),
+				Has:                       types.ListNull(projectRouteConditionAttrType),
+				Missing:                   types.ListNull(projectRouteConditionAttrType),
+				Transforms:                types.ListNull(projectRouteTransformAttrType),
+				RespectOriginCacheControl: types.BoolNull(),
+			},
+		}.toAttrValue()
+	}
+
+	for _, tt := range []struct {
+		name    string
+		rules   []attr.Value
+		wantErr bool
+	}{
+		{name: "unique", rules: []attr.Value{rule("a"), rule("b")}},
+		{name: "duplicate", rules: []attr.Value{rule("a"), rule("b"), rule("a")}, wantErr: true},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			resp := &validator.ListResponse{}
+			projectRoutesUniqueNamesValidator{}.ValidateList(context.Background(), validator.ListRequest{
+				Path:        path.Root("rules"),
+				ConfigValue: types.ListValueMust(projectRouteAttrType, tt.rules),
+			}, resp)
+			if resp.Diagnostics.HasError() != tt.wantErr {
+				t.Errorf("ValidateList() diagnostics = %v, wantErr %v", resp.Diagnostics, tt.wantErr)
+			}
+			if tt.wantErr {
+				if got := resp.Diagnostics.Errors()[0].(interface{ Path() path.Path }).Path(); !got.Equal(path.Root("rules").AtListIndex(2).AtName("name")) {
+					t.Errorf("error path = %s", got)
+				}
+			}
+		})
+	}
+}
+
+func TestEnsureNoStagedProjectRoutesAllowsOwnDraft(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v1/projects/prj_123/routes/versions" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintln(w, `{"versions":[{"id":"ver_live","isLive":true},{"id":"ver_draft","isStaging":true}]}`)
+	}))
+	t.Cleanup(server.Close)
+
+	c := client.New("INVALID").WithBaseURL(server.URL)
+	ctx := context.Background()
+
+	if err := ensureNoStagedProjectRoutes(ctx, c, "prj_123", "team_123", "", "vercel_project_routes"); err == nil {
+		t.Error("expected an error for a draft staged outside Terraform")
+	}
+	if err := ensureNoStagedProjectRoutes(ctx, c, "prj_123", "team_123", "ver_other", "vercel_project_routes"); err == nil {
+		t.Error("expected an error for a draft staged on top of our own")
+	}
+	if err := ensureNoStagedProjectRoutes(ctx, c, "prj_123", "team_123", "ver_draft", "vercel_project_routes"); err != nil {
+		t.Errorf("unexpected error for our own draft: %v", err)
+	}
+}
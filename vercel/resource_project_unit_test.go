@@ -263,3 +263,41 @@ func assertStringSliceSet(t *testing.T, got, want []string) {
 		}
 	}
 }
+
+func projectWithDeployHooks(t *testing.T, hooks ...deployHook) Project {
+	t.Helper()
+
+	deployHooks, diags := types.SetValueFrom(context.Background(), deployHookType, hooks)
+	if diags.HasError() {
+		t.Fatalf("SetValueFrom() returned diagnostics: %v", diags)
+	}
+	project := projectForUpdateRequestTests()
+	project.GitRepository = types.ObjectValueMust(gitRepositoryAttrType.AttrTypes, map[string]attr.Value{
+		"type":              types.StringValue("github"),
+		"repo":              types.StringValue("vercel/example"),
+		"production_branch": types.StringNull(),
+		"deploy_hooks":      deployHooks,
+	})
+	return project
+}
+
+func TestWarnOnUnmanagedDeployHooks(t *testing.T) {
+	ctx := context.Background()
+	state := projectWithDeployHooks(t,
+		deployHook{Name: "main", Ref: "main", ID: "hook_1", URL: "https://example.com/1"},
+		deployHook{Name: "standalone", Ref: "staging", ID: "hook_2", URL: "https://example.com/2"},
+	)
+
+	diags := warnOnUnmanagedDeployHooks(ctx, projectWithDeployHooks(t, deployHook{Name: "main", Ref: "main"}), state)
+	if diags.HasError() {
+		t.Fatalf("warnOnUnmanagedDeployHooks() returned errors: %v", diags)
+	}
+	if got := diags.WarningsCount(); got != 1 {
+		t.Fatalf("warnOnUnmanagedDeployHooks() returned %d warnings, want 1", got)
+	}
+
+	diags = warnOnUnmanagedDeployHooks(ctx, projectForUpdateRequestTests(), state)
+	if got := diags.WarningsCount(); got != 0 {
+		t.Fatalf("warnOnUnmanagedDeployHooks() returned %d warnings without inline deploy hooks, want 0", got)
+	}
+}
@@ -26,6 +26,7 @@ var (
 	_ resource.ResourceWithImportState      = &sharedEnvironmentVariableResource{}
 	_ resource.ResourceWithModifyPlan       = &sharedEnvironmentVariableResource{}
 	_ resource.ResourceWithConfigValidators = &sharedEnvironmentVariableResource{}
+	_ resource.ResourceWithIdentity         = &sharedEnvironmentVariableResource{}
 )
 
 func newSharedEnvironmentVariableResource() resource.Resource {
@@ -287,6 +288,11 @@ func (v *sharedEnvTargetValidator) ValidateResource(ctx context.Context, req res
 	}
 }
 
+// IdentitySchema returns the identity schema for a shared environment variable resource.
+func (r *sharedEnvironmentVariableResource) IdentitySchema(_ context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = teamResourceIdentitySchema("The ID of the shared environment variable.")
+}
+
 // SharedEnvironmentVariable reflects the state terraform stores internally for a project environment variable.
 type SharedEnvironmentVariable struct {
 	Target                       types.Set    `tfsdk:"target"`
@@ -301,6 +307,13 @@ type SharedEnvironmentVariable struct {
 	ApplyToAllCustomEnvironments types.Bool   `tfsdk:"apply_to_all_custom_environments"`
 }
 
+func (e SharedEnvironmentVariable) identity() TeamResourceIdentity {
+	return TeamResourceIdentity{
+		TeamID: e.TeamID,
+		ID:     e.ID,
+	}
+}
+
 func (e SharedEnvironmentVariable) isExplicitlyNonSensitive() bool {
 	return !e.Sensitive.IsNull() && !e.Sensitive.IsUnknown() && !e.Sensitive.ValueBool()
 }
@@ -455,6 +468,22 @@ func convertResponseToSharedEnvironmentVariable(response client.SharedEnvironmen
 	}
 }
 
+// convertImportedSharedEnvironmentVariable populates terraform state for a shared environment
+// variable that has no prior plan or state, such as when it is imported.
+func convertImportedSharedEnvironmentVariable(response client.SharedEnvironmentVariableResponse) SharedEnvironmentVariable {
+	value := types.StringNull()
+	if response.Type != "sensitive" {
+		value = types.StringValue(response.Value)
+	}
+
+	projectIDs := make([]attr.Value, 0, len(response.ProjectIDs))
+	for _, projectID := range response.ProjectIDs {
+		projectIDs = append(projectIDs, types.StringValue(projectID))
+	}
+
+	return convertResponseToSharedEnvironmentVariable(response, value, types.SetValueMust(types.StringType, projectIDs))
+}
+
 // Create will create a new shared environment variable.
 // This is called automatically by the provider when a new resource should be created.
 func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -496,6 +525,8 @@ func (r *sharedEnvironmentVariableResource) Create(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Read will read an shared environment variable by requesting it from the Vercel API, and will update terraform
@@ -536,6 +567,8 @@ func (r *sharedEnvironmentVariableResource) Read(ctx context.Context, req resour
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Update updates the shared environment variable of a Vercel project state.
@@ -578,6 +611,8 @@ func (r *sharedEnvironmentVariableResource) Update(ctx context.Context, req reso
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
 
 // Delete deletes a Vercel shared environment variable.
@@ -614,11 +649,17 @@ func (r *sharedEnvironmentVariableResource) Delete(ctx context.Context, req reso
 // ImportState takes an identifier and reads all the shared environment variable information from the Vercel API.
 // The results are then stored in terraform state.
 func (r *sharedEnvironmentVariableResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	teamID, envID, ok := splitInto1Or2(req.ID)
+	importID, diags := importIDFromRequest(ctx, req, "team_id", "id")
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamID, envID, ok := splitInto1Or2(importID)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Error importing shared environment variable",
-			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/env_id\"", req.ID),
+			fmt.Sprintf("Invalid id '%s' specified. should be in format \"team_id/env_id\"", importID),
 		)
 		return
 	}
@@ -636,25 +677,17 @@ func (r *sharedEnvironmentVariableResource) ImportState(ctx context.Context, req
 		return
 	}
 
-	value := types.StringNull()
-	if out.Type != "sensitive" {
-		value = types.StringValue(out.Value)
-	}
-
-	projectIDs := make([]attr.Value, 0, len(out.ProjectIDs))
-	for _, projectID := range out.ProjectIDs {
-		projectIDs = append(projectIDs, types.StringValue(projectID))
-	}
-
-	result := convertResponseToSharedEnvironmentVariable(out, value, types.SetValueMust(types.StringType, projectIDs))
+	result := convertImportedSharedEnvironmentVariable(out)
 	tflog.Info(ctx, "imported shared environment variable", map[string]any{
 		"team_id": result.TeamID.ValueString(),
 		"env_id":  result.ID.ValueString(),
 	})
 
-	diags := resp.State.Set(ctx, result)
+	diags = resp.State.Set(ctx, result)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+	diags = setIdentity(ctx, resp.Identity, result.identity())
+	resp.Diagnostics.Append(diags...)
 }
@@ -0,0 +1,40 @@
+package vercel
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+)
+
+var _ validator.String = validatorDuration{}
+
+func validateDuration() validatorDuration {
+	return validatorDuration{}
+}
+
+type validatorDuration struct {
+}
+
+func (v validatorDuration) Description(ctx context.Context) string {
+	return "Value must be a positive duration, such as 30m or 72h"
+}
+func (v validatorDuration) MarkdownDescription(ctx context.Context) string {
+	return "Value must be a positive duration, such as `30m` or `72h`"
+}
+
+func (v validatorDuration) ValidateString(ctx context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsUnknown() || req.ConfigValue.IsNull() {
+		return
+	}
+
+	duration, err := time.ParseDuration(req.ConfigValue.ValueString())
+	if err != nil || duration <= 0 {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid value provided",
+			fmt.Sprintf("Value must be a positive duration such as 30m or 72h, got %q.", req.ConfigValue.ValueString()),
+		)
+	}
+}